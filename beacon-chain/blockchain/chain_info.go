@@ -25,6 +25,7 @@ type ChainInfoFetcher interface {
 	GenesisFetcher
 	CanonicalFetcher
 	ForkFetcher
+	TimeFetcher
 }
 
 // TimeFetcher retrieves the Ethereum consensus data that's related to time.
@@ -72,6 +73,11 @@ type FinalizationFetcher interface {
 	PreviousJustifiedCheckpt() *ethpb.Checkpoint
 }
 
+// ProposalPreparationSubscriber registers consumers of proposal preparation events.
+type ProposalPreparationSubscriber interface {
+	SubscribeProposalPreparation() (unsubscribe func())
+}
+
 // FinalizedCheckpt returns the latest finalized checkpoint from head state.
 func (s *Service) FinalizedCheckpt() *ethpb.Checkpoint {
 	if s.finalizedCheckpt == nil {
@@ -0,0 +1,77 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// This saves the fork choice store in DB, so the non-finalized blocks and the votes of validators
+// can be restored on the next start instead of replaying blocks from the finalized checkpoint.
+func (s *Service) saveForkChoiceStore(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveForkChoiceStore")
+	defer span.End()
+
+	if s.cfg.ForkChoiceStore == nil {
+		return nil
+	}
+	store := s.cfg.ForkChoiceStore.ToProto()
+	// There is nothing to save before the chain has started.
+	if len(store.Nodes) == 0 {
+		return nil
+	}
+	if err := s.cfg.BeaconDB.SaveForkChoiceStore(ctx, store); err != nil {
+		return errors.Wrap(err, "could not save fork choice store")
+	}
+	return nil
+}
+
+// This restores the fork choice store saved in DB. It returns nil if no store was saved. The saved store is only
+// restored if it contains the finalized block of the input checkpoint and all of its nodes are blocks saved in DB,
+// with the same slot and parent as in the store.
+func (s *Service) restoreForkChoiceStore(ctx context.Context, finalizedCheckpoint *ethpb.Checkpoint) (*protoarray.ForkChoice, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.restoreForkChoiceStore")
+	defer span.End()
+
+	saved, err := s.cfg.BeaconDB.ForkChoiceStore(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork choice store from db")
+	}
+	if saved == nil {
+		return nil, nil
+	}
+	store, err := protoarray.NewFromProto(saved)
+	if err != nil {
+		return nil, err
+	}
+	finalizedRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalizedCheckpoint.Root))
+	if !store.HasNode(finalizedRoot) {
+		return nil, fmt.Errorf("finalized block %#x is not in the fork choice store", finalizedRoot)
+	}
+	nodes := store.Nodes()
+	for _, n := range nodes {
+		blk, err := s.cfg.BeaconDB.Block(ctx, n.Root())
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get block %#x from db", n.Root())
+		}
+		if blk == nil || blk.IsNil() {
+			return nil, fmt.Errorf("fork choice node %#x is not a block in db", n.Root())
+		}
+		if blk.Block().Slot() != n.Slot() {
+			return nil, fmt.Errorf("fork choice node %#x has slot %d, its block has slot %d", n.Root(), n.Slot(), blk.Block().Slot())
+		}
+		if n.Parent() == protoarray.NonExistentNode {
+			continue
+		}
+		parentRoot := nodes[n.Parent()].Root()
+		if bytesutil.ToBytes32(blk.Block().ParentRoot()) != parentRoot {
+			return nil, fmt.Errorf("fork choice node %#x has parent %#x, its block has parent %#x", n.Root(), parentRoot, blk.Block().ParentRoot())
+		}
+	}
+	return store, nil
+}
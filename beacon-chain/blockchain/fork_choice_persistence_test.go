@@ -0,0 +1,140 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_SaveAndRestoreForkChoiceStore(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		ForkChoiceStore: protoarray.New(0, 0, params.BeaconConfig().ZeroHash),
+	})
+	require.NoError(t, err)
+
+	// Nothing is saved before the chain has started.
+	require.NoError(t, service.saveForkChoiceStore(ctx))
+	restored, err := service.restoreForkChoiceStore(ctx, &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]})
+	require.NoError(t, err)
+	assert.Equal(t, (*protoarray.ForkChoice)(nil), restored)
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	service.genesisRoot = genesisRoot
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 1
+	b.Block.ParentRoot = genesisRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, genesisRoot, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, root, genesisRoot, [32]byte{}, 0, 0))
+	service.cfg.ForkChoiceStore.ProcessAttestation(ctx, []uint64{0}, root, 0)
+	_, err = service.cfg.ForkChoiceStore.Head(ctx, 0, genesisRoot, []uint64{10}, 0)
+	require.NoError(t, err)
+	require.NoError(t, service.saveForkChoiceStore(ctx))
+
+	// The zero finalized root is the genesis block.
+	restored, err = service.restoreForkChoiceStore(ctx, &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]})
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.DeepEqual(t, service.cfg.ForkChoiceStore.Nodes(), restored.Nodes())
+	assert.Equal(t, uint64(10), restored.Node(root).Weight())
+
+	// The store is not restored if it does not contain the finalized block.
+	_, err = service.restoreForkChoiceStore(ctx, &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'a'}, 32)})
+	assert.ErrorContains(t, "is not in the fork choice store", err)
+
+	// The store is not restored if one of its blocks is not in DB.
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 2, [32]byte{'b'}, root, [32]byte{}, 0, 0))
+	require.NoError(t, service.saveForkChoiceStore(ctx))
+	_, err = service.restoreForkChoiceStore(ctx, &ethpb.Checkpoint{Root: genesisRoot[:]})
+	assert.ErrorContains(t, "is not a block in db", err)
+}
+
+func TestService_ResumeForkChoice(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{BeaconDB: beaconDB})
+	require.NoError(t, err)
+
+	// Without a saved store, a new store is created from the checkpoints.
+	finalized := &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'a'}, 32)}
+	service.resumeForkChoice(ctx, &ethpb.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte{'b'}, 32)}, finalized)
+	assert.Equal(t, 0, len(service.cfg.ForkChoiceStore.Nodes()))
+	assert.Equal(t, uint64(2), uint64(service.cfg.ForkChoiceStore.Store().JustifiedEpoch()))
+
+	// A saved store is restored.
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 32
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 32, root, params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, service.saveForkChoiceStore(ctx))
+	finalized = &ethpb.Checkpoint{Epoch: 1, Root: root[:]}
+	service.resumeForkChoice(ctx, finalized, finalized)
+	assert.Equal(t, true, service.cfg.ForkChoiceStore.HasNode(root))
+}
+
+func TestService_RestoreForkChoiceStore_BlockMismatch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{BeaconDB: beaconDB})
+	require.NoError(t, err)
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	service.genesisRoot = genesisRoot
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 2
+	b.Block.ParentRoot = genesisRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	other := testutil.NewBeaconBlock()
+	other.Block.Slot = 1
+	other.Block.ParentRoot = genesisRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(other)))
+	otherRoot, err := other.Block.HashTreeRoot()
+	require.NoError(t, err)
+	finalized := &ethpb.Checkpoint{Root: genesisRoot[:]}
+
+	// The slot of a node does not match its block.
+	service.cfg.ForkChoiceStore = protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, genesisRoot, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 3, root, genesisRoot, [32]byte{}, 0, 0))
+	require.NoError(t, service.saveForkChoiceStore(ctx))
+	_, err = service.restoreForkChoiceStore(ctx, finalized)
+	assert.ErrorContains(t, "has slot 3, its block has slot 2", err)
+
+	// The parent of a node does not match the parent of its block.
+	service.cfg.ForkChoiceStore = protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, genesisRoot, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, otherRoot, genesisRoot, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 2, root, otherRoot, [32]byte{}, 0, 0))
+	require.NoError(t, service.saveForkChoiceStore(ctx))
+	_, err = service.restoreForkChoiceStore(ctx, finalized)
+	assert.ErrorContains(t, "its block has parent", err)
+
+	// The mismatching store is discarded and fork choice is rebuilt from the checkpoint.
+	service.resumeForkChoice(ctx, finalized, finalized)
+	assert.Equal(t, 0, len(service.cfg.ForkChoiceStore.Nodes()))
+}
@@ -4,17 +4,21 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	core "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/sirupsen/logrus"
@@ -160,6 +164,9 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 		if err := s.notifyNewHeadEvent(newHeadSlot, newHeadState, newStateRoot, headRoot[:]); err != nil {
 			log.WithError(err).Error("Could not notify event feed of new chain head")
 		}
+		if err := s.notifyProposalPreparationEvent(s.ctx, newHeadSlot, newHeadState, headRoot); err != nil {
+			log.WithError(err).Warn("Could not notify event feed of proposal preparation")
+		}
 	}()
 
 	return nil
@@ -360,3 +367,90 @@ func (s *Service) notifyNewHeadEvent(
 	})
 	return nil
 }
+
+// SubscribeProposalPreparation registers a consumer of proposal preparation events. The events are
+// only computed while at least one consumer is registered, as determining the upcoming proposer
+// requires advancing the head state. The returned function removes the registration.
+func (s *Service) SubscribeProposalPreparation() (unsubscribe func()) {
+	atomic.AddInt32(&s.proposalPreparationSubs, 1)
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			atomic.AddInt32(&s.proposalPreparationSubs, -1)
+		})
+	}
+}
+
+// Notifies a common event feed of the upcoming block proposal on top of the new chain head,
+// so that block production for the next slot can be prepared ahead of time. The head state
+// is advanced to the proposal slot to determine the expected proposer. Nothing is sent while
+// the head lags far behind the current slot, as is the case during initial sync, or while
+// nobody consumes the events.
+func (s *Service) notifyProposalPreparationEvent(
+	ctx context.Context,
+	newHeadSlot types.Slot,
+	newHeadState state.BeaconState,
+	newHeadRoot [32]byte,
+) error {
+	if atomic.LoadInt32(&s.proposalPreparationSubs) == 0 {
+		return nil
+	}
+	proposalSlot := s.CurrentSlot() + 1
+	if proposalSlot <= newHeadSlot {
+		proposalSlot = newHeadSlot + 1
+	}
+	if proposalSlot-newHeadSlot > params.BeaconConfig().SlotsPerEpoch {
+		return nil
+	}
+	proposalState, err := s.nextSlotState(ctx, newHeadState, newHeadRoot)
+	if err != nil {
+		return err
+	}
+	if proposalSlot > proposalState.Slot() {
+		proposalState, err = core.ProcessSlots(ctx, proposalState, proposalSlot)
+		if err != nil {
+			return errors.Wrap(err, "could not process slots up to proposal slot")
+		}
+	}
+	proposerIndex, err := helpers.BeaconProposerIndex(proposalState)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposer index")
+	}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.ProposalPreparation,
+		Data: &statefeed.ProposalPreparationData{
+			ProposalSlot:    proposalSlot,
+			ProposerIndex:   proposerIndex,
+			ParentBlockRoot: newHeadRoot,
+			Timestamp:       uint64(s.genesisTime.Unix()) + uint64(proposalSlot)*params.BeaconConfig().SecondsPerSlot,
+		},
+	})
+	return nil
+}
+
+// Returns the head state advanced by one slot. The state is read from the next slot cache, and on a
+// miss it is computed and saved to the cache, so that processing the block of the next slot reuses it.
+func (s *Service) nextSlotState(ctx context.Context, headState state.BeaconState, headRoot [32]byte) (state.BeaconState, error) {
+	st, err := core.NextSlotState(ctx, headRoot[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next slot state")
+	}
+	if st != nil && !st.IsNil() {
+		return st, nil
+	}
+	if !featureconfig.Get().EnableNextSlotStateCache {
+		return core.ProcessSlots(ctx, headState.Copy(), headState.Slot()+1)
+	}
+	if err := core.UpdateNextSlotCache(ctx, headRoot[:], headState); err != nil {
+		return nil, errors.Wrap(err, "could not update next slot state cache")
+	}
+	st, err = core.NextSlotState(ctx, headRoot[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next slot state")
+	}
+	if st == nil || st.IsNil() {
+		// The cache was overwritten by a concurrent update for another root.
+		return core.ProcessSlots(ctx, headState.Copy(), headState.Slot()+1)
+	}
+	return st, nil
+}
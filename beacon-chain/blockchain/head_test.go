@@ -4,15 +4,20 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	core "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -214,3 +219,84 @@ func Test_notifyNewHeadEvent(t *testing.T) {
 		require.DeepSSZEqual(t, wanted, eventHead)
 	})
 }
+
+func Test_notifyProposalPreparationEvent(t *testing.T) {
+	t.Run("next_slot", func(t *testing.T) {
+		bState, _ := testutil.DeterministicGenesisState(t, 64)
+		notifier := &mock.MockStateNotifier{RecordEvents: true}
+		genesisTime := time.Now()
+		srv := &Service{
+			cfg: &Config{
+				StateNotifier: notifier,
+			},
+			genesisTime: genesisTime,
+		}
+		defer srv.SubscribeProposalPreparation()()
+		newHeadRoot := [32]byte{'a'}
+		require.NoError(t, srv.notifyProposalPreparationEvent(context.Background(), 0, bState, newHeadRoot))
+		events := notifier.ReceivedEvents()
+		require.Equal(t, 1, len(events))
+
+		data, ok := events[0].Data.(*statefeed.ProposalPreparationData)
+		require.Equal(t, true, ok)
+		advanced := bState.Copy()
+		require.NoError(t, advanced.SetSlot(1))
+		wantedProposer, err := helpers.BeaconProposerIndex(advanced)
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(1), data.ProposalSlot)
+		assert.Equal(t, wantedProposer, data.ProposerIndex)
+		assert.Equal(t, newHeadRoot, data.ParentBlockRoot)
+		assert.Equal(t, uint64(genesisTime.Unix())+params.BeaconConfig().SecondsPerSlot, data.Timestamp)
+		assert.Equal(t, types.Slot(0), bState.Slot(), "Head state was mutated")
+	})
+	t.Run("head_far_behind", func(t *testing.T) {
+		bState, _ := testutil.DeterministicGenesisState(t, 64)
+		notifier := &mock.MockStateNotifier{RecordEvents: true}
+		slotsBehind := params.BeaconConfig().SlotsPerEpoch + 1
+		srv := &Service{
+			cfg: &Config{
+				StateNotifier: notifier,
+			},
+			genesisTime: time.Now().Add(-time.Duration(uint64(slotsBehind)*params.BeaconConfig().SecondsPerSlot) * time.Second),
+		}
+		defer srv.SubscribeProposalPreparation()()
+		require.NoError(t, srv.notifyProposalPreparationEvent(context.Background(), 0, bState, [32]byte{'b'}))
+		assert.Equal(t, 0, len(notifier.ReceivedEvents()))
+	})
+	t.Run("no_subscribers", func(t *testing.T) {
+		bState, _ := testutil.DeterministicGenesisState(t, 64)
+		notifier := &mock.MockStateNotifier{RecordEvents: true}
+		srv := &Service{
+			cfg: &Config{
+				StateNotifier: notifier,
+			},
+			genesisTime: time.Now(),
+		}
+		unsubscribe := srv.SubscribeProposalPreparation()
+		unsubscribe()
+		unsubscribe()
+		require.NoError(t, srv.notifyProposalPreparationEvent(context.Background(), 0, bState, [32]byte{'c'}))
+		assert.Equal(t, 0, len(notifier.ReceivedEvents()))
+		assert.Equal(t, int32(0), srv.proposalPreparationSubs)
+	})
+	t.Run("updates_next_slot_cache", func(t *testing.T) {
+		resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableNextSlotStateCache: true})
+		defer resetCfg()
+		bState, _ := testutil.DeterministicGenesisState(t, 64)
+		notifier := &mock.MockStateNotifier{RecordEvents: true}
+		srv := &Service{
+			cfg: &Config{
+				StateNotifier: notifier,
+			},
+			genesisTime: time.Now(),
+		}
+		defer srv.SubscribeProposalPreparation()()
+		newHeadRoot := [32]byte{'d'}
+		require.NoError(t, srv.notifyProposalPreparationEvent(context.Background(), 0, bState, newHeadRoot))
+		require.Equal(t, 1, len(notifier.ReceivedEvents()))
+		cached, err := core.NextSlotState(context.Background(), newHeadRoot[:])
+		require.NoError(t, err)
+		require.NotNil(t, cached)
+		assert.Equal(t, types.Slot(1), cached.Slot())
+	})
+}
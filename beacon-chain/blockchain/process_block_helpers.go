@@ -427,6 +427,18 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) e
 	if err = s.cfg.DepositCache.PruneProofs(ctx, eth1DepositIndex); err != nil {
 		return errors.Wrap(err, "could not prune deposit proofs")
 	}
+	// Deposits already processed by the finalized state are never needed again, so the
+	// snapshot of the deposit trie replaces them.
+	snapshotCount := finalizedState.Eth1DepositIndex()
+	if snapshotCount > finalizedState.Eth1Data().DepositCount {
+		snapshotCount = finalizedState.Eth1Data().DepositCount
+	}
+	if err = s.cfg.DepositCache.SnapshotFinalizedDeposits(ctx, snapshotCount); err != nil {
+		return errors.Wrap(err, "could not snapshot finalized deposits")
+	}
+	if featureconfig.Get().EnableDepositPruning {
+		s.cfg.DepositCache.PruneFinalizedDeposits(ctx)
+	}
 	return nil
 }
 
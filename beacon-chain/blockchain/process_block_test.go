@@ -26,6 +26,7 @@ import (
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -1001,3 +1002,79 @@ func TestInsertFinalizedDeposits(t *testing.T) {
 		assert.DeepEqual(t, [][]byte(nil), d.Proof, "Proofs are not empty")
 	}
 }
+
+func TestInsertFinalizedDeposits_PrunesSnapshottedDeposits(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableDepositPruning: true})
+	defer resetCfg()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	cfg := &Config{
+		BeaconDB:        beaconDB,
+		StateGen:        stategen.New(beaconDB),
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+		DepositCache:    depositCache,
+	}
+	service, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+
+	gs, _ := testutil.DeterministicGenesisState(t, 32)
+	require.NoError(t, service.saveGenesisData(ctx, gs))
+	gs = gs.Copy()
+	assert.NoError(t, gs.SetEth1Data(&ethpb.Eth1Data{DepositCount: 10}))
+	assert.NoError(t, gs.SetEth1DepositIndex(6))
+	assert.NoError(t, service.cfg.StateGen.SaveState(ctx, [32]byte{'m', 'o', 'c', 'k'}, gs))
+	zeroSig := [96]byte{}
+	for i := uint64(0); i < 12; i++ {
+		root := []byte(strconv.Itoa(int(i)))
+		assert.NoError(t, depositCache.InsertDeposit(ctx, &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.FromBytes48([48]byte{}),
+			WithdrawalCredentials: params.BeaconConfig().ZeroHash[:],
+			Amount:                0,
+			Signature:             zeroSig[:],
+		}, Proof: [][]byte{root}}, 100+i, int64(i), bytesutil.ToBytes32(root)))
+	}
+	assert.NoError(t, service.insertFinalizedDeposits(ctx, [32]byte{'m', 'o', 'c', 'k'}))
+	snapshot := depositCache.DepositSnapshot(ctx)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, uint64(6), snapshot.DepositCount)
+	assert.Equal(t, uint64(105), snapshot.ExecutionBlockHeight)
+	ctrs := depositCache.AllDepositContainers(ctx)
+	require.Equal(t, 6, len(ctrs))
+	assert.Equal(t, int64(6), ctrs[0].Index)
+	assert.Equal(t, 9, int(depositCache.FinalizedDeposits(ctx).MerkleTrieIndex))
+}
+
+func TestInsertSlashingsToForkChoiceStore(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	cfg := &Config{
+		BeaconDB:        beaconDB,
+		StateGen:        stategen.New(beaconDB),
+		ForkChoiceStore: protoarray.New(0, 0, params.BeaconConfig().ZeroHash),
+	}
+	service, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+
+	// Validator 0 outweighs validator 1, so block 1 is the head.
+	balances := []uint64{2, 1}
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, params.BeaconConfig().ZeroHash, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'b'}, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	service.cfg.ForkChoiceStore.ProcessAttestation(ctx, []uint64{0}, [32]byte{'a'}, 1)
+	service.cfg.ForkChoiceStore.ProcessAttestation(ctx, []uint64{1}, [32]byte{'b'}, 1)
+	head, err := service.cfg.ForkChoiceStore.Head(ctx, 0, params.BeaconConfig().ZeroHash, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'a'}, head)
+
+	// Only validator 0 attested in both attestations of the slashing.
+	slashing := &ethpb.AttesterSlashing{
+		Attestation_1: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{0, 2}}),
+		Attestation_2: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{0, 1}}),
+	}
+	service.insertSlashingsToForkChoiceStore(ctx, []*ethpb.AttesterSlashing{slashing})
+	head, err = service.cfg.ForkChoiceStore.Head(ctx, 0, params.BeaconConfig().ZeroHash, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'b'}, head)
+}
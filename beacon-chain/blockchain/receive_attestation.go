@@ -118,7 +118,20 @@ func (s *Service) processAttestationsRoutine(subscribedToStateEvents chan<- stru
 		select {
 		case <-s.ctx.Done():
 			return
-		case <-st.C():
+		case slot := <-st.C():
+			// The proposer boost only applies to the slot of the boosted block.
+			s.cfg.ForkChoiceStore.ResetBoostedProposerRoot(s.ctx)
+			// Remove the weight of validators that equivocated according to the pending attester slashings.
+			if s.hasHeadState() {
+				s.insertSlashingsToForkChoiceStore(s.ctx, s.cfg.SlashingPool.PendingAttesterSlashings(s.ctx, s.headState(s.ctx), true /*noLimit*/))
+			}
+			// Save the fork choice store every epoch, so it can be restored after an unclean shutdown.
+			if helpers.IsEpochStart(slot) {
+				if err := s.saveForkChoiceStore(s.ctx); err != nil {
+					log.WithError(err).Error("Could not save fork choice store")
+				}
+			}
+
 			// Continue when there's no fork choice attestation, there's nothing to process and update head.
 			// This covers the condition when the node is still initial syncing to the head of the chain.
 			if s.cfg.AttPool.ForkchoiceAttestationCount() == 0 {
@@ -65,6 +65,8 @@ type Service struct {
 	justifiedBalances     []uint64
 	justifiedBalancesLock sync.RWMutex
 	wsVerified            bool
+	// proposalPreparationSubs is the number of consumers of proposal preparation events, accessed atomically.
+	proposalPreparationSubs int32
 }
 
 // Config options for the service.
@@ -143,16 +145,19 @@ func (s *Service) Start() {
 			log.Fatalf("Could not set up chain info: %v", err)
 		}
 
-		// We start a counter to genesis, if needed.
+		// We start a counter to genesis, if needed. A node synced from a
+		// checkpoint may not have the genesis state, which is then long past.
 		gState, err := s.cfg.BeaconDB.GenesisState(s.ctx)
 		if err != nil {
 			log.Fatalf("Could not retrieve genesis state: %v", err)
 		}
-		gRoot, err := gState.HashTreeRoot(s.ctx)
-		if err != nil {
-			log.Fatalf("Could not hash tree root genesis state: %v", err)
+		if gState != nil && !gState.IsNil() {
+			gRoot, err := gState.HashTreeRoot(s.ctx)
+			if err != nil {
+				log.Fatalf("Could not hash tree root genesis state: %v", err)
+			}
+			go slotutil.CountdownToGenesis(s.ctx, s.genesisTime, uint64(gState.NumValidators()), gRoot)
 		}
-		go slotutil.CountdownToGenesis(s.ctx, s.genesisTime, uint64(gState.NumValidators()), gRoot)
 
 		justifiedCheckpoint, err := s.cfg.BeaconDB.JustifiedCheckpoint(s.ctx)
 		if err != nil {
@@ -172,7 +177,10 @@ func (s *Service) Start() {
 		s.bestJustifiedCheckpt = copyutil.CopyCheckpoint(justifiedCheckpoint)
 		s.finalizedCheckpt = copyutil.CopyCheckpoint(finalizedCheckpoint)
 		s.prevFinalizedCheckpt = copyutil.CopyCheckpoint(finalizedCheckpoint)
-		s.resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint)
+		s.resumeForkChoice(s.ctx, justifiedCheckpoint, finalizedCheckpoint)
+		if err := s.insertFinalizedBlockToForkChoice(s.ctx, justifiedCheckpoint, finalizedCheckpoint); err != nil {
+			log.Fatalf("Could not insert finalized block to fork choice store: %v", err)
+		}
 
 		ss, err := helpers.StartSlot(s.finalizedCheckpt.Epoch)
 		if err != nil {
@@ -316,7 +324,12 @@ func (s *Service) Stop() error {
 	}
 
 	// Save initial sync cached blocks to the DB before stop.
-	return s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
+	if err := s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks()); err != nil {
+		return err
+	}
+
+	// Save fork choice store after the blocks, as it is only restored if all of its blocks are in DB.
+	return s.saveForkChoiceStore(s.ctx)
 }
 
 // Status always returns nil unless there is an error condition that causes
@@ -380,14 +393,16 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not get genesis block from db")
 	}
-	if genesisBlock == nil || genesisBlock.IsNil() {
+	if genesisBlock != nil && !genesisBlock.IsNil() {
+		genesisBlkRoot, err := genesisBlock.Block().HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not get signing root of genesis block")
+		}
+		s.genesisRoot = genesisBlkRoot
+	} else if _, err := s.cfg.BeaconDB.OriginCheckpointBlockRoot(ctx); err != nil {
+		// Only a node synced from a checkpoint can be missing the genesis block.
 		return errors.New("no genesis block in db")
 	}
-	genesisBlkRoot, err := genesisBlock.Block().HashTreeRoot()
-	if err != nil {
-		return errors.Wrap(err, "could not get signing root of genesis block")
-	}
-	s.genesisRoot = genesisBlkRoot
 
 	finalized, err := s.cfg.BeaconDB.FinalizedCheckpoint(ctx)
 	if err != nil {
@@ -455,13 +470,42 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	return nil
 }
 
-// This is called when a client starts from non-genesis slot. This passes last justified and finalized
-// information to fork choice service to initializes fork choice store.
-func (s *Service) resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint *ethpb.Checkpoint) {
+// This is called when a client starts from non-genesis slot. This restores the fork choice store saved in DB,
+// or passes last justified and finalized information to fork choice service to initializes fork choice store.
+func (s *Service) resumeForkChoice(ctx context.Context, justifiedCheckpoint, finalizedCheckpoint *ethpb.Checkpoint) {
+	restored, err := s.restoreForkChoiceStore(ctx, finalizedCheckpoint)
+	if err != nil {
+		log.WithError(err).Warn("Could not restore fork choice store, rebuilding it from the finalized checkpoint")
+	}
+	if restored != nil {
+		log.WithField("nodes", len(restored.Nodes())).Info("Restored fork choice store")
+		s.cfg.ForkChoiceStore = restored
+		return
+	}
 	store := protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
 	s.cfg.ForkChoiceStore = store
 }
 
+// This inserts the finalized block in the fork choice store if it is missing, which is the case when
+// the node starts from the checkpoint it synced from, as the fork choice store is otherwise only
+// filled with the blocks after the finalized checkpoint.
+func (s *Service) insertFinalizedBlockToForkChoice(ctx context.Context, justifiedCheckpoint, finalizedCheckpoint *ethpb.Checkpoint) error {
+	finalizedRoot := bytesutil.ToBytes32(finalizedCheckpoint.Root)
+	if finalizedRoot == params.BeaconConfig().ZeroHash || s.cfg.ForkChoiceStore.HasNode(finalizedRoot) {
+		return nil
+	}
+	finalizedBlock, err := s.cfg.BeaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block from db")
+	}
+	if finalizedBlock == nil || finalizedBlock.IsNil() {
+		return errors.New("finalized block can't be nil")
+	}
+	b := finalizedBlock.Block()
+	return s.cfg.ForkChoiceStore.ProcessBlock(ctx, b.Slot(), finalizedRoot, bytesutil.ToBytes32(b.ParentRoot()),
+		bytesutil.ToBytes32(b.Body().Graffiti()), justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch)
+}
+
 // This returns true if block has been processed before. Two ways to verify the block has been processed:
 // 1.) Check fork choice store.
 // 2.) Check DB.
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -52,6 +53,7 @@ type ChainService struct {
 	ForkChoiceStore             *protoarray.Store
 	VerifyBlkDescendantErr      error
 	Slot                        *types.Slot // Pointer because 0 is a useful value, so checking against it can be incorrect.
+	ProposalPreparationSubs     int32
 }
 
 // StateNotifier mocks the same method in the chain service.
@@ -334,6 +336,14 @@ func (s *ChainService) CurrentSlot() types.Slot {
 	return types.Slot(uint64(time.Now().Unix()-s.Genesis.Unix()) / params.BeaconConfig().SecondsPerSlot)
 }
 
+// SubscribeProposalPreparation mocks the same method in the chain service.
+func (s *ChainService) SubscribeProposalPreparation() func() {
+	atomic.AddInt32(&s.ProposalPreparationSubs, 1)
+	return func() {
+		atomic.AddInt32(&s.ProposalPreparationSubs, -1)
+	}
+}
+
 // Participation mocks the same method in the chain service.
 func (s *ChainService) Participation(_ uint64) *precompute.Balance {
 	return s.Balance
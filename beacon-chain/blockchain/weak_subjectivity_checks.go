@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -29,6 +31,27 @@ func (s *Service) VerifyWeakSubjectivityRoot(ctx context.Context) error {
 
 	r := bytesutil.ToBytes32(s.cfg.WeakSubjectivityCheckpt.Root)
 	log.Infof("Performing weak subjectivity check for root %#x in epoch %d", r, s.cfg.WeakSubjectivityCheckpt.Epoch)
+	// A node synced from a checkpoint has no blocks before that checkpoint.
+	originRoot, err := s.cfg.BeaconDB.OriginCheckpointBlockRoot(ctx)
+	if err != nil && !errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		return err
+	}
+	if err == nil {
+		if r == originRoot {
+			log.Info("Weak subjectivity check has passed")
+			s.wsVerified = true
+			return nil
+		}
+		originBlock, err := s.cfg.BeaconDB.Block(ctx, originRoot)
+		if err != nil {
+			return err
+		}
+		if originBlock != nil && !originBlock.IsNil() &&
+			s.cfg.WeakSubjectivityCheckpt.Epoch <= helpers.SlotToEpoch(originBlock.Block().Slot()) {
+			return fmt.Errorf("weak subjectivity checkpoint in epoch %d precedes the checkpoint the node synced from",
+				s.cfg.WeakSubjectivityCheckpt.Epoch)
+		}
+	}
 	// Save initial sync cached blocks to DB.
 	if err := s.cfg.BeaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
 		return err
@@ -80,3 +80,36 @@ func TestService_VerifyWeakSubjectivityRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestService_VerifyWeakSubjectivityRoot_Origin(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(64))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 64
+	b.Block.StateRoot = stateRoot[:]
+	require.NoError(t, beaconDB.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	s := &Service{
+		cfg:              &Config{BeaconDB: beaconDB, WeakSubjectivityCheckpt: &ethpb.Checkpoint{Root: r[:], Epoch: 2}},
+		finalizedCheckpt: &ethpb.Checkpoint{Epoch: 3},
+	}
+	require.NoError(t, s.VerifyWeakSubjectivityRoot(ctx))
+	require.Equal(t, true, s.wsVerified)
+
+	s = &Service{
+		cfg: &Config{
+			BeaconDB:                beaconDB,
+			WeakSubjectivityCheckpt: &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{'a'}, 32), Epoch: 1},
+		},
+		finalizedCheckpt: &ethpb.Checkpoint{Epoch: 3},
+	}
+	require.ErrorContains(t, "precedes the checkpoint the node synced from", s.VerifyWeakSubjectivityRoot(ctx))
+}
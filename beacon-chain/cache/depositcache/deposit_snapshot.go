@@ -0,0 +1,143 @@
+package depositcache
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// SnapshotFinalizedDeposits takes a snapshot of the first depositCount deposits, which must
+// already be part of the finalized deposits trie. This method does nothing if the current
+// snapshot already covers as many deposits.
+func (dc *DepositCache) SnapshotFinalizedDeposits(ctx context.Context, depositCount uint64) error {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.SnapshotFinalizedDeposits")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if depositCount == 0 || (dc.depositSnapshot != nil && depositCount <= dc.depositSnapshot.DepositCount) {
+		return nil
+	}
+	lastIndex := int64(depositCount) - 1
+	if lastIndex > dc.finalizedDeposits.MerkleTrieIndex {
+		return errors.Errorf("cannot snapshot %d deposits, only %d deposits are finalized", depositCount, dc.finalizedDeposits.MerkleTrieIndex+1)
+	}
+	position := lastIndex - dc.firstDepositIndex
+	if position < 0 || position >= int64(len(dc.deposits)) {
+		return errors.Errorf("deposit with index %d is not in the cache", lastIndex)
+	}
+	finalized, err := dc.finalizedDeposits.Deposits.FinalizedHashes(depositCount)
+	if err != nil {
+		return errors.Wrap(err, "could not compute finalized deposit hashes")
+	}
+	last := dc.deposits[position]
+	dc.depositSnapshot = &dbpb.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          bytesutil.SafeCopyBytes(last.DepositRoot),
+		DepositCount:         depositCount,
+		ExecutionBlockHeight: last.Eth1BlockHeight,
+	}
+	return nil
+}
+
+// DepositSnapshot returns a copy of the latest snapshot of the finalized deposits, or nil if
+// none was taken yet.
+func (dc *DepositCache) DepositSnapshot(ctx context.Context) *dbpb.DepositSnapshot {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositSnapshot")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	if dc.depositSnapshot == nil {
+		return nil
+	}
+	return proto.Clone(dc.depositSnapshot).(*dbpb.DepositSnapshot)
+}
+
+// PruneFinalizedDeposits removes the containers of all deposits covered by the latest snapshot.
+// The pruned deposits can no longer be looked up, but their contribution to the deposit
+// trie is kept by the snapshot.
+func (dc *DepositCache) PruneFinalizedDeposits(ctx context.Context) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.PruneFinalizedDeposits")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if dc.depositSnapshot == nil {
+		return
+	}
+	pruneCount := int64(dc.depositSnapshot.DepositCount) - dc.firstDepositIndex
+	if pruneCount <= 0 {
+		return
+	}
+	if pruneCount > int64(len(dc.deposits)) {
+		pruneCount = int64(len(dc.deposits))
+	}
+	// Copy the remaining deposits so that the pruned ones can be garbage collected.
+	remaining := make([]*dbpb.DepositContainer, int64(len(dc.deposits))-pruneCount)
+	copy(remaining, dc.deposits[pruneCount:])
+	dc.deposits = remaining
+	dc.firstDepositIndex += pruneCount
+
+	log.WithFields(logrus.Fields{
+		"prunedDeposits":    pruneCount,
+		"firstDepositIndex": dc.firstDepositIndex,
+	}).Debug("Pruned finalized deposits")
+}
+
+// InsertDepositSnapshot restores the finalized deposits from a snapshot. The deposits it covers
+// do not need to be in the cache, the following deposits are expected to be inserted afterwards.
+// This method does nothing if the current snapshot already covers as many deposits.
+func (dc *DepositCache) InsertDepositSnapshot(ctx context.Context, snapshot *dbpb.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertDepositSnapshot")
+	defer span.End()
+	if snapshot == nil {
+		return errors.New("nil deposit snapshot")
+	}
+	depositTrie, err := DepositTrieFromSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if dc.depositSnapshot != nil && snapshot.DepositCount <= dc.depositSnapshot.DepositCount {
+		return nil
+	}
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        depositTrie,
+		MerkleTrieIndex: int64(snapshot.DepositCount) - 1,
+	}
+	dc.depositSnapshot = proto.Clone(snapshot).(*dbpb.DepositSnapshot)
+	if len(dc.deposits) == 0 {
+		dc.firstDepositIndex = int64(snapshot.DepositCount)
+	}
+	return nil
+}
+
+// DepositTrieFromSnapshot rebuilds the deposit trie described by a snapshot and checks
+// that its root matches the one of the snapshot.
+func DepositTrieFromSnapshot(snapshot *dbpb.DepositSnapshot) (*trieutil.SparseMerkleTrie, error) {
+	depositTrie, err := trieutil.GenerateTrieFromFinalizedHashes(
+		snapshot.Finalized,
+		snapshot.DepositCount,
+		params.BeaconConfig().DepositContractTreeDepth,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not rebuild deposit trie from snapshot")
+	}
+	root := depositTrie.Root()
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return nil, errors.Errorf("deposit snapshot root %#x does not match the root %#x of its finalized deposits", snapshot.DepositRoot, root)
+	}
+	return depositTrie, nil
+}
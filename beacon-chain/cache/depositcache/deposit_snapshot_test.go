@@ -0,0 +1,120 @@
+package depositcache
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// insertDeposits inserts count deposits into the cache, the deposit with index i being
+// included in eth1 block i, and returns the resulting deposit trie.
+func insertDeposits(t *testing.T, dc *DepositCache, count int) *trieutil.SparseMerkleTrie {
+	depositTrie, err := trieutil.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := 0; i < count; i++ {
+		d := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+			Proof: makeDepositProof(),
+		}
+		h, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		depositTrie.Insert(h[:], i)
+		require.NoError(t, dc.InsertDeposit(context.Background(), d, uint64(i), int64(i), depositTrie.Root()))
+	}
+	return depositTrie
+}
+
+func TestDepositSnapshot_PruneAndRestore(t *testing.T) {
+	ctx := context.Background()
+	dc, err := New()
+	require.NoError(t, err)
+	depositTrie := insertDeposits(t, dc, 10)
+	assert.Equal(t, true, dc.DepositSnapshot(ctx) == nil, "Unexpected snapshot")
+
+	dc.InsertFinalizedDeposits(ctx, 7)
+	require.NoError(t, dc.SnapshotFinalizedDeposits(ctx, 6))
+	snapshot := dc.DepositSnapshot(ctx)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, uint64(6), snapshot.DepositCount)
+	assert.Equal(t, uint64(5), snapshot.ExecutionBlockHeight)
+	assert.DeepEqual(t, dc.deposits[5].DepositRoot, snapshot.DepositRoot)
+
+	// Taking an older snapshot keeps the current one.
+	require.NoError(t, dc.SnapshotFinalizedDeposits(ctx, 4))
+	assert.Equal(t, uint64(6), dc.DepositSnapshot(ctx).DepositCount)
+
+	dc.PruneFinalizedDeposits(ctx)
+	ctrs := dc.AllDepositContainers(ctx)
+	require.Equal(t, 4, len(ctrs))
+	assert.Equal(t, int64(6), ctrs[0].Index)
+	assert.Equal(t, uint64(10), dc.DepositCount(ctx))
+	assert.ErrorContains(t, "wanted deposit with index 10 to be inserted but received 5", dc.InsertDeposit(ctx, &ethpb.Deposit{}, 5, 5, [32]byte{}))
+
+	// The snapshot answers for the heights of the pruned deposits.
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(5))
+	assert.Equal(t, uint64(6), count)
+	assert.Equal(t, bytesutil.ToBytes32(snapshot.DepositRoot), root)
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(8))
+	assert.Equal(t, uint64(9), count)
+	assert.Equal(t, bytesutil.ToBytes32(ctrs[2].DepositRoot), root)
+
+	require.NoError(t, dc.PruneProofs(ctx, 7))
+	assert.Equal(t, true, ctrs[0].Deposit.Proof == nil)
+	assert.Equal(t, true, ctrs[1].Deposit.Proof == nil)
+	assert.NotNil(t, ctrs[2].Deposit.Proof)
+
+	// A new cache restored from the snapshot and the remaining deposits has the same trie.
+	restored, err := New()
+	require.NoError(t, err)
+	require.NoError(t, restored.InsertDepositSnapshot(ctx, snapshot))
+	restored.InsertDepositContainers(ctx, ctrs)
+	restored.InsertFinalizedDeposits(ctx, 9)
+	finalized := restored.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(9), finalized.MerkleTrieIndex)
+	assert.Equal(t, depositTrie.HashTreeRoot(), finalized.Deposits.HashTreeRoot())
+	count, _ = restored.DepositsNumberAndRootAtHeight(ctx, big.NewInt(9))
+	assert.Equal(t, uint64(10), count)
+}
+
+func TestSnapshotFinalizedDeposits_NotFinalized(t *testing.T) {
+	ctx := context.Background()
+	dc, err := New()
+	require.NoError(t, err)
+	insertDeposits(t, dc, 5)
+	dc.InsertFinalizedDeposits(ctx, 2)
+
+	assert.ErrorContains(t, "cannot snapshot 4 deposits, only 3 deposits are finalized", dc.SnapshotFinalizedDeposits(ctx, 4))
+	assert.Equal(t, true, dc.DepositSnapshot(ctx) == nil, "Unexpected snapshot")
+}
+
+func TestInsertDepositSnapshot_InvalidRoot(t *testing.T) {
+	ctx := context.Background()
+	dc, err := New()
+	require.NoError(t, err)
+	insertDeposits(t, dc, 5)
+	dc.InsertFinalizedDeposits(ctx, 4)
+	require.NoError(t, dc.SnapshotFinalizedDeposits(ctx, 5))
+
+	snapshot := dc.DepositSnapshot(ctx)
+	snapshot.DepositRoot = make([]byte, 32)
+	restored, err := New()
+	require.NoError(t, err)
+	assert.ErrorContains(t, "does not match the root", restored.InsertDepositSnapshot(ctx, snapshot))
+	assert.ErrorContains(t, "nil deposit snapshot", restored.InsertDepositSnapshot(ctx, nil))
+	assert.Equal(t, true, restored.DepositSnapshot(ctx) == nil, "Unexpected snapshot")
+
+	_, err = DepositTrieFromSnapshot(&dbpb.DepositSnapshot{DepositCount: 5})
+	assert.ErrorContains(t, "could not rebuild deposit trie from snapshot", err)
+}
@@ -34,8 +34,10 @@ var (
 // DepositFetcher defines a struct which can retrieve deposit information from a store.
 type DepositFetcher interface {
 	AllDeposits(ctx context.Context, untilBlk *big.Int) []*ethpb.Deposit
+	AllDepositContainers(ctx context.Context) []*dbpb.DepositContainer
 	DepositByPubkey(ctx context.Context, pubKey []byte) (*ethpb.Deposit, *big.Int)
 	DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *big.Int) (uint64, [32]byte)
+	DepositSnapshot(ctx context.Context) *dbpb.DepositSnapshot
 	FinalizedDeposits(ctx context.Context) *FinalizedDeposits
 	NonFinalizedDeposits(ctx context.Context, untilBlk *big.Int) []*ethpb.Deposit
 }
@@ -54,6 +56,10 @@ type DepositCache struct {
 	pendingDeposits   []*dbpb.DepositContainer
 	deposits          []*dbpb.DepositContainer
 	finalizedDeposits *FinalizedDeposits
+	// Snapshot of the finalized deposits, the containers of the deposits it covers may
+	// have been pruned, in which case deposits[0] has the index firstDepositIndex.
+	depositSnapshot   *dbpb.DepositSnapshot
+	firstDepositIndex int64
 	depositsLock      sync.RWMutex
 }
 
@@ -90,8 +96,8 @@ func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blo
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if int(index) != len(dc.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(dc.deposits), index)
+	if wanted := dc.firstDepositIndex + int64(len(dc.deposits)); index != wanted {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", wanted, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= index })
@@ -112,6 +118,13 @@ func (dc *DepositCache) InsertDepositContainers(ctx context.Context, ctrs []*dbp
 
 	sort.SliceStable(ctrs, func(i int, j int) bool { return ctrs[i].Index < ctrs[j].Index })
 	dc.deposits = ctrs
+	dc.firstDepositIndex = 0
+	if dc.depositSnapshot != nil {
+		dc.firstDepositIndex = int64(dc.depositSnapshot.DepositCount)
+	}
+	if len(ctrs) > 0 {
+		dc.firstDepositIndex = ctrs[0].Index
+	}
 	historicalDepositsCount.Add(float64(len(ctrs)))
 }
 
@@ -122,6 +135,10 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
+	// The finalized deposits may already be ahead, e.g. when restored from a snapshot.
+	if eth1DepositIndex <= dc.finalizedDeposits.MerkleTrieIndex {
+		return
+	}
 	depositTrie := dc.finalizedDeposits.Deposits
 	insertIndex := int(dc.finalizedDeposits.MerkleTrieIndex + 1)
 	for _, d := range dc.deposits {
@@ -173,6 +190,17 @@ func (dc *DepositCache) AllDeposits(ctx context.Context, untilBlk *big.Int) []*e
 	return deposits
 }
 
+// DepositCount returns the number of deposits processed so far, including the ones
+// which were pruned after being covered by the deposit snapshot.
+func (dc *DepositCache) DepositCount(ctx context.Context) uint64 {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositCount")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	return uint64(dc.firstDepositIndex) + uint64(len(dc.deposits))
+}
+
 // DepositsNumberAndRootAtHeight returns number of deposits made up to blockheight and the
 // root that corresponds to the latest deposit at that blockheight.
 func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *big.Int) (uint64, [32]byte) {
@@ -184,9 +212,13 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
+		// The earlier deposits may have been pruned, in which case the snapshot describes them.
+		if s := dc.depositSnapshot; s != nil && dc.firstDepositIndex > 0 && blockHeight.Uint64() >= s.ExecutionBlockHeight {
+			return s.DepositCount, bytesutil.ToBytes32(s.DepositRoot)
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	return uint64(dc.firstDepositIndex) + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -252,11 +284,13 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(dc.deposits)) {
-		untilDepositIndex = int64(len(dc.deposits) - 1)
+	// Deposits are stored by index, starting after the pruned ones.
+	untilPosition := untilDepositIndex - dc.firstDepositIndex
+	if untilPosition >= int64(len(dc.deposits)) {
+		untilPosition = int64(len(dc.deposits) - 1)
 	}
 
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := untilPosition; i >= 0; i-- {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
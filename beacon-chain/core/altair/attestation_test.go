@@ -0,0 +1,79 @@
+package altair
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestValidatorFlag_Has(t *testing.T) {
+	cfg := params.BeaconConfig()
+	tests := []struct {
+		name     string
+		set      uint8
+		expected []uint8
+	}{
+		{name: "none", set: 0, expected: []uint8{}},
+		{name: "source", set: 1, expected: []uint8{cfg.TimelySourceFlagIndex}},
+		{name: "source, target", set: 3, expected: []uint8{cfg.TimelySourceFlagIndex, cfg.TimelyTargetFlagIndex}},
+		{name: "source, target, head", set: 7, expected: []uint8{cfg.TimelySourceFlagIndex, cfg.TimelyTargetFlagIndex, cfg.TimelyHeadFlagIndex}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, f := range tt.expected {
+				assert.Equal(t, true, HasValidatorFlag(tt.set, f))
+			}
+		})
+	}
+	assert.Equal(t, false, HasValidatorFlag(1, cfg.TimelyHeadFlagIndex))
+}
+
+func TestValidatorFlag_Add(t *testing.T) {
+	cfg := params.BeaconConfig()
+	tests := []struct {
+		name          string
+		set           []uint8
+		expectedTrue  []uint8
+		expectedFalse []uint8
+	}{
+		{
+			name:          "none",
+			set:           []uint8{},
+			expectedTrue:  []uint8{},
+			expectedFalse: []uint8{cfg.TimelySourceFlagIndex, cfg.TimelyTargetFlagIndex, cfg.TimelyHeadFlagIndex},
+		},
+		{
+			name:          "source",
+			set:           []uint8{cfg.TimelySourceFlagIndex},
+			expectedTrue:  []uint8{cfg.TimelySourceFlagIndex},
+			expectedFalse: []uint8{cfg.TimelyTargetFlagIndex, cfg.TimelyHeadFlagIndex},
+		},
+		{
+			name:          "source, target",
+			set:           []uint8{cfg.TimelySourceFlagIndex, cfg.TimelyTargetFlagIndex},
+			expectedTrue:  []uint8{cfg.TimelySourceFlagIndex, cfg.TimelyTargetFlagIndex},
+			expectedFalse: []uint8{cfg.TimelyHeadFlagIndex},
+		},
+		{
+			name:          "source, target, head",
+			set:           []uint8{cfg.TimelySourceFlagIndex, cfg.TimelyTargetFlagIndex, cfg.TimelyHeadFlagIndex},
+			expectedTrue:  []uint8{cfg.TimelySourceFlagIndex, cfg.TimelyTargetFlagIndex, cfg.TimelyHeadFlagIndex},
+			expectedFalse: []uint8{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := uint8(0)
+			for _, f := range tt.set {
+				b = AddValidatorFlag(b, f)
+			}
+			for _, f := range tt.expectedFalse {
+				assert.Equal(t, false, HasValidatorFlag(b, f))
+			}
+			for _, f := range tt.expectedTrue {
+				assert.Equal(t, true, HasValidatorFlag(b, f))
+			}
+		})
+	}
+}
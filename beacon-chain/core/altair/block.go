@@ -0,0 +1,142 @@
+package altair
+
+import (
+	"errors"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ProcessSyncAggregate verifies sync committee aggregate signature signing over the previous slot block root.
+//
+// Spec code:
+//  def process_sync_aggregate(state: BeaconState, sync_aggregate: SyncAggregate) -> None:
+//    # Verify sync committee aggregate signature signing over the previous slot block root
+//    committee_pubkeys = state.current_sync_committee.pubkeys
+//    participant_pubkeys = [pubkey for pubkey, bit in zip(committee_pubkeys, sync_aggregate.sync_committee_bits) if bit]
+//    previous_slot = max(state.slot, Slot(1)) - Slot(1)
+//    domain = get_domain(state, DOMAIN_SYNC_COMMITTEE, compute_epoch_at_slot(previous_slot))
+//    signing_root = compute_signing_root(get_block_root_at_slot(state, previous_slot), domain)
+//    assert eth2_fast_aggregate_verify(participant_pubkeys, signing_root, sync_aggregate.sync_committee_signature)
+//
+//    # Compute participant and proposer rewards
+//    total_active_increments = get_total_active_balance(state) // EFFECTIVE_BALANCE_INCREMENT
+//    total_base_rewards = Gwei(get_base_reward_per_increment(state) * total_active_increments)
+//    max_participant_rewards = Gwei(total_base_rewards * SYNC_REWARD_WEIGHT // WEIGHT_DENOMINATOR // SLOTS_PER_EPOCH)
+//    participant_reward = Gwei(max_participant_rewards // SYNC_COMMITTEE_SIZE)
+//    proposer_reward = Gwei(participant_reward * PROPOSER_WEIGHT // (WEIGHT_DENOMINATOR - PROPOSER_WEIGHT))
+//
+//    # Apply participant and proposer rewards
+//    all_pubkeys = [v.pubkey for v in state.validators]
+//    committee_indices = [ValidatorIndex(all_pubkeys.index(pubkey)) for pubkey in state.current_sync_committee.pubkeys]
+//    for participant_index, participation_bit in zip(committee_indices, sync_aggregate.sync_committee_bits):
+//        if participation_bit:
+//            increase_balance(state, participant_index, participant_reward)
+//            increase_balance(state, get_beacon_proposer_index(state), proposer_reward)
+//        else:
+//            decrease_balance(state, participant_index, participant_reward)
+func ProcessSyncAggregate(s state.BeaconState, sync *prysmv2.SyncAggregate) (state.BeaconState, error) {
+	if sync == nil {
+		return nil, errors.New("nil sync aggregate")
+	}
+	currentSyncCommittee, err := s.CurrentSyncCommittee()
+	if err != nil {
+		return nil, err
+	}
+	if currentSyncCommittee == nil {
+		return nil, errors.New("nil current sync committee in state")
+	}
+	committeeKeys := currentSyncCommittee.Pubkeys
+	votedKeys := make([]bls.PublicKey, 0, len(committeeKeys))
+	committeeIndices := make([]types.ValidatorIndex, 0, len(committeeKeys))
+	// Verify sync committee signature.
+	for i := uint64(0); i < uint64(len(committeeKeys)); i++ {
+		vIdx, exists := s.ValidatorIndexByPubkey(bytesutil.ToBytes48(committeeKeys[i]))
+		// Impossible scenario.
+		if !exists {
+			return nil, errors.New("validator public key does not exist in state")
+		}
+
+		committeeIndices = append(committeeIndices, vIdx)
+		if sync.SyncCommitteeBits.BitAt(i) {
+			pubKey, err := bls.PublicKeyFromBytes(committeeKeys[i])
+			if err != nil {
+				return nil, err
+			}
+			votedKeys = append(votedKeys, pubKey)
+		}
+	}
+	ps := helpers.PrevSlot(s.Slot())
+	d, err := helpers.Domain(s.Fork(), helpers.SlotToEpoch(ps), params.BeaconConfig().DomainSyncCommittee, s.GenesisValidatorRoot())
+	if err != nil {
+		return nil, err
+	}
+	pbr, err := helpers.BlockRootAtSlot(s, ps)
+	if err != nil {
+		return nil, err
+	}
+	sszBytes := bytesutil.ToBytes32(pbr)
+	r, err := (&statepb.SigningData{ObjectRoot: sszBytes[:], Domain: d}).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.SignatureFromBytes(sync.SyncCommitteeSignature)
+	if err != nil {
+		return nil, err
+	}
+	if !sig.Eth2FastAggregateVerify(votedKeys, r) {
+		return nil, errors.New("could not verify sync committee signature")
+	}
+
+	// Calculate sync committee rewards and apply them.
+	activeBalance, err := TotalActiveBalance(s)
+	if err != nil {
+		return nil, err
+	}
+	proposerReward, participantReward, err := SyncRewards(activeBalance)
+	if err != nil {
+		return nil, err
+	}
+	proposerIndex, err := helpers.BeaconProposerIndex(s)
+	if err != nil {
+		return nil, err
+	}
+	// Rewards and penalties are applied in committee order, as a decrease
+	// is floored at zero and the proposer may also be a committee member.
+	for i, index := range committeeIndices {
+		if sync.SyncCommitteeBits.BitAt(uint64(i)) {
+			if err := helpers.IncreaseBalance(s, index, participantReward); err != nil {
+				return nil, err
+			}
+			if err := helpers.IncreaseBalance(s, proposerIndex, proposerReward); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := helpers.DecreaseBalance(s, index, participantReward); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+// SyncRewards returns the proposer reward and the sync participant reward given the total active balance in state.
+func SyncRewards(activeBalance uint64) (proposerReward, participantReward uint64, err error) {
+	cfg := params.BeaconConfig()
+	totalActiveIncrements := activeBalance / cfg.EffectiveBalanceIncrement
+	baseRewardPerInc, err := BaseRewardPerIncrement(activeBalance)
+	if err != nil {
+		return 0, 0, err
+	}
+	totalBaseRewards := baseRewardPerInc * totalActiveIncrements
+	maxParticipantRewards := totalBaseRewards * cfg.SyncRewardWeight / cfg.WeightDenominator / uint64(cfg.SlotsPerEpoch)
+	participantReward = maxParticipantRewards / cfg.SyncCommitteeSize
+	proposerReward = participantReward * cfg.ProposerWeight / (cfg.WeightDenominator - cfg.ProposerWeight)
+	return
+}
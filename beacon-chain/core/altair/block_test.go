@@ -0,0 +1,49 @@
+package altair
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncRewards(t *testing.T) {
+	tests := []struct {
+		name                  string
+		activeBalance         uint64
+		wantProposerReward    uint64
+		wantParticipantReward uint64
+		errString             string
+	}{
+		{
+			name:          "active balance is 0",
+			activeBalance: 0,
+			errString:     "active balance can't be 0",
+		},
+		{
+			name:                  "64 validators",
+			activeBalance:         params.BeaconConfig().MaxEffectiveBalance * 64,
+			wantProposerReward:    24,
+			wantParticipantReward: 174,
+		},
+		{
+			name:                  "16384 validators",
+			activeBalance:         params.BeaconConfig().MaxEffectiveBalance * 16384,
+			wantProposerReward:    399,
+			wantParticipantReward: 2795,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proposerReward, participantReward, err := SyncRewards(tt.activeBalance)
+			if tt.errString != "" {
+				require.ErrorContains(t, tt.errString, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProposerReward, proposerReward)
+			assert.Equal(t, tt.wantParticipantReward, participantReward)
+		})
+	}
+}
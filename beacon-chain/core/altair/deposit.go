@@ -0,0 +1,72 @@
+package altair
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ProcessDeposits processes validator deposits for beacon state Altair.
+func ProcessDeposits(
+	ctx context.Context,
+	beaconState state.BeaconState,
+	deposits []*ethpb.Deposit,
+) (state.BeaconState, error) {
+	batchVerified, err := blocks.BatchVerifyDepositsSignatures(ctx, deposits)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, deposit := range deposits {
+		if deposit == nil || deposit.Data == nil {
+			return nil, errors.New("got a nil deposit in block")
+		}
+		beaconState, err = ProcessDeposit(beaconState, deposit, batchVerified)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process deposit from %#x", bytesutil.Trunc(deposit.Data.PublicKey))
+		}
+	}
+	return beaconState, nil
+}
+
+// ProcessDeposit processes validator deposit for beacon state Altair.
+//
+// Spec code:
+//  def add_validator_to_registry(state: BeaconState,
+//                                pubkey: BLSPubkey,
+//                                withdrawal_credentials: Bytes32,
+//                                amount: uint64) -> None:
+//    index = get_index_for_new_validator(state)
+//    validator = get_validator_from_deposit(pubkey, withdrawal_credentials, amount)
+//    set_or_append_list(state.validators, index, validator)
+//    set_or_append_list(state.balances, index, amount)
+//    # [New in Altair]
+//    set_or_append_list(state.previous_epoch_participation, index, ParticipationFlags(0b0000_0000))
+//    set_or_append_list(state.current_epoch_participation, index, ParticipationFlags(0b0000_0000))
+//    set_or_append_list(state.inactivity_scores, index, uint64(0))
+func ProcessDeposit(beaconState state.BeaconState, deposit *ethpb.Deposit, verifySignature bool) (state.BeaconState, error) {
+	numVals := beaconState.NumValidators()
+	beaconState, err := blocks.ProcessDeposit(beaconState, deposit, verifySignature)
+	if err != nil {
+		return nil, err
+	}
+	// Only a new validator being added to the registry requires
+	// the Altair specific fields to be extended.
+	if beaconState.NumValidators() == numVals {
+		return beaconState, nil
+	}
+	if err := beaconState.AppendPreviousParticipationBits(0); err != nil {
+		return nil, err
+	}
+	if err := beaconState.AppendCurrentParticipationBits(0); err != nil {
+		return nil, err
+	}
+	if err := beaconState.AppendInactivityScore(0); err != nil {
+		return nil, err
+	}
+	return beaconState, nil
+}
@@ -0,0 +1,102 @@
+package altair_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProcessDeposits_AddsNewValidatorDeposit(t *testing.T) {
+	dep, _, err := testutil.DeterministicDepositsAndKeys(1)
+	require.NoError(t, err)
+	eth1Data, err := testutil.DeterministicEth1Data(len(dep))
+	require.NoError(t, err)
+
+	registry := []*ethpb.Validator{
+		{
+			PublicKey:             []byte{1},
+			WithdrawalCredentials: []byte{1, 2, 3},
+		},
+	}
+	beaconState, err := stateAltair.InitializeFromProto(&statepb.BeaconStateAltair{
+		Validators:                 registry,
+		Balances:                   []uint64{0},
+		PreviousEpochParticipation: []byte{0},
+		CurrentEpochParticipation:  []byte{0},
+		InactivityScores:           []uint64{0},
+		Eth1Data:                   eth1Data,
+		Fork: &statepb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+		},
+	})
+	require.NoError(t, err)
+	newState, err := altair.ProcessDeposits(context.Background(), beaconState, []*ethpb.Deposit{dep[0]})
+	require.NoError(t, err, "Expected block deposits to process correctly")
+
+	require.Equal(t, 2, newState.NumValidators())
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, newState.Balances()[1])
+	prev, err := newState.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{0, 0}, prev)
+	curr, err := newState.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{0, 0}, curr)
+	scores, err := newState.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{0, 0}, scores)
+}
+
+func TestProcessDeposit_ExistingValidatorTopsUpBalance(t *testing.T) {
+	dep, _, err := testutil.DeterministicDepositsAndKeys(1)
+	require.NoError(t, err)
+	eth1Data, err := testutil.DeterministicEth1Data(len(dep))
+	require.NoError(t, err)
+
+	registry := []*ethpb.Validator{
+		{
+			PublicKey:             dep[0].Data.PublicKey,
+			WithdrawalCredentials: dep[0].Data.WithdrawalCredentials,
+		},
+	}
+	beaconState, err := stateAltair.InitializeFromProto(&statepb.BeaconStateAltair{
+		Validators:                 registry,
+		Balances:                   []uint64{1},
+		PreviousEpochParticipation: []byte{7},
+		CurrentEpochParticipation:  []byte{7},
+		InactivityScores:           []uint64{3},
+		Eth1Data:                   eth1Data,
+		Fork: &statepb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+		},
+	})
+	require.NoError(t, err)
+	newState, err := altair.ProcessDeposit(beaconState, dep[0], true)
+	require.NoError(t, err)
+
+	// A top up must not extend or reset the Altair specific fields.
+	require.Equal(t, 1, newState.NumValidators())
+	assert.Equal(t, 1+dep[0].Data.Amount, newState.Balances()[0])
+	prev, err := newState.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{7}, prev)
+	scores, err := newState.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{3}, scores)
+}
+
+func TestProcessDeposits_NilDeposit(t *testing.T) {
+	beaconState, err := stateAltair.InitializeFromProto(&statepb.BeaconStateAltair{})
+	require.NoError(t, err)
+	_, err = altair.ProcessDeposits(context.Background(), beaconState, []*ethpb.Deposit{{}})
+	assert.ErrorContains(t, "got a nil deposit in block", err)
+}
@@ -0,0 +1,356 @@
+package altair
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// InitializeEpochValidators gets called at the beginning of process epoch cycle to return
+// pre computed instances of validators attesting records and total
+// balances attested in an epoch.
+func InitializeEpochValidators(ctx context.Context, st state.BeaconState) ([]*precompute.Validator, *precompute.Balance, error) {
+	ctx, span := trace.StartSpan(ctx, "altair.InitializeEpochValidators")
+	defer span.End()
+	pValidators := make([]*precompute.Validator, st.NumValidators())
+	bal := &precompute.Balance{}
+
+	prevEpoch := helpers.PrevEpoch(st)
+	currentEpoch := helpers.CurrentEpoch(st)
+	inactivityScores, err := st.InactivityScores()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// This shouldn't happen with a correct beacon state,
+	// but rather be safe to defend against index out of bound panics.
+	if st.NumValidators() != len(inactivityScores) {
+		return nil, nil, errors.New("num of validators is different than num of inactivity scores")
+	}
+	if err := st.ReadFromEveryValidator(func(idx int, val state.ReadOnlyValidator) error {
+		// Was validator withdrawable or slashed
+		withdrawable := prevEpoch+1 >= val.WithdrawableEpoch()
+		pVal := &precompute.Validator{
+			IsSlashed:                    val.Slashed(),
+			IsWithdrawableCurrentEpoch:   withdrawable,
+			CurrentEpochEffectiveBalance: val.EffectiveBalance(),
+			InactivityScore:              inactivityScores[idx],
+		}
+		// Validator active current epoch
+		if helpers.IsActiveValidatorUsingTrie(val, currentEpoch) {
+			pVal.IsActiveCurrentEpoch = true
+			bal.ActiveCurrentEpoch += val.EffectiveBalance()
+		}
+		// Validator active previous epoch
+		if helpers.IsActiveValidatorUsingTrie(val, prevEpoch) {
+			pVal.IsActivePrevEpoch = true
+			bal.ActivePrevEpoch += val.EffectiveBalance()
+		}
+
+		pValidators[idx] = pVal
+		return nil
+	}); err != nil {
+		return nil, nil, errors.Wrap(err, "could not read every validator")
+	}
+	return pValidators, bal, nil
+}
+
+// ProcessInactivityScores of beacon chain. This updates inactivity scores of beacon chain and
+// updates the precompute validator struct for later processing.
+//
+// Spec code:
+//  def process_inactivity_updates(state: BeaconState) -> None:
+//    # Skip the genesis epoch as score updates are based on the previous epoch participation
+//    if get_current_epoch(state) == GENESIS_EPOCH:
+//        return
+//
+//    for index in get_eligible_validator_indices(state):
+//        # Increase the inactivity score of inactive validators
+//        if index in get_unslashed_participating_indices(state, TIMELY_TARGET_FLAG_INDEX, get_previous_epoch(state)):
+//            state.inactivity_scores[index] -= min(1, state.inactivity_scores[index])
+//        else:
+//            state.inactivity_scores[index] += INACTIVITY_SCORE_BIAS
+//        # Decrease the inactivity score of all eligible validators during a leak-free epoch
+//        if not is_in_inactivity_leak(state):
+//            state.inactivity_scores[index] -= min(INACTIVITY_SCORE_RECOVERY_RATE, state.inactivity_scores[index])
+func ProcessInactivityScores(
+	ctx context.Context,
+	st state.BeaconState,
+	vals []*precompute.Validator,
+) (state.BeaconState, []*precompute.Validator, error) {
+	ctx, span := trace.StartSpan(ctx, "altair.ProcessInactivityScores")
+	defer span.End()
+
+	if helpers.CurrentEpoch(st) == params.BeaconConfig().GenesisEpoch {
+		return st, vals, nil
+	}
+
+	inactivityScores, err := st.InactivityScores()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(inactivityScores) != len(vals) {
+		return nil, nil, errors.New("num of validators is different than num of inactivity scores")
+	}
+
+	cfg := params.BeaconConfig()
+	inLeak := helpers.IsInInactivityLeak(helpers.PrevEpoch(st), st.FinalizedCheckpointEpoch())
+	for i, v := range vals {
+		if !precompute.EligibleForRewards(v) {
+			continue
+		}
+
+		if v.IsPrevEpochTargetAttester && !v.IsSlashed {
+			// Decrease inactivity score when validator gets target correct.
+			if v.InactivityScore > 0 {
+				v.InactivityScore--
+			}
+		} else {
+			v.InactivityScore += cfg.InactivityScoreBias
+		}
+
+		if !inLeak {
+			if v.InactivityScore < cfg.InactivityScoreRecoveryRate {
+				v.InactivityScore = 0
+			} else {
+				v.InactivityScore -= cfg.InactivityScoreRecoveryRate
+			}
+		}
+		inactivityScores[i] = v.InactivityScore
+	}
+
+	if err := st.SetInactivityScores(inactivityScores); err != nil {
+		return nil, nil, err
+	}
+
+	return st, vals, nil
+}
+
+// ProcessEpochParticipation processes the epoch participation in state and updates individual validator's pre computes,
+// it also tracks and updates epoch attesting balances.
+//
+// Spec code:
+//  def get_unslashed_participating_indices(state: BeaconState, flag_index: int, epoch: Epoch) -> Set[ValidatorIndex]:
+//    """
+//    Return the set of validator indices that are both active and unslashed for the given ``flag_index`` and ``epoch``.
+//    """
+//    assert epoch in (get_previous_epoch(state), get_current_epoch(state))
+//    if epoch == get_current_epoch(state):
+//        epoch_participation = state.current_epoch_participation
+//    else:
+//        epoch_participation = state.previous_epoch_participation
+//    active_validator_indices = get_active_validator_indices(state, epoch)
+//    participating_indices = [i for i in active_validator_indices if has_flag(epoch_participation[i], flag_index)]
+//    return set(filter(lambda index: not state.validators[index].slashed, participating_indices))
+func ProcessEpochParticipation(
+	ctx context.Context,
+	st state.BeaconState,
+	bal *precompute.Balance,
+	vals []*precompute.Validator,
+) ([]*precompute.Validator, *precompute.Balance, error) {
+	ctx, span := trace.StartSpan(ctx, "altair.ProcessEpochParticipation")
+	defer span.End()
+
+	cp, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, nil, err
+	}
+	pp, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(cp) != len(vals) || len(pp) != len(vals) {
+		return nil, nil, errors.New("num of validators is different than num of epoch participation bits")
+	}
+
+	cfg := params.BeaconConfig()
+	sourceIdx := cfg.TimelySourceFlagIndex
+	targetIdx := cfg.TimelyTargetFlagIndex
+	headIdx := cfg.TimelyHeadFlagIndex
+	for i, b := range cp {
+		if !vals[i].IsActiveCurrentEpoch {
+			continue
+		}
+		if HasValidatorFlag(b, sourceIdx) {
+			vals[i].IsCurrentEpochAttester = true
+		}
+		if HasValidatorFlag(b, targetIdx) {
+			vals[i].IsCurrentEpochTargetAttester = true
+		}
+	}
+	for i, b := range pp {
+		if !vals[i].IsActivePrevEpoch {
+			continue
+		}
+		if HasValidatorFlag(b, sourceIdx) {
+			vals[i].IsPrevEpochAttester = true
+		}
+		if HasValidatorFlag(b, targetIdx) {
+			vals[i].IsPrevEpochTargetAttester = true
+		}
+		if HasValidatorFlag(b, headIdx) {
+			vals[i].IsPrevEpochHeadAttester = true
+		}
+	}
+	bal = precompute.UpdateBalance(vals, bal)
+	return vals, bal, nil
+}
+
+// ProcessRewardsAndPenaltiesPrecompute processes the rewards and penalties of individual validator.
+// This is an optimized version by passing in precomputed validator attesting records and and total epoch balances.
+func ProcessRewardsAndPenaltiesPrecompute(
+	st state.BeaconState,
+	bal *precompute.Balance,
+	vals []*precompute.Validator,
+) (state.BeaconState, error) {
+	// Don't process rewards and penalties in genesis epoch.
+	if helpers.CurrentEpoch(st) == 0 {
+		return st, nil
+	}
+
+	numOfVals := st.NumValidators()
+	// Guard against an out-of-bounds using validator balance precompute.
+	if len(vals) != numOfVals || len(vals) != st.BalancesLength() {
+		return st, errors.New("validator registries not the same length as state's validator registries")
+	}
+
+	attsRewards, attsPenalties, err := AttestationsDelta(st, bal, vals)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation delta")
+	}
+
+	balances := st.Balances()
+	for i := 0; i < numOfVals; i++ {
+		vals[i].BeforeEpochTransitionBalance = balances[i]
+
+		// Compute the post balance of the validator after accounting for the
+		// attester and proposer rewards and penalties.
+		balances[i] = helpers.IncreaseBalanceWithVal(balances[i], attsRewards[i])
+		balances[i] = helpers.DecreaseBalanceWithVal(balances[i], attsPenalties[i])
+
+		vals[i].AfterEpochTransitionBalance = balances[i]
+	}
+
+	if err := st.SetBalances(balances); err != nil {
+		return nil, errors.Wrap(err, "could not set validator balances")
+	}
+
+	return st, nil
+}
+
+// AttestationsDelta computes and returns the rewards and penalties differences for individual validators based on the
+// voting records.
+func AttestationsDelta(st state.ReadOnlyBeaconState, bal *precompute.Balance, vals []*precompute.Validator) (rewards, penalties []uint64, err error) {
+	numOfVals := st.NumValidators()
+	rewards = make([]uint64, numOfVals)
+	penalties = make([]uint64, numOfVals)
+	prevEpoch := helpers.PrevEpoch(st)
+	finalizedEpoch := st.FinalizedCheckpointEpoch()
+	inLeak := helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch)
+
+	baseRewardPerInc, err := BaseRewardPerIncrement(bal.ActiveCurrentEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, v := range vals {
+		rewards[i], penalties[i] = attestationDelta(bal, v, baseRewardPerInc, inLeak)
+	}
+	return rewards, penalties, nil
+}
+
+// Spec code:
+//  def get_flag_index_deltas(state: BeaconState, flag_index: int) -> Tuple[Sequence[Gwei], Sequence[Gwei]]:
+//    """
+//    Return the deltas for a given ``flag_index`` by scanning through the participation flags.
+//    """
+//    rewards = [Gwei(0)] * len(state.validators)
+//    penalties = [Gwei(0)] * len(state.validators)
+//    previous_epoch = get_previous_epoch(state)
+//    unslashed_participating_indices = get_unslashed_participating_indices(state, flag_index, previous_epoch)
+//    weight = PARTICIPATION_FLAG_WEIGHTS[flag_index]
+//    unslashed_participating_balance = get_total_balance(state, unslashed_participating_indices)
+//    unslashed_participating_increments = unslashed_participating_balance // EFFECTIVE_BALANCE_INCREMENT
+//    active_increments = get_total_active_balance(state) // EFFECTIVE_BALANCE_INCREMENT
+//    for index in get_eligible_validator_indices(state):
+//        base_reward = get_base_reward(state, index)
+//        if index in unslashed_participating_indices:
+//            if not is_in_inactivity_leak(state):
+//                reward_numerator = base_reward * weight * unslashed_participating_increments
+//                rewards[index] += Gwei(reward_numerator // (active_increments * WEIGHT_DENOMINATOR))
+//        elif flag_index != TIMELY_HEAD_FLAG_INDEX:
+//            penalties[index] += Gwei(base_reward * weight // WEIGHT_DENOMINATOR)
+//    return rewards, penalties
+//
+//  def get_inactivity_penalty_deltas(state: BeaconState) -> Tuple[Sequence[Gwei], Sequence[Gwei]]:
+//    """
+//    Return the inactivity penalty deltas by considering timely target participation flags and inactivity scores.
+//    """
+//    rewards = [Gwei(0) for _ in range(len(state.validators))]
+//    penalties = [Gwei(0) for _ in range(len(state.validators))]
+//    previous_epoch = get_previous_epoch(state)
+//    matching_target_indices = get_unslashed_participating_indices(state, TIMELY_TARGET_FLAG_INDEX, previous_epoch)
+//    for index in get_eligible_validator_indices(state):
+//        if index not in matching_target_indices:
+//            penalty_numerator = state.validators[index].effective_balance * state.inactivity_scores[index]
+//            penalty_denominator = INACTIVITY_SCORE_BIAS * INACTIVITY_PENALTY_QUOTIENT_ALTAIR
+//            penalties[index] += Gwei(penalty_numerator // penalty_denominator)
+//    return rewards, penalties
+func attestationDelta(bal *precompute.Balance, val *precompute.Validator, baseRewardPerInc uint64, inLeak bool) (reward, penalty uint64) {
+	// Per spec `ActiveCurrentEpoch` can't be 0 to process attestation delta.
+	if !precompute.EligibleForRewards(val) || bal.ActiveCurrentEpoch == 0 {
+		return 0, 0
+	}
+
+	cfg := params.BeaconConfig()
+	increment := cfg.EffectiveBalanceIncrement
+	effectiveBalance := val.CurrentEpochEffectiveBalance
+	baseReward := (effectiveBalance / increment) * baseRewardPerInc
+	activeIncrement := bal.ActiveCurrentEpoch / increment
+
+	weightDenominator := cfg.WeightDenominator
+	srcWeight := cfg.TimelySourceWeight
+	tgtWeight := cfg.TimelyTargetWeight
+	headWeight := cfg.TimelyHeadWeight
+	reward, penalty = uint64(0), uint64(0)
+	// Process source reward / penalty
+	if val.IsPrevEpochAttester && !val.IsSlashed {
+		if !inLeak {
+			n := baseReward * srcWeight * (bal.PrevEpochAttested / increment)
+			reward += n / (activeIncrement * weightDenominator)
+		}
+	} else {
+		penalty += baseReward * srcWeight / weightDenominator
+	}
+
+	// Process target reward / penalty
+	if val.IsPrevEpochTargetAttester && !val.IsSlashed {
+		if !inLeak {
+			n := baseReward * tgtWeight * (bal.PrevEpochTargetAttested / increment)
+			reward += n / (activeIncrement * weightDenominator)
+		}
+	} else {
+		penalty += baseReward * tgtWeight / weightDenominator
+	}
+
+	// Process head reward / penalty
+	if val.IsPrevEpochHeadAttester && !val.IsSlashed {
+		if !inLeak {
+			n := baseReward * headWeight * (bal.PrevEpochHeadAttested / increment)
+			reward += n / (activeIncrement * weightDenominator)
+		}
+	}
+
+	// Process inactivity penalty for validators that did not attest to the target or have been slashed.
+	if !val.IsPrevEpochTargetAttester || val.IsSlashed {
+		n := effectiveBalance * val.InactivityScore
+		d := cfg.InactivityScoreBias * cfg.InactivityPenaltyQuotientAltair
+		penalty += n / d
+	}
+
+	return reward, penalty
+}
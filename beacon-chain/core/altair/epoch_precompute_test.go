@@ -0,0 +1,206 @@
+package altair_test
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// testStateAltair returns an Altair state at the given slot with four validators:
+// 0 attested to source, target and head in both epochs, 1 only attested to the
+// source, 2 is slashed but fully attested, and 3 has not been activated yet.
+func testStateAltair(t *testing.T, slot types.Slot) state.BeaconState {
+	cfg := params.BeaconConfig()
+	all := altair.AddValidatorFlag(altair.AddValidatorFlag(altair.AddValidatorFlag(0, cfg.TimelySourceFlagIndex), cfg.TimelyTargetFlagIndex), cfg.TimelyHeadFlagIndex)
+	src := altair.AddValidatorFlag(0, cfg.TimelySourceFlagIndex)
+	validators := make([]*ethpb.Validator, 4)
+	balances := make([]uint64, len(validators))
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			EffectiveBalance:  cfg.MaxEffectiveBalance,
+			ExitEpoch:         cfg.FarFutureEpoch,
+			WithdrawableEpoch: cfg.FarFutureEpoch,
+		}
+		balances[i] = cfg.MaxEffectiveBalance
+	}
+	validators[2].Slashed = true
+	validators[3].ActivationEpoch = cfg.FarFutureEpoch
+	st, err := stateAltair.InitializeFromProto(&statepb.BeaconStateAltair{
+		Slot:                       slot,
+		Validators:                 validators,
+		Balances:                   balances,
+		CurrentEpochParticipation:  []byte{all, src, all, 0},
+		PreviousEpochParticipation: []byte{all, src, all, 0},
+		InactivityScores:           []uint64{5, 5, 5, 5},
+		FinalizedCheckpoint:        &ethpb.Checkpoint{Root: make([]byte, 32)},
+	})
+	require.NoError(t, err)
+	return st
+}
+
+func TestInitializeEpochValidators(t *testing.T) {
+	st := testStateAltair(t, params.BeaconConfig().SlotsPerEpoch)
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(vals))
+
+	maxBal := params.BeaconConfig().MaxEffectiveBalance
+	assert.Equal(t, 3*maxBal, bal.ActiveCurrentEpoch)
+	assert.Equal(t, 3*maxBal, bal.ActivePrevEpoch)
+	assert.Equal(t, true, vals[0].IsActiveCurrentEpoch)
+	assert.Equal(t, true, vals[2].IsSlashed)
+	assert.Equal(t, false, vals[3].IsActiveCurrentEpoch)
+	assert.Equal(t, false, vals[3].IsActivePrevEpoch)
+	assert.Equal(t, uint64(5), vals[1].InactivityScore)
+}
+
+func TestInitializeEpochValidators_BadState(t *testing.T) {
+	st := testStateAltair(t, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, st.SetInactivityScores([]uint64{}))
+	_, _, err := altair.InitializeEpochValidators(context.Background(), st)
+	assert.ErrorContains(t, "num of validators is different than num of inactivity scores", err)
+}
+
+func TestProcessEpochParticipation(t *testing.T) {
+	st := testStateAltair(t, params.BeaconConfig().SlotsPerEpoch)
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	vals, bal, err = altair.ProcessEpochParticipation(context.Background(), st, bal, vals)
+	require.NoError(t, err)
+
+	assert.Equal(t, true, vals[0].IsPrevEpochAttester)
+	assert.Equal(t, true, vals[0].IsPrevEpochTargetAttester)
+	assert.Equal(t, true, vals[0].IsPrevEpochHeadAttester)
+	assert.Equal(t, true, vals[0].IsCurrentEpochTargetAttester)
+	assert.Equal(t, true, vals[1].IsPrevEpochAttester)
+	assert.Equal(t, false, vals[1].IsPrevEpochTargetAttester)
+	assert.Equal(t, false, vals[1].IsPrevEpochHeadAttester)
+	// Inactive validators are never marked as attesters.
+	assert.Equal(t, false, vals[3].IsPrevEpochAttester)
+
+	// Slashed validators are excluded from the attesting balances.
+	maxBal := params.BeaconConfig().MaxEffectiveBalance
+	assert.Equal(t, 2*maxBal, bal.PrevEpochAttested)
+	assert.Equal(t, maxBal, bal.PrevEpochTargetAttested)
+	assert.Equal(t, maxBal, bal.PrevEpochHeadAttested)
+}
+
+func TestProcessEpochParticipation_InvalidParticipationLength(t *testing.T) {
+	st := testStateAltair(t, params.BeaconConfig().SlotsPerEpoch)
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentParticipationBits([]byte{0}))
+	_, _, err = altair.ProcessEpochParticipation(context.Background(), st, bal, vals)
+	assert.ErrorContains(t, "num of validators is different than num of epoch participation bits", err)
+}
+
+func TestProcessInactivityScores_InLeak(t *testing.T) {
+	// Finality has not advanced for longer than MIN_EPOCHS_TO_INACTIVITY_PENALTY.
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().MinEpochsToInactivityPenalty + 2))
+	st := testStateAltair(t, slot)
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	vals, _, err = altair.ProcessEpochParticipation(context.Background(), st, bal, vals)
+	require.NoError(t, err)
+	st, _, err = altair.ProcessInactivityScores(context.Background(), st, vals)
+	require.NoError(t, err)
+
+	scores, err := st.InactivityScores()
+	require.NoError(t, err)
+	bias := params.BeaconConfig().InactivityScoreBias
+	// Target attester recovers by one, a non target attester and a slashed
+	// validator accrue the bias, and the inactive validator is untouched.
+	assert.DeepEqual(t, []uint64{4, 5 + bias, 5 + bias, 5}, scores)
+}
+
+func TestProcessInactivityScores_NotInLeak(t *testing.T) {
+	st := testStateAltair(t, params.BeaconConfig().SlotsPerEpoch*2)
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	vals, _, err = altair.ProcessEpochParticipation(context.Background(), st, bal, vals)
+	require.NoError(t, err)
+	st, _, err = altair.ProcessInactivityScores(context.Background(), st, vals)
+	require.NoError(t, err)
+
+	scores, err := st.InactivityScores()
+	require.NoError(t, err)
+	// Outside of a leak the recovery rate brings every eligible score back down.
+	assert.DeepEqual(t, []uint64{0, 0, 0, 5}, scores)
+}
+
+func TestProcessInactivityScores_GenesisEpoch(t *testing.T) {
+	st := testStateAltair(t, 0)
+	vals, _, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	st, _, err = altair.ProcessInactivityScores(context.Background(), st, vals)
+	require.NoError(t, err)
+	scores, err := st.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{5, 5, 5, 5}, scores)
+}
+
+func TestProcessRewardsAndPenaltiesPrecompute(t *testing.T) {
+	st := testStateAltair(t, params.BeaconConfig().SlotsPerEpoch*2)
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	vals, bal, err = altair.ProcessEpochParticipation(context.Background(), st, bal, vals)
+	require.NoError(t, err)
+	st, err = altair.ProcessRewardsAndPenaltiesPrecompute(st, bal, vals)
+	require.NoError(t, err)
+
+	maxBal := params.BeaconConfig().MaxEffectiveBalance
+	balances := st.Balances()
+	assert.Equal(t, true, balances[0] > maxBal, "Full attester was not rewarded")
+	assert.Equal(t, true, balances[1] < maxBal, "Source only attester was not penalized")
+	assert.Equal(t, true, balances[2] < maxBal, "Slashed validator was not penalized")
+	assert.Equal(t, maxBal, balances[3], "Inactive validator balance changed")
+	assert.Equal(t, vals[0].AfterEpochTransitionBalance, balances[0])
+	assert.Equal(t, maxBal, vals[0].BeforeEpochTransitionBalance)
+}
+
+func TestProcessRewardsAndPenaltiesPrecompute_GenesisEpoch(t *testing.T) {
+	st := testStateAltair(t, 0)
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	st, err = altair.ProcessRewardsAndPenaltiesPrecompute(st, bal, vals)
+	require.NoError(t, err)
+	maxBal := params.BeaconConfig().MaxEffectiveBalance
+	assert.DeepEqual(t, []uint64{maxBal, maxBal, maxBal, maxBal}, st.Balances())
+}
+
+func TestProcessRewardsAndPenaltiesPrecompute_BadValidatorCount(t *testing.T) {
+	st := testStateAltair(t, params.BeaconConfig().SlotsPerEpoch*2)
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	_, err = altair.ProcessRewardsAndPenaltiesPrecompute(st, bal, vals[:1])
+	assert.ErrorContains(t, "validator registries not the same length as state's validator registries", err)
+}
+
+func TestAttestationsDelta(t *testing.T) {
+	st := testStateAltair(t, params.BeaconConfig().SlotsPerEpoch*2)
+	vals, bal, err := altair.InitializeEpochValidators(context.Background(), st)
+	require.NoError(t, err)
+	vals, bal, err = altair.ProcessEpochParticipation(context.Background(), st, bal, vals)
+	require.NoError(t, err)
+	rewards, penalties, err := altair.AttestationsDelta(st, bal, vals)
+	require.NoError(t, err)
+
+	assert.Equal(t, true, rewards[0] > 0)
+	assert.Equal(t, uint64(0), penalties[0])
+	// Missing the target is penalized, missing the head is not.
+	assert.Equal(t, true, rewards[1] > 0)
+	assert.Equal(t, true, penalties[1] > 0)
+	assert.Equal(t, uint64(0), rewards[2])
+	assert.Equal(t, true, penalties[2] > penalties[1])
+	assert.Equal(t, uint64(0), rewards[3])
+	assert.Equal(t, uint64(0), penalties[3])
+}
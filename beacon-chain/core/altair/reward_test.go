@@ -0,0 +1,39 @@
+package altair
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBaseRewardPerIncrement(t *testing.T) {
+	tests := []struct {
+		activeBalance uint64
+		want          uint64
+		errString     string
+	}{
+		{
+			activeBalance: 0,
+			errString:     "active balance can't be 0",
+		},
+		{
+			activeBalance: params.BeaconConfig().EffectiveBalanceIncrement,
+			want:          2023907,
+		},
+		{
+			activeBalance: params.BeaconConfig().MaxEffectiveBalance * 64,
+			want:          44721,
+		},
+	}
+	for _, tt := range tests {
+		got, err := BaseRewardPerIncrement(tt.activeBalance)
+		if tt.errString != "" {
+			require.ErrorContains(t, tt.errString, err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
@@ -0,0 +1,248 @@
+package altair
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const maxRandomByte = uint64(1<<8 - 1)
+
+// NextSyncCommittee returns the next sync committee for a given state.
+//
+// Spec code:
+//  def get_next_sync_committee(state: BeaconState) -> SyncCommittee:
+//    """
+//    Return the next sync committee, with possible pubkey duplicates.
+//    """
+//    indices = get_next_sync_committee_indices(state)
+//    pubkeys = [state.validators[index].pubkey for index in indices]
+//    aggregate_pubkey = bls.AggregatePKs(pubkeys)
+//    return SyncCommittee(pubkeys=pubkeys, aggregate_pubkey=aggregate_pubkey)
+func NextSyncCommittee(s state.ReadOnlyBeaconState) (*statepb.SyncCommittee, error) {
+	indices, err := NextSyncCommitteeIndices(s)
+	if err != nil {
+		return nil, err
+	}
+	pubkeys := make([][]byte, len(indices))
+	for i, index := range indices {
+		p := s.PubkeyAtIndex(index)
+		pubkeys[i] = p[:]
+	}
+	aggregated, err := bls.AggregatePublicKeys(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	return &statepb.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: aggregated.Marshal(),
+	}, nil
+}
+
+// NextSyncCommitteeIndices returns the next sync committee indices for a given state.
+//
+// Spec code:
+//  def get_next_sync_committee_indices(state: BeaconState) -> Sequence[ValidatorIndex]:
+//    """
+//    Return the sync committee indices, with possible duplicates, for the next sync committee.
+//    """
+//    epoch = Epoch(get_current_epoch(state) + 1)
+//
+//    MAX_RANDOM_BYTE = 2**8 - 1
+//    active_validator_indices = get_active_validator_indices(state, epoch)
+//    active_validator_count = uint64(len(active_validator_indices))
+//    seed = get_seed(state, epoch, DOMAIN_SYNC_COMMITTEE)
+//    i = 0
+//    sync_committee_indices: List[ValidatorIndex] = []
+//    while len(sync_committee_indices) < SYNC_COMMITTEE_SIZE:
+//        shuffled_index = compute_shuffled_index(uint64(i % active_validator_count), active_validator_count, seed)
+//        candidate_index = active_validator_indices[shuffled_index]
+//        random_byte = hash(seed + uint_to_bytes(uint64(i // 32)))[i % 32]
+//        effective_balance = state.validators[candidate_index].effective_balance
+//        if effective_balance * MAX_RANDOM_BYTE >= MAX_EFFECTIVE_BALANCE * random_byte:
+//            sync_committee_indices.append(candidate_index)
+//        i += 1
+//    return sync_committee_indices
+func NextSyncCommitteeIndices(s state.ReadOnlyBeaconState) ([]types.ValidatorIndex, error) {
+	epoch := helpers.NextEpoch(s)
+	indices, err := helpers.ActiveValidatorIndices(s, epoch)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := helpers.Seed(s, epoch, params.BeaconConfig().DomainSyncCommittee)
+	if err != nil {
+		return nil, err
+	}
+	count := uint64(len(indices))
+	if count == 0 {
+		return nil, errors.New("no active validators to compute sync committee")
+	}
+	cfg := params.BeaconConfig()
+	syncCommitteeSize := cfg.SyncCommitteeSize
+	cIndices := make([]types.ValidatorIndex, 0, syncCommitteeSize)
+	hashFunc := hashutil.CustomSHA256Hasher()
+
+	for i := types.ValidatorIndex(0); uint64(len(cIndices)) < syncCommitteeSize; i++ {
+		sIndex, err := helpers.ComputeShuffledIndex(i.Mod(count), count, seed, true)
+		if err != nil {
+			return nil, err
+		}
+
+		b := append(seed[:], bytesutil.Bytes8(uint64(i.Div(32)))...)
+		randomByte := hashFunc(b)[i%32]
+		cIndex := indices[sIndex]
+		v, err := s.ValidatorAtIndexReadOnly(cIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		effectiveBal := v.EffectiveBalance()
+		if effectiveBal*maxRandomByte >= cfg.MaxEffectiveBalance*uint64(randomByte) {
+			cIndices = append(cIndices, cIndex)
+		}
+	}
+
+	return cIndices, nil
+}
+
+// SyncSubCommitteePubkeys returns the pubkeys participating in a sync subcommittee.
+// The caller is expected to select the committee responsible for the slot, see
+// SyncCommitteeForSlot.
+//
+// Spec code:
+//  def get_sync_subcommittee_pubkeys(state: BeaconState, subcommittee_index: uint64) -> Sequence[BLSPubkey]:
+//    ...
+//    # Return pubkeys for the subcommittee index
+//    sync_subcommittee_size = SYNC_COMMITTEE_SIZE // SYNC_COMMITTEE_SUBNET_COUNT
+//    i = subcommittee_index * sync_subcommittee_size
+//    return sync_committee.pubkeys[i:i + sync_subcommittee_size]
+func SyncSubCommitteePubkeys(syncCommittee *statepb.SyncCommittee, subComIdx types.CommitteeIndex) ([][]byte, error) {
+	cfg := params.BeaconConfig()
+	subCommSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+	i := uint64(subComIdx) * subCommSize
+	endOfSubCom := i + subCommSize
+	pubkeyLen := uint64(len(syncCommittee.Pubkeys))
+	if endOfSubCom > pubkeyLen {
+		return nil, errors.Errorf("end index is larger than array length: %d > %d", endOfSubCom, pubkeyLen)
+	}
+	return syncCommittee.Pubkeys[i:endOfSubCom], nil
+}
+
+// SyncCommitteeForSlot returns the sync committee which is responsible for signing
+// messages for the slot after the state's slot. Committees assigned to a slot sign
+// for the previous slot, so the next sync committee is returned when the next slot
+// falls in a new sync committee period.
+func SyncCommitteeForSlot(s state.BeaconState) (*statepb.SyncCommittee, error) {
+	currentPeriod := helpers.SyncCommitteePeriod(helpers.CurrentEpoch(s))
+	nextSlotPeriod := helpers.SyncCommitteePeriod(helpers.SlotToEpoch(s.Slot() + 1))
+	if currentPeriod == nextSlotPeriod {
+		return s.CurrentSyncCommittee()
+	}
+	return s.NextSyncCommittee()
+}
+
+// SubnetsForSyncCommittee returns the subnets the validator at index `valIdx`
+// is expected to publish sync committee messages on.
+//
+// Spec code:
+//  def compute_subnets_for_sync_committee(state: BeaconState, validator_index: ValidatorIndex) -> Set[uint64]:
+//    next_slot_epoch = compute_epoch_at_slot(Slot(state.slot + 1))
+//    if compute_sync_committee_period(get_current_epoch(state)) == compute_sync_committee_period(next_slot_epoch):
+//        sync_committee = state.current_sync_committee
+//    else:
+//        sync_committee = state.next_sync_committee
+//
+//    target_pubkey = state.validators[validator_index].pubkey
+//    sync_committee_indices = [index for index, pubkey in enumerate(sync_committee.pubkeys) if pubkey == target_pubkey]
+//    return set([
+//        uint64(index // (SYNC_COMMITTEE_SIZE // SYNC_COMMITTEE_SUBNET_COUNT))
+//        for index in sync_committee_indices
+//    ])
+func SubnetsForSyncCommittee(s state.BeaconState, valIdx types.ValidatorIndex) ([]uint64, error) {
+	committee, err := SyncCommitteeForSlot(s)
+	if err != nil {
+		return nil, err
+	}
+	if committee == nil {
+		return nil, errors.New("nil sync committee in state")
+	}
+	v, err := s.ValidatorAtIndexReadOnly(valIdx)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := v.PublicKey()
+	cfg := params.BeaconConfig()
+	subCommSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+	seen := make(map[uint64]bool)
+	var subnets []uint64
+	for i, pk := range committee.Pubkeys {
+		if !bytes.Equal(pk, pubkey[:]) {
+			continue
+		}
+		subnet := uint64(i) / subCommSize
+		if !seen[subnet] {
+			seen[subnet] = true
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets, nil
+}
+
+// IsSyncCommitteeAggregator checks whether the provided signature is for a valid
+// sync committee aggregator.
+//
+// Spec code:
+//  def is_sync_committee_aggregator(signature: BLSSignature) -> bool:
+//    modulo = max(1, SYNC_COMMITTEE_SIZE // SYNC_COMMITTEE_SUBNET_COUNT // TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE)
+//    return bytes_to_uint64(hash(signature)[0:8]) % modulo == 0
+func IsSyncCommitteeAggregator(sig []byte) (bool, error) {
+	if len(sig) != params.BeaconConfig().BLSSignatureLength {
+		return false, errors.New("incorrect sig length")
+	}
+	cfg := params.BeaconConfig()
+	modulo := mathutil.Max(1, cfg.SyncCommitteeSize/cfg.SyncCommitteeSubnetCount/cfg.TargetAggregatorsPerSyncSubcommittee)
+	hashedSig := hashutil.Hash(sig)
+	return binary.LittleEndian.Uint64(hashedSig[:8])%modulo == 0, nil
+}
+
+// ValidateSyncMessageTime validates sync message to ensure that the provided slot is valid.
+// A sync message is only valid for the current slot, with a tolerance of the
+// clock disparity on either side.
+func ValidateSyncMessageTime(slot types.Slot, genesisTime time.Time, clockDisparity time.Duration) error {
+	if err := helpers.ValidateSlotClock(slot, uint64(genesisTime.Unix())); err != nil {
+		return err
+	}
+	messageTime, err := helpers.SlotToTime(uint64(genesisTime.Unix()), slot)
+	if err != nil {
+		return err
+	}
+	currentSlot := helpers.SlotsSince(genesisTime)
+	slotStartTime, err := helpers.SlotToTime(uint64(genesisTime.Unix()), currentSlot)
+	if err != nil {
+		return err
+	}
+
+	lowerBound := slotStartTime.Add(-clockDisparity)
+	upperBound := timeutils.Now().Add(clockDisparity)
+	if messageTime.Before(lowerBound) || messageTime.After(upperBound) {
+		return fmt.Errorf(
+			"sync message slot %d not within allowable range of current slot %d",
+			slot,
+			currentSlot,
+		)
+	}
+	return nil
+}
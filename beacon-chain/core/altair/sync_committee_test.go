@@ -0,0 +1,64 @@
+package altair
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncSubCommitteePubkeys(t *testing.T) {
+	cfg := params.BeaconConfig()
+	pubkeys := make([][]byte, cfg.SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = bytesutil.PadTo(bytesutil.Bytes8(uint64(i)), 48)
+	}
+	committee := &statepb.SyncCommittee{Pubkeys: pubkeys}
+	subCommSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+
+	for i := uint64(0); i < cfg.SyncCommitteeSubnetCount; i++ {
+		got, err := SyncSubCommitteePubkeys(committee, types.CommitteeIndex(i))
+		require.NoError(t, err)
+		require.Equal(t, int(subCommSize), len(got))
+	}
+	got, err := SyncSubCommitteePubkeys(committee, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubkeys[subCommSize], got[0])
+
+	_, err = SyncSubCommitteePubkeys(committee, 4)
+	assert.ErrorContains(t, "end index is larger than array length", err)
+}
+
+func TestIsSyncCommitteeAggregator(t *testing.T) {
+	_, err := IsSyncCommitteeAggregator([]byte{'a'})
+	assert.ErrorContains(t, "incorrect sig length", err)
+
+	// Every signature is selected once the modulo drops to 1.
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.TargetAggregatorsPerSyncSubcommittee = c.SyncCommitteeSize
+	params.OverrideBeaconConfig(c)
+	sig := make([]byte, params.BeaconConfig().BLSSignatureLength)
+	agg, err := IsSyncCommitteeAggregator(sig)
+	require.NoError(t, err)
+	assert.Equal(t, true, agg)
+}
+
+func TestValidateSyncMessageTime(t *testing.T) {
+	clockDisparity := params.BeaconNetworkConfig().MaximumGossipClockDisparity
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	// Genesis ten slots ago, so the current slot is 10.
+	genesis := time.Now().Add(-10 * secondsPerSlot)
+	require.NoError(t, ValidateSyncMessageTime(10, genesis, clockDisparity))
+
+	err := ValidateSyncMessageTime(8, genesis, clockDisparity)
+	assert.ErrorContains(t, "not within allowable range", err)
+	err = ValidateSyncMessageTime(12, genesis, clockDisparity)
+	assert.ErrorContains(t, "not within allowable range", err)
+}
@@ -0,0 +1,113 @@
+package altair
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"go.opencensus.io/trace"
+)
+
+// ProcessEpoch describes the per epoch operations that are performed on the beacon state.
+// It's optimized by pre computing validator attested info and epoch total/attested balances upfront.
+//
+// Spec code:
+//  def process_epoch(state: BeaconState) -> None:
+//    process_justification_and_finalization(state)  # [Modified in Altair]
+//    process_inactivity_updates(state)  # [New in Altair]
+//    process_rewards_and_penalties(state)  # [Modified in Altair]
+//    process_registry_updates(state)
+//    process_slashings(state)  # [Modified in Altair]
+//    process_eth1_data_reset(state)
+//    process_effective_balance_updates(state)
+//    process_slashings_reset(state)
+//    process_randao_mixes_reset(state)
+//    process_historical_roots_update(state)
+//    process_participation_flag_updates(state)  # [New in Altair]
+//    process_sync_committee_updates(state)  # [New in Altair]
+func ProcessEpoch(ctx context.Context, state state.BeaconState) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "altair.ProcessEpoch")
+	defer span.End()
+
+	if state == nil || state.IsNil() {
+		return nil, errors.New("nil state")
+	}
+	span.AddAttributes(trace.Int64Attribute("epoch", int64(helpers.CurrentEpoch(state))))
+
+	vp, bp, err := InitializeEpochValidators(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	// New in Altair.
+	vp, bp, err = ProcessEpochParticipation(ctx, state, bp, vp)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err = precompute.ProcessJustificationAndFinalizationPreCompute(state, bp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process justification")
+	}
+
+	// New in Altair.
+	state, vp, err = ProcessInactivityScores(ctx, state, vp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process inactivity updates")
+	}
+
+	// New in Altair.
+	state, err = ProcessRewardsAndPenaltiesPrecompute(state, bp, vp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process rewards and penalties")
+	}
+
+	state, err = e.ProcessRegistryUpdates(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process registry updates")
+	}
+
+	// Modified in Altair.
+	state, err = ProcessSlashings(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slashings")
+	}
+
+	state, err = e.ProcessEth1DataReset(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process eth1 data reset")
+	}
+	state, err = e.ProcessEffectiveBalanceUpdates(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process effective balance updates")
+	}
+	state, err = e.ProcessSlashingsReset(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slashings reset")
+	}
+	state, err = e.ProcessRandaoMixesReset(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process randao mixes reset")
+	}
+	state, err = e.ProcessHistoricalRootsUpdate(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process historical roots update")
+	}
+
+	// New in Altair.
+	state, err = ProcessParticipationFlagUpdates(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process participation flag updates")
+	}
+
+	// New in Altair.
+	state, err = ProcessSyncCommitteeUpdates(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process sync committee updates")
+	}
+
+	return state, nil
+}
@@ -0,0 +1,112 @@
+package altair_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+func TestUpgradeToAltair(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, params.BeaconConfig().MaxValidatorsPerCommittee)
+	preForkState := st.Copy()
+	aState, err := altair.UpgradeToAltair(context.Background(), st)
+	require.NoError(t, err)
+	require.Equal(t, version.Altair, aState.Version())
+
+	require.Equal(t, preForkState.GenesisTime(), aState.GenesisTime())
+	require.DeepSSZEqual(t, preForkState.GenesisValidatorRoot(), aState.GenesisValidatorRoot())
+	require.Equal(t, preForkState.Slot(), aState.Slot())
+	require.DeepSSZEqual(t, preForkState.LatestBlockHeader(), aState.LatestBlockHeader())
+	require.DeepSSZEqual(t, preForkState.BlockRoots(), aState.BlockRoots())
+	require.DeepSSZEqual(t, preForkState.StateRoots(), aState.StateRoots())
+	require.DeepSSZEqual(t, preForkState.Eth1Data(), aState.Eth1Data())
+	require.DeepSSZEqual(t, preForkState.Eth1DepositIndex(), aState.Eth1DepositIndex())
+	require.DeepSSZEqual(t, preForkState.Validators(), aState.Validators())
+	require.DeepSSZEqual(t, preForkState.Balances(), aState.Balances())
+	require.DeepSSZEqual(t, preForkState.RandaoMixes(), aState.RandaoMixes())
+	require.DeepSSZEqual(t, preForkState.Slashings(), aState.Slashings())
+	require.DeepSSZEqual(t, preForkState.JustificationBits(), aState.JustificationBits())
+	require.DeepSSZEqual(t, preForkState.FinalizedCheckpoint(), aState.FinalizedCheckpoint())
+
+	numValidators := aState.NumValidators()
+	p, err := aState.PreviousEpochParticipation()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, make([]byte, numValidators), p)
+	p, err = aState.CurrentEpochParticipation()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, make([]byte, numValidators), p)
+	s, err := aState.InactivityScores()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, make([]uint64, numValidators), s)
+
+	f := aState.Fork()
+	require.DeepSSZEqual(t, &statepb.Fork{
+		PreviousVersion: st.Fork().CurrentVersion,
+		CurrentVersion:  params.BeaconConfig().AltairForkVersion,
+		Epoch:           helpers.CurrentEpoch(st),
+	}, f)
+	csc, err := aState.CurrentSyncCommittee()
+	require.NoError(t, err)
+	nsc, err := aState.NextSyncCommittee()
+	require.NoError(t, err)
+	require.Equal(t, int(params.BeaconConfig().SyncCommitteeSize), len(csc.Pubkeys))
+	require.DeepSSZEqual(t, nsc, csc)
+}
+
+func TestUpgradeToAltair_NilState(t *testing.T) {
+	_, err := altair.UpgradeToAltair(context.Background(), nil)
+	assert.ErrorContains(t, "nil state", err)
+}
+
+func TestTranslateParticipation(t *testing.T) {
+	s, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, s.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	st, err := altair.UpgradeToAltair(context.Background(), s)
+	require.NoError(t, err)
+
+	// An attestation for slot 0 included with the minimum delay matches
+	// source, target and head, so every attester gets all three flags.
+	committee, err := helpers.BeaconCommitteeFromState(st, 0, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		bits.SetBitAt(uint64(i), true)
+	}
+	pendingAtts := []*statepb.PendingAttestation{
+		{
+			AggregationBits: bits,
+			InclusionDelay:  1,
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+		},
+	}
+	st, err = altair.TranslateParticipation(st, pendingAtts)
+	require.NoError(t, err)
+
+	participation, err := st.PreviousEpochParticipation()
+	require.NoError(t, err)
+	cfg := params.BeaconConfig()
+	attested := make(map[uint64]bool, len(committee))
+	for _, idx := range committee {
+		attested[uint64(idx)] = true
+	}
+	for i, p := range participation {
+		want := attested[uint64(i)]
+		assert.Equal(t, want, altair.HasValidatorFlag(p, cfg.TimelySourceFlagIndex))
+		assert.Equal(t, want, altair.HasValidatorFlag(p, cfg.TimelyTargetFlagIndex))
+		assert.Equal(t, want, altair.HasValidatorFlag(p, cfg.TimelyHeadFlagIndex))
+	}
+}
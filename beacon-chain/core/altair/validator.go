@@ -0,0 +1,46 @@
+package altair
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// SlashValidator slashes the malicious validator's balance and awards
+// the whistleblower's balance using the Altair slashing parameters.
+//
+// Spec code:
+//  def slash_validator(state: BeaconState,
+//                      slashed_index: ValidatorIndex,
+//                      whistleblower_index: ValidatorIndex=None) -> None:
+//    """
+//    Slash the validator with index ``slashed_index``.
+//    """
+//    epoch = get_current_epoch(state)
+//    initiate_validator_exit(state, slashed_index)
+//    validator = state.validators[slashed_index]
+//    validator.slashed = True
+//    validator.withdrawable_epoch = max(validator.withdrawable_epoch, Epoch(epoch + EPOCHS_PER_SLASHINGS_VECTOR))
+//    state.slashings[epoch % EPOCHS_PER_SLASHINGS_VECTOR] += validator.effective_balance
+//    decrease_balance(state, slashed_index, validator.effective_balance // MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR)
+//
+//    # Apply proposer and whistleblower rewards
+//    proposer_index = get_beacon_proposer_index(state)
+//    if whistleblower_index is None:
+//        whistleblower_index = proposer_index
+//    whistleblower_reward = Gwei(validator.effective_balance // WHISTLEBLOWER_REWARD_QUOTIENT)
+//    proposer_reward = Gwei(whistleblower_reward * PROPOSER_WEIGHT // WEIGHT_DENOMINATOR)
+//    increase_balance(state, proposer_index, proposer_reward)
+//    increase_balance(state, whistleblower_index, Gwei(whistleblower_reward - proposer_reward))
+func SlashValidator(s state.BeaconState, slashedIdx types.ValidatorIndex) (state.BeaconState, error) {
+	cfg := params.BeaconConfig()
+	// `whistleblower_reward * PROPOSER_WEIGHT // WEIGHT_DENOMINATOR` is equivalent to
+	// dividing the whistleblower reward by `WEIGHT_DENOMINATOR // PROPOSER_WEIGHT`.
+	return validators.SlashValidatorWithQuotients(
+		s,
+		slashedIdx,
+		cfg.MinSlashingPenaltyQuotientAltair,
+		cfg.WeightDenominator/cfg.ProposerWeight,
+	)
+}
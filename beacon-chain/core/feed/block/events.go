@@ -9,9 +9,20 @@ import (
 const (
 	// ReceivedBlock is sent after a block has been received by the beacon node via p2p or RPC.
 	ReceivedBlock = iota + 1
+	// GossipValidatedBlock is sent after a block received via p2p has passed gossip validation,
+	// before it has been imported into the chain.
+	GossipValidatedBlock
 )
 
 // ReceivedBlockData is the data sent with ReceivedBlock events.
 type ReceivedBlockData struct {
 	SignedBlock interfaces.SignedBeaconBlock
 }
+
+// GossipValidatedBlockData is the data sent with GossipValidatedBlock events.
+type GossipValidatedBlockData struct {
+	// BlockRoot of the gossip validated block.
+	BlockRoot [32]byte
+	// SignedBlock is the gossip validated block.
+	SignedBlock interfaces.SignedBeaconBlock
+}
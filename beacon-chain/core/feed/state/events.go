@@ -26,6 +26,9 @@ const (
 	FinalizedCheckpoint
 	// NewHead of the chain event.
 	NewHead
+	// ProposalPreparation is sent after a new head has been set, announcing the proposer
+	// and parent of the next slot's block so that block production can be prepared ahead of time.
+	ProposalPreparation
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -59,3 +62,15 @@ type InitializedData struct {
 	// GenesisValidatorsRoot represents state.validators.HashTreeRoot().
 	GenesisValidatorsRoot []byte
 }
+
+// ProposalPreparationData is the data sent with ProposalPreparation events.
+type ProposalPreparationData struct {
+	// ProposalSlot is the slot of the upcoming proposal.
+	ProposalSlot types.Slot
+	// ProposerIndex is the index of the validator expected to propose at the proposal slot.
+	ProposerIndex types.ValidatorIndex
+	// ParentBlockRoot is the root of the head block the proposal will build on.
+	ParentBlockRoot [32]byte
+	// Timestamp is the unix time in seconds at the start of the proposal slot.
+	Timestamp uint64
+}
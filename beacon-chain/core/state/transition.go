@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
@@ -21,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"go.opencensus.io/trace"
 )
 
@@ -46,6 +48,26 @@ var processExitFunc = func(ctx context.Context, s state.BeaconState, blk interfa
 	return b.ProcessVoluntaryExits(ctx, s, blk.Block().Body().VoluntaryExits())
 }
 
+var processDepositsAltairFunc = func(ctx context.Context, s state.BeaconState, blk interfaces.SignedBeaconBlock) (state.BeaconState, error) {
+	return altair.ProcessDeposits(ctx, s, blk.Block().Body().Deposits())
+}
+
+var processProposerSlashingAltairFunc = func(ctx context.Context, s state.BeaconState, blk interfaces.SignedBeaconBlock) (state.BeaconState, error) {
+	return b.ProcessProposerSlashings(ctx, s, blk.Block().Body().ProposerSlashings(), altair.SlashValidator)
+}
+
+var processAttesterSlashingAltairFunc = func(ctx context.Context, s state.BeaconState, blk interfaces.SignedBeaconBlock) (state.BeaconState, error) {
+	return b.ProcessAttesterSlashings(ctx, s, blk.Block().Body().AttesterSlashings(), altair.SlashValidator)
+}
+
+var processSyncAggregateFunc = func(ctx context.Context, s state.BeaconState, blk interfaces.SignedBeaconBlock) (state.BeaconState, error) {
+	sa, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, err
+	}
+	return altair.ProcessSyncAggregate(s, sa)
+}
+
 // This defines the processing block routine as outlined in the Ethereum Beacon Chain spec:
 // https://github.com/ethereum/eth2.0-specs/blob/dev/specs/phase0/beacon-chain.md#block-processing
 var processingPipeline = []processFunc{
@@ -60,6 +82,21 @@ var processingPipeline = []processFunc{
 	processExitFunc,
 }
 
+// This defines the processing block routine as outlined in the Ethereum Beacon Chain spec for Altair:
+// https://github.com/ethereum/eth2.0-specs/blob/dev/specs/altair/beacon-chain.md#block-processing
+var processingPipelineAltair = []processFunc{
+	b.ProcessBlockHeader,
+	b.ProcessRandao,
+	processEth1DataFunc,
+	VerifyOperationLengths,
+	processProposerSlashingAltairFunc,
+	processAttesterSlashingAltairFunc,
+	altair.ProcessAttestations,
+	processDepositsAltairFunc,
+	processExitFunc,
+	processSyncAggregateFunc,
+}
+
 // ExecuteStateTransition defines the procedure for a state transition function.
 //
 // Note: This method differs from the spec pseudocode as it uses a batch signature verification.
@@ -272,7 +309,14 @@ func ProcessSlots(ctx context.Context, state state.BeaconState, slot types.Slot)
 			return nil, errors.Wrap(err, "could not process slot")
 		}
 		if CanProcessEpoch(state) {
-			state, err = ProcessEpochPrecompute(ctx, state)
+			switch state.Version() {
+			case version.Phase0:
+				state, err = ProcessEpochPrecompute(ctx, state)
+			case version.Altair:
+				state, err = altair.ProcessEpoch(ctx, state)
+			default:
+				err = fmt.Errorf("unsupported beacon state version %d", state.Version())
+			}
 			if err != nil {
 				traceutil.AnnotateError(span, err)
 				return nil, errors.Wrap(err, "could not process epoch with optimizations")
@@ -282,6 +326,15 @@ func ProcessSlots(ctx context.Context, state state.BeaconState, slot types.Slot)
 			traceutil.AnnotateError(span, err)
 			return nil, errors.Wrap(err, "failed to increment state slot")
 		}
+
+		// Transition to the Altair state at the start of the scheduled fork epoch.
+		if CanUpgradeToAltair(state.Slot()) {
+			state, err = altair.UpgradeToAltair(ctx, state)
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return nil, errors.Wrap(err, "could not upgrade state to altair")
+			}
+		}
 	}
 
 	if highestSlot < state.Slot() {
@@ -318,7 +371,11 @@ func ProcessBlock(
 		return nil, err
 	}
 
-	for _, p := range processingPipeline {
+	pipeline := processingPipeline
+	if signed.Version() == version.Altair {
+		pipeline = processingPipelineAltair
+	}
+	for _, p := range pipeline {
 		state, err = p(ctx, state, signed)
 		if err != nil {
 			return nil, errors.Wrap(err, "Could not process block")
@@ -392,6 +449,16 @@ func CanProcessEpoch(state state.ReadOnlyBeaconState) bool {
 	return (state.Slot()+1)%params.BeaconConfig().SlotsPerEpoch == 0
 }
 
+// CanUpgradeToAltair returns true if the input `slot` is the first slot of the Altair fork epoch.
+//
+// Spec code:
+//  If state.slot % SLOTS_PER_EPOCH == 0 and compute_epoch_at_slot(state.slot) == ALTAIR_FORK_EPOCH
+func CanUpgradeToAltair(slot types.Slot) bool {
+	epochStart := helpers.IsEpochStart(slot)
+	altairEpoch := helpers.SlotToEpoch(slot) == params.BeaconConfig().AltairForkEpoch
+	return epochStart && altairEpoch
+}
+
 // ProcessEpochPrecompute describes the per epoch operations that are performed on the beacon state.
 // It's optimized by pre computing validator attested info and epoch total/attested balances upfront.
 func ProcessEpochPrecompute(ctx context.Context, state state.BeaconState) (state.BeaconState, error) {
@@ -29,3 +29,14 @@ type SlasherDatabase = iface.SlasherDatabase
 // ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 // when one already exists in a database.
 var ErrExistingGenesisState = iface.ErrExistingGenesisState
+
+// ErrExistingChainData is an error when the user attempts to initialize a database from a
+// checkpoint when it already holds a finalized chain.
+var ErrExistingChainData = iface.ErrExistingChainData
+
+// ErrNotFoundOriginBlockRoot is an error when the database was not initialized from a checkpoint.
+var ErrNotFoundOriginBlockRoot = iface.ErrNotFoundOriginBlockRoot
+
+// ErrNotFoundBackfillBlockRoot is an error when the database was not initialized from a checkpoint,
+// so that there are no blocks to backfill.
+var ErrNotFoundBackfillBlockRoot = iface.ErrNotFoundBackfillBlockRoot
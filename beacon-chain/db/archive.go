@@ -0,0 +1,410 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/archive"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// blocksPerChunk is the number of blocks grouped in a chunk of an exported archive.
+const blocksPerChunk = 256
+
+// ExportArchive writes the finalized blocks of a beacon chain database, along with the states it
+// stores for them, to an archive file. The beacon node using the database must be stopped.
+func ExportArchive(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	outputFile := cliCtx.String(cmd.ArchiveOutputFileFlag.Name)
+
+	dbPath := path.Join(dataDir, kv.BeaconNodeDbDirName)
+	if !fileutil.FileExists(path.Join(dbPath, kv.DatabaseFileName)) {
+		return errors.Errorf("no database found in %s", dbPath)
+	}
+	d, err := kv.NewKVStore(ctx, dbPath, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	f, err := os.Create(outputFile)
+	if err != nil {
+		return errors.Wrap(err, "could not create archive file")
+	}
+	blocks, states, err := exportArchive(ctx, d, f)
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close archive file")
+		}
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "could not close archive file")
+	}
+
+	log.WithFields(logrus.Fields{
+		"blocks": blocks,
+		"states": states,
+		"file":   outputFile,
+	}).Info("Archive exported successfully")
+	return nil
+}
+
+// ImportArchive initializes a new beacon chain database from the finalized blocks and states of
+// an archive file.
+func ImportArchive(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	sourceFile := cliCtx.String(cmd.ArchiveSourceFileFlag.Name)
+
+	dbPath := path.Join(dataDir, kv.BeaconNodeDbDirName)
+	if fileutil.FileExists(path.Join(dbPath, kv.DatabaseFileName)) {
+		return errors.Errorf("a database already exists in %s, archives can only be imported into a new database", dbPath)
+	}
+	f, err := os.Open(sourceFile)
+	if err != nil {
+		return errors.Wrap(err, "could not open archive file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Failed to close archive file")
+		}
+	}()
+	if err := fileutil.MkdirAll(dbPath); err != nil {
+		return err
+	}
+	d, err := kv.NewKVStore(ctx, dbPath, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	blocks, states, err := importArchive(ctx, d, f)
+	if closeErr := d.Close(); closeErr != nil && err == nil {
+		err = errors.Wrap(closeErr, "could not close database")
+	}
+	if err != nil {
+		// The partially imported database is useless, remove it so that the import can be retried.
+		if removeErr := os.RemoveAll(dbPath); removeErr != nil {
+			log.WithError(removeErr).Error("Failed to remove partially imported database")
+		}
+		return err
+	}
+
+	log.WithFields(logrus.Fields{
+		"blocks": blocks,
+		"states": states,
+		"dir":    dbPath,
+	}).Info("Archive imported successfully")
+	return nil
+}
+
+// exportArchive writes the finalized chain of the database to the writer, starting at the earliest
+// block the database has a state for: the genesis block, or the checkpoint it was synced from.
+func exportArchive(ctx context.Context, d *kv.Store, w io.Writer) (int, int, error) {
+	finalized, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not retrieve finalized checkpoint")
+	}
+	root := bytesutil.ToBytes32(finalized.Root)
+	if root == params.BeaconConfig().ZeroHash {
+		genesis, err := d.GenesisBlock(ctx)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "could not retrieve genesis block")
+		}
+		if genesis == nil || genesis.IsNil() {
+			return 0, 0, errors.New("database has no finalized block")
+		}
+		if root, err = genesis.Block().HashTreeRoot(); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	// Walk the finalized chain backwards, then export it forwards from the earliest block with a state.
+	var roots [][32]byte
+	for {
+		blk, err := d.Block(ctx, root)
+		if err != nil {
+			return 0, 0, err
+		}
+		if blk == nil || blk.IsNil() {
+			break
+		}
+		roots = append(roots, root)
+		if blk.Block().Slot() == 0 {
+			break
+		}
+		root = bytesutil.ToBytes32(blk.Block().ParentRoot())
+	}
+	start := -1
+	for i := len(roots) - 1; i >= 0; i-- {
+		if d.HasState(ctx, roots[i]) {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return 0, 0, errors.New("database has no state for any finalized block")
+	}
+	anchor, err := d.State(ctx, roots[start])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	header := &archive.Header{
+		GenesisValidatorsRoot: bytesutil.ToBytes32(anchor.GenesisValidatorRoot()),
+		ConfigName:            params.BeaconConfig().ConfigName,
+	}
+	aw, err := archive.NewWriter(w, header)
+	if err != nil {
+		return 0, 0, err
+	}
+	var pending []*archive.Entry
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		err := aw.WriteChunk(archive.KindBlocks, pending)
+		pending = nil
+		return err
+	}
+	blocks, states := 0, 0
+	for i := start; i >= 0; i-- {
+		blk, err := d.Block(ctx, roots[i])
+		if err != nil {
+			return 0, 0, err
+		}
+		enc, err := blk.MarshalSSZ()
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "could not marshal block at slot %d", blk.Block().Slot())
+		}
+		pending = append(pending, &archive.Entry{Root: roots[i], Slot: blk.Block().Slot(), SSZ: enc})
+		blocks++
+		// A state is written after the chunk holding its block.
+		if d.HasState(ctx, roots[i]) {
+			if err := flush(); err != nil {
+				return 0, 0, err
+			}
+			st, err := d.State(ctx, roots[i])
+			if err != nil {
+				return 0, 0, err
+			}
+			enc, err := st.MarshalSSZ()
+			if err != nil {
+				return 0, 0, errors.Wrapf(err, "could not marshal state at slot %d", st.Slot())
+			}
+			if err := aw.WriteChunk(archive.KindStates, []*archive.Entry{{Root: roots[i], Slot: st.Slot(), SSZ: enc}}); err != nil {
+				return 0, 0, err
+			}
+			states++
+		}
+		if len(pending) >= blocksPerChunk {
+			if err := flush(); err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return 0, 0, err
+	}
+	return blocks, states, aw.Close()
+}
+
+// importArchive saves the blocks and states read from the archive into an empty database. The
+// first state of the archive must be the one of its first block, which is either the genesis
+// block or a checkpoint saved with SaveOrigin. The last block becomes the finalized checkpoint
+// and the head of the chain.
+func importArchive(ctx context.Context, d *kv.Store, r io.Reader) (int, int, error) {
+	ar, err := archive.NewReader(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	header := ar.Header()
+	if header.ConfigName != params.BeaconConfig().ConfigName {
+		return 0, 0, fmt.Errorf("archive was exported with the %s config instead of %s",
+			header.ConfigName, params.BeaconConfig().ConfigName)
+	}
+
+	var firstRoot, lastRoot, anchorRoot [32]byte
+	var lastSlot types.Slot
+	blocks, states := 0, 0
+	for {
+		chunk, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		switch chunk.Kind {
+		case archive.KindBlocks:
+			blks := make([]interfaces.SignedBeaconBlock, len(chunk.Entries))
+			summaries := make([]*statepb.StateSummary, len(chunk.Entries))
+			for i, e := range chunk.Entries {
+				blk := &ethpb.SignedBeaconBlock{}
+				if err := blk.UnmarshalSSZ(e.SSZ); err != nil {
+					return 0, 0, errors.Wrapf(err, "could not unmarshal block at slot %d", e.Slot)
+				}
+				root, err := blk.Block.HashTreeRoot()
+				if err != nil {
+					return 0, 0, err
+				}
+				if root != e.Root || blk.Block.Slot != e.Slot {
+					return 0, 0, fmt.Errorf("block %#x at slot %d does not match its archive entry", root, blk.Block.Slot)
+				}
+				if blocks == 0 {
+					firstRoot = root
+				} else if bytesutil.ToBytes32(blk.Block.ParentRoot) != lastRoot {
+					return 0, 0, fmt.Errorf("block at slot %d is not a child of the block at slot %d", blk.Block.Slot, lastSlot)
+				}
+				blks[i] = wrapper.WrappedPhase0SignedBeaconBlock(blk)
+				summaries[i] = &statepb.StateSummary{Slot: blk.Block.Slot, Root: root[:]}
+				lastRoot, lastSlot = root, blk.Block.Slot
+				blocks++
+			}
+			if err := d.SaveBlocks(ctx, blks); err != nil {
+				return 0, 0, errors.Wrap(err, "could not save blocks")
+			}
+			if err := d.SaveStateSummaries(ctx, summaries); err != nil {
+				return 0, 0, errors.Wrap(err, "could not save state summaries")
+			}
+		case archive.KindStates:
+			for _, e := range chunk.Entries {
+				pbState := &statepb.BeaconState{}
+				if err := pbState.UnmarshalSSZ(e.SSZ); err != nil {
+					return 0, 0, errors.Wrapf(err, "could not unmarshal state at slot %d", e.Slot)
+				}
+				st, err := statev1.InitializeFromProtoUnsafe(pbState)
+				if err != nil {
+					return 0, 0, err
+				}
+				if !bytes.Equal(st.GenesisValidatorRoot(), header.GenesisValidatorsRoot[:]) {
+					return 0, 0, fmt.Errorf("state at slot %d does not match the genesis validators root of the archive", st.Slot())
+				}
+				blk, err := d.Block(ctx, e.Root)
+				if err != nil {
+					return 0, 0, err
+				}
+				if blk == nil || blk.IsNil() {
+					return 0, 0, fmt.Errorf("state at slot %d precedes its block %#x", st.Slot(), e.Root)
+				}
+				if anchorRoot == params.BeaconConfig().ZeroHash {
+					if e.Root != firstRoot {
+						return 0, 0, errors.New("the first state of the archive is not the state of its first block")
+					}
+					if err := saveArchiveAnchor(ctx, d, st, blk); err != nil {
+						return 0, 0, err
+					}
+					anchorRoot = e.Root
+				} else {
+					if err := verifyArchivedState(ctx, st, blk, e.Root); err != nil {
+						return 0, 0, err
+					}
+					if err := d.SaveState(ctx, st, e.Root); err != nil {
+						return 0, 0, errors.Wrap(err, "could not save state")
+					}
+				}
+				states++
+			}
+		}
+		log.WithFields(logrus.Fields{
+			"slot":   lastSlot,
+			"blocks": blocks,
+			"states": states,
+		}).Debug("Imported archive chunk")
+	}
+	if anchorRoot == params.BeaconConfig().ZeroHash {
+		return 0, 0, errors.New("archive has no state")
+	}
+
+	// The checkpoint is the last block at or before the start of the finalized epoch.
+	epoch := helpers.SlotToEpoch(lastSlot)
+	if !helpers.IsEpochStart(lastSlot) {
+		epoch++
+	}
+	checkpoint := &ethpb.Checkpoint{Epoch: epoch, Root: lastRoot[:]}
+	if err := d.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return 0, 0, errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := d.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return 0, 0, errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	if err := d.SaveHeadBlockRoot(ctx, lastRoot); err != nil {
+		return 0, 0, errors.Wrap(err, "could not save head block root")
+	}
+	return blocks, states, nil
+}
+
+// verifyArchivedState checks that the state was computed from the block, at the slot of the block
+// or at a later slot without any block in between.
+func verifyArchivedState(ctx context.Context, st state.BeaconState, blk interfaces.SignedBeaconBlock, blockRoot [32]byte) error {
+	if st.Slot() == blk.Block().Slot() {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(stateRoot[:], blk.Block().StateRoot()) {
+			return fmt.Errorf("state root %#x at slot %d does not match the block state root %#x",
+				stateRoot, st.Slot(), blk.Block().StateRoot())
+		}
+		return nil
+	}
+	if st.Slot() < blk.Block().Slot() {
+		return fmt.Errorf("state at slot %d precedes its block at slot %d", st.Slot(), blk.Block().Slot())
+	}
+	// Processing the slots after the block fills in the state root of the latest block header,
+	// whose root is then the block root.
+	headerRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if headerRoot != blockRoot {
+		return fmt.Errorf("state at slot %d was not computed from block %#x", st.Slot(), blockRoot)
+	}
+	return nil
+}
+
+// saveArchiveAnchor saves the state of the first block of an archive, from which the states of
+// the following blocks are regenerated.
+func saveArchiveAnchor(ctx context.Context, d *kv.Store, st state.BeaconState, blk interfaces.SignedBeaconBlock) error {
+	if blk.Block().Slot() != 0 {
+		return d.SaveOrigin(ctx, st, blk)
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(stateRoot[:], blk.Block().StateRoot()) {
+		return fmt.Errorf("genesis state root %#x does not match the genesis block state root %#x", stateRoot, blk.Block().StateRoot())
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if err := d.SaveState(ctx, st, root); err != nil {
+		return errors.Wrap(err, "could not save genesis state")
+	}
+	return d.SaveGenesisBlockRoot(ctx, root)
+}
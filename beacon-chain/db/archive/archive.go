@@ -0,0 +1,117 @@
+// Package archive defines a self-describing format to exchange the finalized blocks and states of a
+// beacon chain database, independently of the underlying database files.
+//
+// An archive is a header followed by chunks, an index of the chunks and a fixed size trailer:
+//
+//	header  = magic "PRYSMARC" | version uint32 | genesis validators root [32]byte |
+//	          config name length uint16 | config name
+//	chunk   = kind uint8 | entry count uint32 | first slot uint64 | last slot uint64 |
+//	          payload length uint32 | CRC-32C checksum of the payload uint32 | payload
+//	payload = snappy block encoding of the concatenated entries
+//	entry   = root [32]byte | slot uint64 | SSZ length uint32 | SSZ encoded object
+//	index   = chunk of kind KindIndex, whose entries describe the preceding chunks
+//	trailer = offset of the index uint64 | magic "PRYSMEND"
+//
+// Integers are little endian. The root of a block entry is the block root, the root of a state
+// entry is the root of the block the state was computed at.
+package archive
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Version of the archive format.
+const Version = 1
+
+// Kind of the entries of a chunk.
+type Kind uint8
+
+const (
+	// KindBlocks is a chunk of SSZ encoded signed beacon blocks, in ascending slot order.
+	KindBlocks Kind = 1
+	// KindStates is a chunk of SSZ encoded beacon states, in ascending slot order.
+	KindStates Kind = 2
+	// KindIndex is the chunk indexing the other chunks, written last.
+	KindIndex Kind = 0xff
+)
+
+const (
+	headerMagic  = "PRYSMARC"
+	trailerMagic = "PRYSMEND"
+	// chunkHeaderSize is the size of the fields preceding the payload of a chunk.
+	chunkHeaderSize = 1 + 4 + 8 + 8 + 4 + 4
+	// indexEntrySize is the size of an entry of the index chunk payload.
+	indexEntrySize = 8 + 1 + 4 + 8 + 8 + 4
+	// trailerSize is the size of the trailer following the index chunk.
+	trailerSize = 8 + len(trailerMagic)
+	// maxChunkSize bounds the size of a decompressed chunk payload.
+	maxChunkSize = 1 << 30
+)
+
+var (
+	// ErrInvalidMagic is returned when the input is not an archive.
+	ErrInvalidMagic = errors.New("invalid archive magic")
+	// ErrUnsupportedVersion is returned for archives written in an unknown version of the format.
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+	// ErrChecksumMismatch is returned when the payload of a chunk does not match its checksum.
+	ErrChecksumMismatch = errors.New("archive chunk checksum mismatch")
+	// ErrIndexMismatch is returned when the index does not describe the chunks of the archive.
+	ErrIndexMismatch = errors.New("archive index does not match its chunks")
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// Header describes the chain an archive was exported from.
+type Header struct {
+	GenesisValidatorsRoot [32]byte
+	ConfigName            string
+}
+
+// Entry is a single SSZ encoded object of a chunk.
+type Entry struct {
+	Root [32]byte
+	Slot types.Slot
+	SSZ  []byte
+}
+
+// Chunk is a group of entries of the same kind, compressed and checksummed together.
+type Chunk struct {
+	Kind    Kind
+	Entries []*Entry
+}
+
+// IndexEntry locates a chunk in the archive and summarizes its content.
+type IndexEntry struct {
+	Offset    uint64
+	Kind      Kind
+	Count     uint32
+	FirstSlot types.Slot
+	LastSlot  types.Slot
+	Checksum  uint32
+}
+
+func (e *IndexEntry) marshal() []byte {
+	buf := make([]byte, indexEntrySize)
+	binary.LittleEndian.PutUint64(buf[0:8], e.Offset)
+	buf[8] = byte(e.Kind)
+	binary.LittleEndian.PutUint32(buf[9:13], e.Count)
+	binary.LittleEndian.PutUint64(buf[13:21], uint64(e.FirstSlot))
+	binary.LittleEndian.PutUint64(buf[21:29], uint64(e.LastSlot))
+	binary.LittleEndian.PutUint32(buf[29:33], e.Checksum)
+	return buf
+}
+
+func unmarshalIndexEntry(buf []byte) *IndexEntry {
+	return &IndexEntry{
+		Offset:    binary.LittleEndian.Uint64(buf[0:8]),
+		Kind:      Kind(buf[8]),
+		Count:     binary.LittleEndian.Uint32(buf[9:13]),
+		FirstSlot: types.Slot(binary.LittleEndian.Uint64(buf[13:21])),
+		LastSlot:  types.Slot(binary.LittleEndian.Uint64(buf[21:29])),
+		Checksum:  binary.LittleEndian.Uint32(buf[29:33]),
+	}
+}
@@ -0,0 +1,131 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testEntries(slots ...types.Slot) []*Entry {
+	entries := make([]*Entry, len(slots))
+	for i, slot := range slots {
+		entries[i] = &Entry{
+			Root: bytesutil.ToBytes32(bytesutil.Bytes8(uint64(slot))),
+			Slot: slot,
+			SSZ:  bytes.Repeat([]byte{byte(slot)}, 100+int(slot)),
+		}
+	}
+	return entries
+}
+
+func writeTestArchive(t *testing.T, header *Header, chunks []*Chunk) []byte {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, header)
+	require.NoError(t, err)
+	for _, c := range chunks {
+		require.NoError(t, w.WriteChunk(c.Kind, c.Entries))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestArchive_RoundTrip(t *testing.T) {
+	header := &Header{GenesisValidatorsRoot: [32]byte{'a'}, ConfigName: "mainnet"}
+	chunks := []*Chunk{
+		{Kind: KindBlocks, Entries: testEntries(0, 1, 3)},
+		{Kind: KindStates, Entries: testEntries(3)},
+		{Kind: KindBlocks, Entries: testEntries(4, 4, 8)},
+	}
+	enc := writeTestArchive(t, header, chunks)
+
+	r, err := NewReader(bytes.NewReader(enc))
+	require.NoError(t, err)
+	assert.DeepEqual(t, header, r.Header())
+	for _, want := range chunks {
+		c, err := r.Next()
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, c)
+	}
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+
+	index, err := ReadIndex(bytes.NewReader(enc))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(index))
+	assert.Equal(t, KindStates, index[1].Kind)
+	assert.Equal(t, uint32(3), index[2].Count)
+	assert.Equal(t, types.Slot(4), index[2].FirstSlot)
+	assert.Equal(t, types.Slot(8), index[2].LastSlot)
+	// The index locates the chunks in the archive.
+	assert.Equal(t, byte(KindStates), enc[index[1].Offset])
+}
+
+func TestArchive_Empty(t *testing.T) {
+	enc := writeTestArchive(t, &Header{}, nil)
+	r, err := NewReader(bytes.NewReader(enc))
+	require.NoError(t, err)
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestArchive_Invalid(t *testing.T) {
+	enc := writeTestArchive(t, &Header{ConfigName: "mainnet"}, []*Chunk{{Kind: KindBlocks, Entries: testEntries(1, 2)}})
+
+	_, err := NewReader(bytes.NewReader(enc[1:]))
+	assert.ErrorContains(t, ErrInvalidMagic.Error(), err)
+
+	readAll := func(enc []byte) error {
+		r, err := NewReader(bytes.NewReader(enc))
+		if err != nil {
+			return err
+		}
+		for {
+			if _, err := r.Next(); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+	require.NoError(t, readAll(enc))
+
+	// Corrupt the payload of the first chunk, which follows the header and the chunk header.
+	corrupted := append([]byte{}, enc...)
+	corrupted[len(headerMagic)+4+32+2+len("mainnet")+chunkHeaderSize] ^= 0xff
+	assert.ErrorContains(t, ErrChecksumMismatch.Error(), readAll(corrupted))
+
+	assert.ErrorContains(t, "truncated archive", readAll(enc[:len(enc)-1]))
+	assert.ErrorContains(t, "unexpected data after the archive trailer", readAll(append(enc, 0)))
+
+	// The trailer must point to the index.
+	badTrailer := append(append([]byte{}, enc[:len(enc)-trailerSize]...), make([]byte, 8)...)
+	badTrailer = append(badTrailer, trailerMagic...)
+	assert.ErrorContains(t, "trailer does not point to the index", readAll(badTrailer))
+
+	// The index must list the chunks read from the stream.
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, &Header{})
+	require.NoError(t, err)
+	require.NoError(t, w.WriteChunk(KindBlocks, testEntries(1)))
+	w.index = nil
+	require.NoError(t, w.Close())
+	assert.ErrorContains(t, ErrIndexMismatch.Error(), readAll(buf.Bytes()))
+}
+
+func TestWriter_WriteChunk_Invalid(t *testing.T) {
+	w, err := NewWriter(&bytes.Buffer{}, &Header{})
+	require.NoError(t, err)
+	assert.ErrorContains(t, "empty chunk", w.WriteChunk(KindBlocks, nil))
+	assert.ErrorContains(t, "invalid chunk kind", w.WriteChunk(KindIndex, testEntries(1)))
+	assert.ErrorContains(t, "lower than the slot", w.WriteChunk(KindBlocks, testEntries(2, 1)))
+	require.NoError(t, w.Close())
+	assert.ErrorContains(t, "closed", w.WriteChunk(KindBlocks, testEntries(1)))
+}
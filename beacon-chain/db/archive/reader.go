@@ -0,0 +1,239 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Reader reads the chunks of an archive from an underlying stream, verifying their checksums and,
+// once all chunks are read, the index of the archive.
+type Reader struct {
+	r      io.Reader
+	offset uint64
+	header *Header
+	chunks []*IndexEntry
+	done   bool
+}
+
+// NewReader reads the archive header from the stream and returns a reader for its chunks.
+func NewReader(r io.Reader) (*Reader, error) {
+	ar := &Reader{r: r}
+	buf, err := ar.read(len(headerMagic) + 4 + 32 + 2)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read archive header")
+	}
+	if string(buf[:len(headerMagic)]) != headerMagic {
+		return nil, ErrInvalidMagic
+	}
+	buf = buf[len(headerMagic):]
+	if v := binary.LittleEndian.Uint32(buf[:4]); v != Version {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "version %d", v)
+	}
+	header := &Header{}
+	copy(header.GenesisValidatorsRoot[:], buf[4:36])
+	name, err := ar.read(int(binary.LittleEndian.Uint16(buf[36:38])))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read archive header")
+	}
+	header.ConfigName = string(name)
+	ar.header = header
+	return ar, nil
+}
+
+// Header returns the header of the archive.
+func (r *Reader) Header() *Header {
+	return r.header
+}
+
+// Next reads the next chunk of the archive. It returns io.EOF after the last chunk, once the
+// index and the trailer of the archive have been verified.
+func (r *Reader) Next() (*Chunk, error) {
+	if r.done {
+		return nil, io.EOF
+	}
+	offset := r.offset
+	entry, raw, err := r.readChunk()
+	if err != nil {
+		return nil, err
+	}
+	if entry.Kind == KindIndex {
+		if err := r.verifyIndex(entry, raw, offset); err != nil {
+			return nil, err
+		}
+		r.done = true
+		return nil, io.EOF
+	}
+	if entry.Kind != KindBlocks && entry.Kind != KindStates {
+		return nil, errors.Errorf("invalid chunk kind %d at offset %d", entry.Kind, offset)
+	}
+	entries, err := decodeEntries(raw, entry.Count)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode chunk at offset %d", offset)
+	}
+	if entries[0].Slot != entry.FirstSlot || entries[len(entries)-1].Slot != entry.LastSlot {
+		return nil, errors.Errorf("slots of the chunk at offset %d do not match its entries", offset)
+	}
+	entry.Offset = offset
+	r.chunks = append(r.chunks, entry)
+	return &Chunk{Kind: entry.Kind, Entries: entries}, nil
+}
+
+// ReadIndex reads the index of an archive from its end, without reading its chunks.
+func ReadIndex(rs io.ReadSeeker) ([]*IndexEntry, error) {
+	if _, err := rs.Seek(-int64(trailerSize), io.SeekEnd); err != nil {
+		return nil, errors.Wrap(err, "could not seek archive trailer")
+	}
+	trailer := make([]byte, trailerSize)
+	if _, err := io.ReadFull(rs, trailer); err != nil {
+		return nil, errors.Wrap(err, "could not read archive trailer")
+	}
+	if string(trailer[8:]) != trailerMagic {
+		return nil, ErrInvalidMagic
+	}
+	indexOffset := binary.LittleEndian.Uint64(trailer[:8])
+	if _, err := rs.Seek(int64(indexOffset), io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "could not seek archive index")
+	}
+	r := &Reader{r: rs, offset: indexOffset}
+	entry, raw, err := r.readChunk()
+	if err != nil {
+		return nil, err
+	}
+	if entry.Kind != KindIndex {
+		return nil, errors.Wrapf(ErrIndexMismatch, "chunk at offset %d is not the index", indexOffset)
+	}
+	return decodeIndex(raw, entry.Count)
+}
+
+// verifyIndex checks that the index lists the chunks read so far, and that the trailer points
+// to the index and ends the stream.
+func (r *Reader) verifyIndex(entry *IndexEntry, raw []byte, offset uint64) error {
+	index, err := decodeIndex(raw, entry.Count)
+	if err != nil {
+		return err
+	}
+	if len(index) != len(r.chunks) {
+		return errors.Wrapf(ErrIndexMismatch, "index lists %d chunks instead of %d", len(index), len(r.chunks))
+	}
+	for i, e := range index {
+		if *e != *r.chunks[i] {
+			return errors.Wrapf(ErrIndexMismatch, "index entry %d does not match the chunk at offset %d", i, r.chunks[i].Offset)
+		}
+	}
+	trailer, err := r.read(trailerSize)
+	if err != nil {
+		return errors.Wrap(err, "could not read archive trailer")
+	}
+	if string(trailer[8:]) != trailerMagic {
+		return ErrInvalidMagic
+	}
+	if binary.LittleEndian.Uint64(trailer[:8]) != offset {
+		return errors.Wrap(ErrIndexMismatch, "trailer does not point to the index")
+	}
+	if n, err := r.r.Read(make([]byte, 1)); n > 0 || (err != nil && err != io.EOF) {
+		return errors.New("unexpected data after the archive trailer")
+	}
+	return nil
+}
+
+// readChunk reads a chunk header and payload, verifies the checksum of the payload and
+// decompresses it.
+func (r *Reader) readChunk() (*IndexEntry, []byte, error) {
+	offset := r.offset
+	buf, err := r.read(chunkHeaderSize)
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil, errors.Wrap(io.ErrUnexpectedEOF, "archive ended before its index")
+		}
+		return nil, nil, errors.Wrapf(err, "could not read chunk at offset %d", offset)
+	}
+	entry := &IndexEntry{
+		Kind:      Kind(buf[0]),
+		Count:     binary.LittleEndian.Uint32(buf[1:5]),
+		FirstSlot: types.Slot(binary.LittleEndian.Uint64(buf[5:13])),
+		LastSlot:  types.Slot(binary.LittleEndian.Uint64(buf[13:21])),
+		Checksum:  binary.LittleEndian.Uint32(buf[25:29]),
+	}
+	length := binary.LittleEndian.Uint32(buf[21:25])
+	if uint64(length) > uint64(snappy.MaxEncodedLen(maxChunkSize)) {
+		return nil, nil, errors.Errorf("chunk at offset %d exceeds the maximum size", offset)
+	}
+	payload, err := r.read(int(length))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not read chunk at offset %d", offset)
+	}
+	if crc32.Checksum(payload, castagnoli) != entry.Checksum {
+		return nil, nil, errors.Wrapf(ErrChecksumMismatch, "chunk at offset %d", offset)
+	}
+	size, err := snappy.DecodedLen(payload)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not decompress chunk at offset %d", offset)
+	}
+	if size > maxChunkSize {
+		return nil, nil, errors.Errorf("chunk at offset %d exceeds the maximum size", offset)
+	}
+	raw, err := snappy.Decode(nil, payload)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not decompress chunk at offset %d", offset)
+	}
+	return entry, raw, nil
+}
+
+func (r *Reader) read(n int) ([]byte, error) {
+	buf := make([]byte, n)
+	read, err := io.ReadFull(r.r, buf)
+	r.offset += uint64(read)
+	if err == io.ErrUnexpectedEOF {
+		return nil, errors.Wrap(err, "truncated archive")
+	}
+	return buf, err
+}
+
+func decodeEntries(raw []byte, count uint32) ([]*Entry, error) {
+	if count == 0 {
+		return nil, errors.New("empty chunk")
+	}
+	rd := bytes.NewReader(raw)
+	entries := make([]*Entry, 0, count)
+	var prefix [32 + 8 + 4]byte
+	for i := uint32(0); i < count; i++ {
+		if _, err := io.ReadFull(rd, prefix[:]); err != nil {
+			return nil, errors.Wrapf(err, "could not read entry %d", i)
+		}
+		e := &Entry{Slot: types.Slot(binary.LittleEndian.Uint64(prefix[32:40]))}
+		copy(e.Root[:], prefix[:32])
+		length := binary.LittleEndian.Uint32(prefix[40:44])
+		if int64(length) > int64(rd.Len()) {
+			return nil, errors.Errorf("entry %d is longer than the chunk", i)
+		}
+		e.SSZ = make([]byte, length)
+		if _, err := io.ReadFull(rd, e.SSZ); err != nil {
+			return nil, errors.Wrapf(err, "could not read entry %d", i)
+		}
+		if i > 0 && e.Slot < entries[i-1].Slot {
+			return nil, errors.Errorf("entry %d is not in ascending slot order", i)
+		}
+		entries = append(entries, e)
+	}
+	if rd.Len() != 0 {
+		return nil, errors.New("unexpected data after the last entry")
+	}
+	return entries, nil
+}
+
+func decodeIndex(raw []byte, count uint32) ([]*IndexEntry, error) {
+	if uint64(len(raw)) != uint64(count)*indexEntrySize {
+		return nil, errors.Wrapf(ErrIndexMismatch, "index of %d bytes cannot hold %d entries", len(raw), count)
+	}
+	index := make([]*IndexEntry, count)
+	for i := range index {
+		index[i] = unmarshalIndexEntry(raw[i*indexEntrySize : (i+1)*indexEntrySize])
+	}
+	return index, nil
+}
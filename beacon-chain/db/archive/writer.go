@@ -0,0 +1,141 @@
+package archive
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// Writer writes an archive to an underlying stream, one chunk at a time.
+type Writer struct {
+	w      io.Writer
+	offset uint64
+	index  []*IndexEntry
+	closed bool
+}
+
+// NewWriter writes the archive header and returns a writer for the chunks of the archive.
+func NewWriter(w io.Writer, header *Header) (*Writer, error) {
+	if len(header.ConfigName) > math.MaxUint16 {
+		return nil, errors.New("config name is too long")
+	}
+	buf := make([]byte, 0, len(headerMagic)+4+32+2+len(header.ConfigName))
+	buf = append(buf, headerMagic...)
+	buf = appendUint32(buf, Version)
+	buf = append(buf, header.GenesisValidatorsRoot[:]...)
+	buf = appendUint16(buf, uint16(len(header.ConfigName)))
+	buf = append(buf, header.ConfigName...)
+	aw := &Writer{w: w}
+	if err := aw.write(buf); err != nil {
+		return nil, errors.Wrap(err, "could not write archive header")
+	}
+	return aw, nil
+}
+
+// WriteChunk compresses the entries into a chunk of the given kind and writes it. The entries
+// must be in ascending slot order.
+func (w *Writer) WriteChunk(kind Kind, entries []*Entry) error {
+	if w.closed {
+		return errors.New("archive writer is closed")
+	}
+	if kind != KindBlocks && kind != KindStates {
+		return errors.Errorf("invalid chunk kind %d", kind)
+	}
+	if len(entries) == 0 {
+		return errors.New("empty chunk")
+	}
+	size := 0
+	for i, e := range entries {
+		if i > 0 && e.Slot < entries[i-1].Slot {
+			return errors.Errorf("entry slot %d is lower than the slot %d of the previous entry", e.Slot, entries[i-1].Slot)
+		}
+		size += 32 + 8 + 4 + len(e.SSZ)
+	}
+	if size > maxChunkSize {
+		return errors.Errorf("chunk size %d exceeds the maximum of %d bytes", size, maxChunkSize)
+	}
+	raw := make([]byte, 0, size)
+	for _, e := range entries {
+		raw = append(raw, e.Root[:]...)
+		raw = appendUint64(raw, uint64(e.Slot))
+		raw = appendUint32(raw, uint32(len(e.SSZ)))
+		raw = append(raw, e.SSZ...)
+	}
+	entry := &IndexEntry{
+		Offset:    w.offset,
+		Kind:      kind,
+		Count:     uint32(len(entries)),
+		FirstSlot: entries[0].Slot,
+		LastSlot:  entries[len(entries)-1].Slot,
+	}
+	if err := w.writeChunk(entry, raw); err != nil {
+		return err
+	}
+	w.index = append(w.index, entry)
+	return nil
+}
+
+// Close writes the index of the chunks and the trailer of the archive. It does not close the
+// underlying stream.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	raw := make([]byte, 0, len(w.index)*indexEntrySize)
+	for _, e := range w.index {
+		raw = append(raw, e.marshal()...)
+	}
+	indexOffset := w.offset
+	if err := w.writeChunk(&IndexEntry{Kind: KindIndex, Count: uint32(len(w.index))}, raw); err != nil {
+		return errors.Wrap(err, "could not write archive index")
+	}
+	trailer := appendUint64(make([]byte, 0, trailerSize), indexOffset)
+	trailer = append(trailer, trailerMagic...)
+	return errors.Wrap(w.write(trailer), "could not write archive trailer")
+}
+
+// writeChunk compresses the raw payload, fills in the checksum of the index entry and writes the chunk.
+func (w *Writer) writeChunk(entry *IndexEntry, raw []byte) error {
+	payload := snappy.Encode(nil, raw)
+	entry.Checksum = crc32.Checksum(payload, castagnoli)
+	buf := make([]byte, 0, chunkHeaderSize)
+	buf = append(buf, byte(entry.Kind))
+	buf = appendUint32(buf, entry.Count)
+	buf = appendUint64(buf, uint64(entry.FirstSlot))
+	buf = appendUint64(buf, uint64(entry.LastSlot))
+	buf = appendUint32(buf, uint32(len(payload)))
+	buf = appendUint32(buf, entry.Checksum)
+	if err := w.write(buf); err != nil {
+		return err
+	}
+	return w.write(payload)
+}
+
+func (w *Writer) write(buf []byte) error {
+	n, err := w.w.Write(buf)
+	w.offset += uint64(n)
+	return err
+}
+
+func appendUint16(buf []byte, v uint16) []byte {
+	var b [2]byte
+	binary.LittleEndian.PutUint16(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	return append(buf, b[:]...)
+}
@@ -0,0 +1,193 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+)
+
+// archiveTestBlock returns a block at the given slot on top of the parent root, along with its
+// post-state derived from the base state if withState is set.
+func archiveTestBlock(t *testing.T, base state.BeaconState, parentRoot [32]byte, slot types.Slot, withState bool) (*ethpb.SignedBeaconBlock, state.BeaconState) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = parentRoot[:]
+	if !withState {
+		return blk, nil
+	}
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	st := base.Copy()
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: parentRoot[:],
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	return blk, st
+}
+
+// archiveTestChain saves a chain of blocks at the given slots on top of the parent root, and
+// returns their roots. A state is saved at the block of each slot in stateSlots.
+func archiveTestChain(t *testing.T, d *kv.Store, base state.BeaconState, parentRoot [32]byte, slots []types.Slot, stateSlots map[types.Slot]bool) [][32]byte {
+	ctx := context.Background()
+	roots := make([][32]byte, len(slots))
+	for i, slot := range slots {
+		blk, st := archiveTestBlock(t, base, parentRoot, slot, stateSlots[slot])
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, d.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+		if st != nil {
+			require.NoError(t, d.SaveState(ctx, st, root))
+		}
+		roots[i] = root
+		parentRoot = root
+	}
+	return roots
+}
+
+func archiveCliContext(dataDir, file string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.String(cmd.ArchiveOutputFileFlag.Name, file, "")
+	set.String(cmd.ArchiveSourceFileFlag.Name, file, "")
+	return cli.NewContext(&cli.App{}, set, nil)
+}
+
+func TestExportImportArchive_Genesis(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	d, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	base, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	roots := archiveTestChain(t, d, base, [32]byte{}, []types.Slot{0, 1, 2, 5, 8, 9}, map[types.Slot]bool{0: true, 5: true})
+	require.NoError(t, d.SaveGenesisBlockRoot(ctx, roots[0]))
+	// A state processed past the slot of its block, up to an empty slot.
+	st, err := d.State(ctx, roots[3])
+	require.NoError(t, err)
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	header := st.LatestBlockHeader()
+	header.StateRoot = stateRoot[:]
+	require.NoError(t, st.SetLatestBlockHeader(header))
+	require.NoError(t, st.SetSlot(7))
+	require.NoError(t, d.SaveState(ctx, st, roots[3]))
+	require.NoError(t, d.SaveStateSummary(ctx, &statepb.StateSummary{Slot: 8, Root: roots[4][:]}))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[4][:]}))
+	require.NoError(t, d.Close())
+
+	file := path.Join(t.TempDir(), "archive")
+	require.NoError(t, ExportArchive(archiveCliContext(dataDir, file)))
+
+	importDir := t.TempDir()
+	require.NoError(t, ImportArchive(archiveCliContext(importDir, file)))
+	imported, err := kv.NewKVStore(ctx, path.Join(importDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, imported.Close())
+	}()
+	// The blocks after the finalized checkpoint are not exported.
+	for _, root := range roots[:5] {
+		assert.Equal(t, true, imported.HasBlock(ctx, root))
+		assert.Equal(t, true, imported.IsFinalizedBlock(ctx, root))
+		assert.Equal(t, true, imported.HasStateSummary(ctx, root))
+	}
+	assert.Equal(t, false, imported.HasBlock(ctx, roots[5]))
+	assert.Equal(t, true, imported.HasState(ctx, roots[0]))
+	assert.Equal(t, false, imported.HasState(ctx, roots[1]))
+	importedState, err := imported.State(ctx, roots[3])
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(7), importedState.Slot())
+	genesis, err := imported.GenesisBlock(ctx)
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, roots[0], genesisRoot)
+	finalized, err := imported.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 1, Root: roots[4][:]}, finalized)
+	head, err := imported.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(8), head.Block().Slot())
+
+	err = ImportArchive(archiveCliContext(importDir, file))
+	assert.ErrorContains(t, "a database already exists", err)
+}
+
+func TestExportImportArchive_Origin(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	d, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	base, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	// The checkpoint must belong to the chain of the genesis state known to the database.
+	genesis, err := d.GenesisState(ctx)
+	require.NoError(t, err)
+	if genesis != nil && !genesis.IsNil() {
+		require.NoError(t, base.SetGenesisValidatorRoot(genesis.GenesisValidatorRoot()))
+	}
+	origin, originState := archiveTestBlock(t, base, [32]byte{'a'}, 64, true)
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveOrigin(ctx, originState, wrapper.WrappedPhase0SignedBeaconBlock(origin)))
+	roots := archiveTestChain(t, d, base, originRoot, []types.Slot{65, 70, 96}, nil)
+	require.NoError(t, d.SaveStateSummary(ctx, &statepb.StateSummary{Slot: 96, Root: roots[2][:]}))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: roots[2][:]}))
+	require.NoError(t, d.Close())
+
+	file := path.Join(t.TempDir(), "archive")
+	require.NoError(t, ExportArchive(archiveCliContext(dataDir, file)))
+	importDir := t.TempDir()
+	require.NoError(t, ImportArchive(archiveCliContext(importDir, file)))
+	imported, err := kv.NewKVStore(ctx, path.Join(importDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, imported.Close())
+	}()
+	importedOrigin, err := imported.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, originRoot, importedOrigin)
+	assert.Equal(t, true, imported.HasState(ctx, originRoot))
+	for _, root := range roots {
+		assert.Equal(t, true, imported.IsFinalizedBlock(ctx, root))
+	}
+	finalized, err := imported.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 3, Root: roots[2][:]}, finalized)
+}
+
+func TestImportArchive_Invalid(t *testing.T) {
+	file := path.Join(t.TempDir(), "archive")
+	require.NoError(t, ioutil.WriteFile(file, bytes.Repeat([]byte("not an archive"), 10), 0600))
+	importDir := t.TempDir()
+	err := ImportArchive(archiveCliContext(importDir, file))
+	assert.ErrorContains(t, "invalid archive magic", err)
+	// The partially imported database is removed.
+	_, err = os.Stat(path.Join(importDir, kv.BeaconNodeDbDirName))
+	assert.Equal(t, true, os.IsNotExist(err))
+
+	err = ExportArchive(archiveCliContext(t.TempDir(), file))
+	assert.ErrorContains(t, "no database found", err)
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// ExportDepositSnapshot writes the deposit snapshot of a beacon chain database to a file.
+// The beacon node using the database must be stopped.
+func ExportDepositSnapshot(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	outputFile := cliCtx.String(cmd.DepositSnapshotOutputFileFlag.Name)
+
+	dbPath := path.Join(dataDir, kv.BeaconNodeDbDirName)
+	if !fileutil.FileExists(path.Join(dbPath, kv.DatabaseFileName)) {
+		return errors.Errorf("no database found in %s", dbPath)
+	}
+	d, err := kv.NewKVStore(ctx, dbPath, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	eth1Data, err := d.PowchainData(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve eth1 data")
+	}
+	if eth1Data == nil || eth1Data.DepositSnapshot == nil {
+		return errors.New("database has no deposit snapshot, the node must have finalized deposits")
+	}
+	enc, err := proto.Marshal(eth1Data.DepositSnapshot)
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteFile(outputFile, enc); err != nil {
+		return err
+	}
+
+	log.WithFields(logrus.Fields{
+		"depositCount": eth1Data.DepositSnapshot.DepositCount,
+		"eth1Block":    eth1Data.DepositSnapshot.ExecutionBlockHeight,
+		"file":         outputFile,
+	}).Info("Deposit snapshot exported successfully")
+	return nil
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExportDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	d, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	snapshot := &protodb.DepositSnapshot{
+		Finalized:            [][]byte{bytesutil.PadTo([]byte{'a'}, 32), bytesutil.PadTo([]byte{'b'}, 32)},
+		DepositRoot:          bytesutil.PadTo([]byte{'c'}, 32),
+		DepositCount:         3,
+		ExecutionBlockHeight: 100,
+	}
+	require.NoError(t, d.SavePowchainData(ctx, &protodb.ETH1ChainData{DepositSnapshot: snapshot}))
+	require.NoError(t, d.Close())
+
+	outputFile := path.Join(t.TempDir(), "deposit_snapshot")
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.String(cmd.DepositSnapshotOutputFileFlag.Name, outputFile, "")
+	require.NoError(t, ExportDepositSnapshot(cli.NewContext(&app, set, nil)))
+
+	enc, err := ioutil.ReadFile(outputFile)
+	require.NoError(t, err)
+	exported := &protodb.DepositSnapshot{}
+	require.NoError(t, proto.Unmarshal(enc, exported))
+	assert.DeepEqual(t, snapshot, exported)
+}
+
+func TestExportDepositSnapshot_NoSnapshot(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	d, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	require.NoError(t, d.SavePowchainData(ctx, &protodb.ETH1ChainData{}))
+	require.NoError(t, d.Close())
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.String(cmd.DepositSnapshotOutputFileFlag.Name, path.Join(t.TempDir(), "deposit_snapshot"), "")
+	assert.ErrorContains(t, "database has no deposit snapshot", ExportDepositSnapshot(cli.NewContext(&app, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, t.TempDir(), "")
+	assert.ErrorContains(t, "no database found", ExportDepositSnapshot(cli.NewContext(&app, set, nil)))
+}
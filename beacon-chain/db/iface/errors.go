@@ -8,4 +8,12 @@ var (
 	// ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 	// when one already exists in a database.
 	ErrExistingGenesisState = errors.New("genesis state exists already in the DB")
+	// ErrExistingChainData is an error when the user attempts to initialize a database from a
+	// checkpoint when it already holds a finalized chain.
+	ErrExistingChainData = errors.New("finalized chain data exists already in the DB")
+	// ErrNotFoundOriginBlockRoot is an error when the database was not initialized from a checkpoint.
+	ErrNotFoundOriginBlockRoot = errors.New("origin checkpoint block root not found in the DB")
+	// ErrNotFoundBackfillBlockRoot is an error when the database was not initialized from a checkpoint,
+	// so that there are no blocks to backfill.
+	ErrNotFoundBackfillBlockRoot = errors.New("backfill block root not found in the DB")
 )
@@ -29,6 +29,8 @@ type ReadOnlyDatabase interface {
 	BlockRootsBySlot(ctx context.Context, slot types.Slot) (bool, [][32]byte, error)
 	HasBlock(ctx context.Context, blockRoot [32]byte) bool
 	GenesisBlock(ctx context.Context) (interfaces.SignedBeaconBlock, error)
+	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
 	FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (interfaces.SignedBeaconBlock, error)
 	HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) ([]interfaces.SignedBeaconBlock, error)
@@ -39,6 +41,7 @@ type ReadOnlyDatabase interface {
 	StateSummary(ctx context.Context, blockRoot [32]byte) (*statepb.StateSummary, error)
 	HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	HighestSlotStatesBelow(ctx context.Context, slot types.Slot) ([]state.ReadOnlyBeaconState, error)
+	EpochState(ctx context.Context, epoch types.Epoch) (state.BeaconState, error)
 	// Slashing operations.
 	ProposerSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.ProposerSlashing, error)
 	AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.AttesterSlashing, error)
@@ -58,6 +61,8 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*v2.ETH1ChainData, error)
+	// Fork choice operations.
+	ForkChoiceStore(ctx context.Context) (*v2.ForkChoiceStore, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -68,6 +73,7 @@ type NoHeadAccessDatabase interface {
 	SaveBlock(ctx context.Context, block interfaces.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock) error
 	// State related methods.
 	SaveState(ctx context.Context, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []state.ReadOnlyBeaconState, blockRoots [][32]byte) error
@@ -75,6 +81,8 @@ type NoHeadAccessDatabase interface {
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
 	SaveStateSummary(ctx context.Context, summary *statepb.StateSummary) error
 	SaveStateSummaries(ctx context.Context, summaries []*statepb.StateSummary) error
+	SaveStateSnapshot(ctx context.Context, epoch types.Epoch, state state.ReadOnlyBeaconState) error
+	SaveStateDiff(ctx context.Context, epoch types.Epoch, base, target state.ReadOnlyBeaconState) error
 	// Slashing operations.
 	SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error
 	SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error
@@ -87,6 +95,8 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *v2.ETH1ChainData) error
+	// Fork choice operations.
+	SaveForkChoiceStore(ctx context.Context, store *v2.ForkChoiceStore) error
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
 
@@ -105,6 +115,10 @@ type HeadAccessDatabase interface {
 	LoadGenesis(ctx context.Context, r io.Reader) error
 	SaveGenesisData(ctx context.Context, state state.BeaconState) error
 	EnsureEmbeddedGenesis(ctx context.Context) error
+
+	// Checkpoint sync operations.
+	LoadOrigin(ctx context.Context, stateReader, blockReader io.Reader) error
+	SaveOrigin(ctx context.Context, state state.BeaconState, block interfaces.SignedBeaconBlock) error
 }
 
 // SlasherDatabase interface for persisting data related to detecting slashable offenses on Ethereum.
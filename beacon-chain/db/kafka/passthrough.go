@@ -229,6 +229,16 @@ func (e Exporter) SavePowchainData(ctx context.Context, data *v2.ETH1ChainData)
 	return e.db.SavePowchainData(ctx, data)
 }
 
+// ForkChoiceStore -- passthrough
+func (e Exporter) ForkChoiceStore(ctx context.Context) (*v2.ForkChoiceStore, error) {
+	return e.db.ForkChoiceStore(ctx)
+}
+
+// SaveForkChoiceStore -- passthrough
+func (e Exporter) SaveForkChoiceStore(ctx context.Context, store *v2.ForkChoiceStore) error {
+	return e.db.SaveForkChoiceStore(ctx, store)
+}
+
 // ArchivedPointRoot -- passthrough
 func (e Exporter) ArchivedPointRoot(ctx context.Context, index types.Slot) [32]byte {
 	return e.db.ArchivedPointRoot(ctx, index)
@@ -244,6 +254,16 @@ func (e Exporter) LastArchivedRoot(ctx context.Context) [32]byte {
 	return e.db.LastArchivedRoot(ctx)
 }
 
+// OriginCheckpointBlockRoot -- passthrough
+func (e Exporter) OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.OriginCheckpointBlockRoot(ctx)
+}
+
+// BackfillBlockRoot -- passthrough
+func (e Exporter) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.BackfillBlockRoot(ctx)
+}
+
 // HighestSlotBlocksBelow -- passthrough
 func (e Exporter) HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) ([]interfaces.SignedBeaconBlock, error) {
 	return e.db.HighestSlotBlocksBelow(ctx, slot)
@@ -254,6 +274,21 @@ func (e Exporter) HighestSlotStatesBelow(ctx context.Context, slot types.Slot) (
 	return e.db.HighestSlotStatesBelow(ctx, slot)
 }
 
+// EpochState -- passthrough
+func (e Exporter) EpochState(ctx context.Context, epoch types.Epoch) (state.BeaconState, error) {
+	return e.db.EpochState(ctx, epoch)
+}
+
+// SaveStateSnapshot -- passthrough
+func (e Exporter) SaveStateSnapshot(ctx context.Context, epoch types.Epoch, st state.ReadOnlyBeaconState) error {
+	return e.db.SaveStateSnapshot(ctx, epoch, st)
+}
+
+// SaveStateDiff -- passthrough
+func (e Exporter) SaveStateDiff(ctx context.Context, epoch types.Epoch, base, target state.ReadOnlyBeaconState) error {
+	return e.db.SaveStateDiff(ctx, epoch, base, target)
+}
+
 // LastArchivedSlot -- passthrough
 func (e Exporter) LastArchivedSlot(ctx context.Context) (types.Slot, error) {
 	return e.db.LastArchivedSlot(ctx)
@@ -283,3 +318,18 @@ func (e Exporter) SaveGenesisData(ctx context.Context, state state.BeaconState)
 func (e Exporter) EnsureEmbeddedGenesis(ctx context.Context) error {
 	return e.db.EnsureEmbeddedGenesis(ctx)
 }
+
+// SaveBackfillBlocks -- passthrough.
+func (e Exporter) SaveBackfillBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock) error {
+	return e.db.SaveBackfillBlocks(ctx, blocks)
+}
+
+// LoadOrigin -- passthrough.
+func (e Exporter) LoadOrigin(ctx context.Context, stateReader, blockReader io.Reader) error {
+	return e.db.LoadOrigin(ctx, stateReader, blockReader)
+}
+
+// SaveOrigin -- passthrough.
+func (e Exporter) SaveOrigin(ctx context.Context, state state.BeaconState, block interfaces.SignedBeaconBlock) error {
+	return e.db.SaveOrigin(ctx, state, block)
+}
@@ -0,0 +1,128 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	dbpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// BackfillBlockRoot returns the root of the earliest block of a database initialized from a checkpoint.
+// The blocks from this block up to the checkpoint block form an unbroken chain, which backfilling
+// extends backwards until it reaches the genesis block. ErrNotFoundBackfillBlockRoot is returned if
+// the database was initialized from genesis.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		r := tx.Bucket(blocksBucket).Get(backfillBlockRootKey)
+		if r == nil {
+			return dbIface.ErrNotFoundBackfillBlockRoot
+		}
+		copy(root[:], r)
+		return nil
+	})
+	return root, err
+}
+
+// SaveBackfillBlocks saves blocks preceding the earliest block of the database, in ascending slot order.
+// The blocks must form a chain ending at the parent of the current backfill block, they are indexed
+// as finalized and canonical, and the first of them becomes the new backfill block. Reaching the
+// genesis block completes the backfill.
+func (s *Store) SaveBackfillBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlocks")
+	defer span.End()
+
+	if len(blocks) == 0 {
+		return nil
+	}
+	backfillRoot, err := s.BackfillBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	child, err := s.Block(ctx, backfillRoot)
+	if err != nil {
+		return err
+	}
+	if child == nil || child.IsNil() {
+		return fmt.Errorf("missing backfill block in database: block root=%#x", backfillRoot)
+	}
+
+	// Check that the blocks link up to the current backfill block, from the last one backwards.
+	roots := make([][32]byte, len(blocks))
+	parentRoot := bytesutil.ToBytes32(child.Block().ParentRoot())
+	childSlot := child.Block().Slot()
+	for i := len(blocks) - 1; i >= 0; i-- {
+		if blocks[i] == nil || blocks[i].IsNil() || blocks[i].Block().IsNil() {
+			return errors.New("nil backfill block")
+		}
+		blk := blocks[i].Block()
+		root, err := blk.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if root != parentRoot {
+			return fmt.Errorf("block root %#x at slot %d does not match the parent root %#x of its child", root, blk.Slot(), parentRoot)
+		}
+		if blk.Slot() >= childSlot {
+			return fmt.Errorf("block slot %d is not lower than the slot %d of its child", blk.Slot(), childSlot)
+		}
+		roots[i] = root
+		parentRoot = bytesutil.ToBytes32(blk.ParentRoot())
+		childSlot = blk.Slot()
+	}
+
+	if err := s.SaveBlocks(ctx, blocks); err != nil {
+		return errors.Wrap(err, "could not save backfill blocks")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		blocksBkt := tx.Bucket(blocksBucket)
+		indexBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for i, signed := range blocks {
+			childRoot := backfillRoot
+			if i < len(blocks)-1 {
+				childRoot = roots[i+1]
+			}
+			enc, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{
+				ParentRoot: signed.Block().ParentRoot(),
+				ChildRoot:  childRoot[:],
+			})
+			if err != nil {
+				return err
+			}
+			if err := indexBkt.Put(roots[i][:], enc); err != nil {
+				return err
+			}
+		}
+
+		first := blocks[0].Block()
+		newBackfillRoot := roots[0][:]
+		genesisRoot := blocksBkt.Get(genesisBlockRootKey)
+		switch {
+		case first.Slot() == 0 && genesisRoot == nil:
+			if err := blocksBkt.Put(genesisBlockRootKey, newBackfillRoot); err != nil {
+				return err
+			}
+		case genesisRoot != nil && bytes.Equal(first.ParentRoot(), genesisRoot):
+			// The genesis block was saved along with the genesis state, so the chain is complete.
+			enc, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{ChildRoot: newBackfillRoot})
+			if err != nil {
+				return err
+			}
+			if err := indexBkt.Put(genesisRoot, enc); err != nil {
+				return err
+			}
+			newBackfillRoot = genesisRoot
+		}
+		return blocksBkt.Put(backfillBlockRootKey, newBackfillRoot)
+	})
+}
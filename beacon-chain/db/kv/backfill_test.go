@@ -0,0 +1,112 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// backfillChain returns blocks at the given ascending slots, each the child of the previous one.
+func backfillChain(t *testing.T, parentRoot []byte, slots ...types.Slot) ([]*ethpb.SignedBeaconBlock, [][32]byte) {
+	blks := make([]*ethpb.SignedBeaconBlock, len(slots))
+	roots := make([][32]byte, len(slots))
+	for i, slot := range slots {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = parentRoot
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		blks[i] = blk
+		roots[i] = root
+		parentRoot = root[:]
+	}
+	return blks, roots
+}
+
+func wrapBlocks(blks []*ethpb.SignedBeaconBlock) []interfaces.SignedBeaconBlock {
+	wrapped := make([]interfaces.SignedBeaconBlock, len(blks))
+	for i, blk := range blks {
+		wrapped[i] = wrapper.WrappedPhase0SignedBeaconBlock(blk)
+	}
+	return wrapped
+}
+
+func TestStore_SaveBackfillBlocks(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	_, err := db.BackfillBlockRoot(ctx)
+	assert.ErrorContains(t, iface.ErrNotFoundBackfillBlockRoot.Error(), err)
+
+	chain, roots := backfillChain(t, make([]byte, 32), 0, 10, 20, 30)
+	st, origin := checkpointStateAndBlock(t, 40)
+	origin.Block.ParentRoot = roots[3][:]
+	require.NoError(t, db.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(origin)))
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	backfillRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, originRoot, backfillRoot)
+
+	// Blocks which do not link up to the backfill block are rejected.
+	err = db.SaveBackfillBlocks(ctx, wrapBlocks(chain[1:3]))
+	assert.ErrorContains(t, "does not match the parent root", err)
+	err = db.SaveBackfillBlocks(ctx, wrapBlocks([]*ethpb.SignedBeaconBlock{chain[1], chain[3]}))
+	assert.ErrorContains(t, "does not match the parent root", err)
+	assert.Equal(t, false, db.HasBlock(ctx, roots[3]))
+
+	require.NoError(t, db.SaveBackfillBlocks(ctx, wrapBlocks(chain[2:])))
+	backfillRoot, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[2], backfillRoot)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[2]))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[3]))
+
+	require.NoError(t, db.SaveBackfillBlocks(ctx, wrapBlocks(chain[:2])))
+	backfillRoot, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[0], backfillRoot)
+	genesis, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, chain[0], genesis.Proto())
+
+	// The finalized blocks are linked from genesis up to the origin block.
+	child, err := db.FinalizedChildBlock(ctx, roots[1])
+	require.NoError(t, err)
+	assert.DeepEqual(t, chain[2], child.Proto())
+	child, err = db.FinalizedChildBlock(ctx, roots[3])
+	require.NoError(t, err)
+	assert.DeepEqual(t, origin, child.Proto())
+}
+
+func TestStore_SaveBackfillBlocks_ExistingGenesis(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	chain, roots := backfillChain(t, genesisRoot[:], 10, 20)
+	st, origin := checkpointStateAndBlock(t, 40)
+	origin.Block.ParentRoot = roots[1][:]
+	require.NoError(t, db.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(origin)))
+
+	require.NoError(t, db.SaveBackfillBlocks(ctx, wrapBlocks(chain)))
+	backfillRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, backfillRoot)
+	child, err := db.FinalizedChildBlock(ctx, genesisRoot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, chain[0], child.Proto())
+}
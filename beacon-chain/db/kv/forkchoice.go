@@ -0,0 +1,52 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	v2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveForkChoiceStore saves the fork choice store, replacing the previously saved one.
+func (s *Store) SaveForkChoiceStore(ctx context.Context, store *v2.ForkChoiceStore) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceStore")
+	defer span.End()
+
+	if store == nil {
+		err := errors.New("cannot save nil fork choice store")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	enc, err := encode(ctx, store)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(forkChoiceBucket)
+		return bkt.Put(forkChoiceStoreKey, enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// ForkChoiceStore retrieves the saved fork choice store, it returns nil if no store was saved.
+func (s *Store) ForkChoiceStore(ctx context.Context) (*v2.ForkChoiceStore, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceStore")
+	defer span.End()
+
+	var store *v2.ForkChoiceStore
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(forkChoiceBucket)
+		enc := bkt.Get(forkChoiceStoreKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		store = &v2.ForkChoiceStore{}
+		return decode(ctx, enc, store)
+	})
+	return store, err
+}
@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	v2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_ForkChoiceStore(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	store, err := db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*v2.ForkChoiceStore)(nil), store, "Expected no fork choice store")
+	assert.ErrorContains(t, "cannot save nil fork choice store", db.SaveForkChoiceStore(ctx, nil))
+
+	want := &v2.ForkChoiceStore{
+		PruneThreshold: 256,
+		JustifiedEpoch: 2,
+		FinalizedEpoch: 1,
+		FinalizedRoot:  bytesutil.PadTo([]byte{'a'}, 32),
+		Nodes: []*v2.ForkChoiceNode{
+			{Slot: 32, Root: bytesutil.PadTo([]byte{'a'}, 32), Parent: ^uint64(0), Weight: 10},
+		},
+		Votes:          []*v2.ForkChoiceVote{{NextRoot: bytesutil.PadTo([]byte{'a'}, 32), NextEpoch: 2}},
+		Balances:       []uint64{10},
+		SlashedIndices: nil,
+	}
+	require.NoError(t, db.SaveForkChoiceStore(ctx, want))
+	store, err = db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, store)
+
+	// Saving a store replaces the previous one.
+	want.Balances = []uint64{20}
+	require.NoError(t, db.SaveForkChoiceStore(ctx, want))
+	store, err = db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, store)
+}
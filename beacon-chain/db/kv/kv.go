@@ -124,7 +124,9 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			chainMetadataBucket,
 			checkpointBucket,
 			powchainBucket,
+			forkChoiceBucket,
 			stateSummaryBucket,
+			stateDiffBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
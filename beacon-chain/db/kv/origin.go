@@ -0,0 +1,161 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// LoadOrigin loads the SSZ encoded finalized state and block of a trusted checkpoint from the
+// given readers, and initializes the database from them with SaveOrigin.
+func (s *Store) LoadOrigin(ctx context.Context, stateReader, blockReader io.Reader) error {
+	stateBytes, err := ioutil.ReadAll(stateReader)
+	if err != nil {
+		return errors.Wrap(err, "could not read checkpoint state")
+	}
+	blockBytes, err := ioutil.ReadAll(blockReader)
+	if err != nil {
+		return errors.Wrap(err, "could not read checkpoint block")
+	}
+	st := &statepb.BeaconState{}
+	if err := st.UnmarshalSSZ(stateBytes); err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(blockBytes); err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	cs, err := statev1.InitializeFromProtoUnsafe(st)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(cs.Fork().CurrentVersion, params.BeaconConfig().GenesisForkVersion) {
+		return fmt.Errorf("checkpoint state fork version (%#x) does not match config genesis "+
+			"fork version (%#x)", cs.Fork().CurrentVersion, params.BeaconConfig().GenesisForkVersion)
+	}
+	return s.SaveOrigin(ctx, cs, wrapper.WrappedPhase0SignedBeaconBlock(blk))
+}
+
+// SaveOrigin initializes the database from the finalized state and block of a trusted checkpoint
+// instead of from genesis. The checkpoint block becomes the head of the chain as well as its
+// justified and finalized checkpoint, from which the node syncs forward.
+func (s *Store) SaveOrigin(ctx context.Context, st state.BeaconState, signed interfaces.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOrigin")
+	defer span.End()
+
+	if signed == nil || signed.IsNil() || signed.Block().IsNil() {
+		return errors.New("nil checkpoint block")
+	}
+	if st == nil || st.IsNil() {
+		return errors.New("nil checkpoint state")
+	}
+	finalized, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	if bytesutil.ToBytes32(finalized.Root) != params.BeaconConfig().ZeroHash {
+		return dbIface.ErrExistingChainData
+	}
+	blk := signed.Block()
+	if blk.Slot() != st.Slot() {
+		return fmt.Errorf("checkpoint state slot %d does not match checkpoint block slot %d", st.Slot(), blk.Slot())
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash checkpoint state")
+	}
+	if !bytes.Equal(blk.StateRoot(), stateRoot[:]) {
+		return fmt.Errorf("checkpoint state root %#x does not match checkpoint block state root %#x", stateRoot, blk.StateRoot())
+	}
+	genesisState, err := s.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if genesisState != nil && !genesisState.IsNil() &&
+		!bytes.Equal(genesisState.GenesisValidatorRoot(), st.GenesisValidatorRoot()) {
+		return fmt.Errorf("checkpoint genesis validators root %#x does not match the one of the genesis state %#x",
+			st.GenesisValidatorRoot(), genesisState.GenesisValidatorRoot())
+	}
+	blockRoot, err := blk.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash checkpoint block")
+	}
+
+	if err := s.SaveBlock(ctx, signed); err != nil {
+		return errors.Wrap(err, "could not save checkpoint block")
+	}
+	if err := s.SaveState(ctx, st, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state")
+	}
+	if err := s.SaveStateSummary(ctx, &statepb.StateSummary{
+		Slot: blk.Slot(),
+		Root: blockRoot[:],
+	}); err != nil {
+		return err
+	}
+	// The checkpoint state is indexed as an archived point, so that states after it can be
+	// regenerated from it in the absence of the genesis state.
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(stateSlotIndicesBucket).Put(bytesutil.SlotToBytesBigEndian(blk.Slot()), blockRoot[:]); err != nil {
+			return err
+		}
+		// Blocks before the checkpoint are backfilled from the checkpoint block backwards.
+		if err := tx.Bucket(blocksBucket).Put(backfillBlockRootKey, blockRoot[:]); err != nil {
+			return err
+		}
+		return tx.Bucket(blocksBucket).Put(originCheckpointBlockRootKey, blockRoot[:])
+	}); err != nil {
+		return errors.Wrap(err, "could not save origin checkpoint block root")
+	}
+
+	// The checkpoint block is the last block at or before the start of the finalized epoch.
+	epoch := helpers.SlotToEpoch(blk.Slot())
+	if !helpers.IsEpochStart(blk.Slot()) {
+		epoch++
+	}
+	checkpoint := &ethpb.Checkpoint{Epoch: epoch, Root: blockRoot[:]}
+	if err := s.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := s.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	if err := s.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	return nil
+}
+
+// OriginCheckpointBlockRoot returns the root of the checkpoint block the database was initialized
+// from, or ErrNotFoundOriginBlockRoot if the database was initialized from genesis.
+func (s *Store) OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OriginCheckpointBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		r := tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey)
+		if r == nil {
+			return dbIface.ErrNotFoundOriginBlockRoot
+		}
+		copy(root[:], r)
+		return nil
+	})
+	return root, err
+}
@@ -0,0 +1,135 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func checkpointStateAndBlock(t *testing.T, slot types.Slot) (state.BeaconState, *ethpb.SignedBeaconBlock) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+	blk.Block.StateRoot = stateRoot[:]
+	return st, blk
+}
+
+func TestStore_SaveOrigin(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	_, err := db.OriginCheckpointBlockRoot(ctx)
+	assert.ErrorContains(t, iface.ErrNotFoundOriginBlockRoot.Error(), err)
+
+	st, blk := checkpointStateAndBlock(t, 100)
+	require.NoError(t, db.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	originRoot, err := db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, originRoot)
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, head.Proto())
+	assert.Equal(t, true, db.HasState(ctx, root))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, root))
+	assert.Equal(t, root, db.LastArchivedRoot(ctx))
+	// Slot 100 is in epoch 3, so the block is the last one before the start of epoch 4.
+	want := &ethpb.Checkpoint{Epoch: 4, Root: root[:]}
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, finalized)
+	justified, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, justified)
+
+	// Finalizing a descendant stops walking the ancestry at the origin block.
+	child := testutil.NewBeaconBlock()
+	child.Block.Slot = 160
+	child.Block.ParentRoot = root[:]
+	childRoot, err := child.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(child)))
+	require.NoError(t, db.SaveStateSummary(ctx, &statepb.StateSummary{Slot: 160, Root: childRoot[:]}))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 5, Root: childRoot[:]}))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, childRoot))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, childRoot))
+	assert.ErrorContains(t, "cannot delete origin checkpoint state", db.DeleteState(ctx, root))
+
+	// The database can only be initialized once.
+	st, blk = checkpointStateAndBlock(t, 200)
+	err = db.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(blk))
+	assert.ErrorContains(t, iface.ErrExistingChainData.Error(), err)
+}
+
+func TestStore_SaveOrigin_EpochStart(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, blk := checkpointStateAndBlock(t, 96)
+	require.NoError(t, db.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(3), finalized.Epoch)
+}
+
+func TestStore_SaveOrigin_Mismatch(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, blk := checkpointStateAndBlock(t, 100)
+	blk.Block.StateRoot = bytesutil.PadTo([]byte("other"), 32)
+	err := db.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(blk))
+	assert.ErrorContains(t, "does not match checkpoint block state root", err)
+
+	st, blk = checkpointStateAndBlock(t, 100)
+	blk.Block.Slot = 101
+	err = db.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(blk))
+	assert.ErrorContains(t, "does not match checkpoint block slot", err)
+
+	gs, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, gs.SetGenesisValidatorRoot(bytesutil.PadTo([]byte("other"), 32)))
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+	st, blk = checkpointStateAndBlock(t, 100)
+	err = db.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(blk))
+	assert.ErrorContains(t, "does not match the one of the genesis state", err)
+}
+
+func TestStore_LoadOrigin(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, blk := checkpointStateAndBlock(t, 100)
+	stateBytes, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	blockBytes, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.LoadOrigin(ctx, bytes.NewReader(stateBytes), bytes.NewReader(blockBytes)))
+
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	originRoot, err := db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, originRoot)
+
+	err = db.LoadOrigin(ctx, bytes.NewReader(stateBytes[1:]), bytes.NewReader(blockBytes))
+	assert.ErrorContains(t, "could not unmarshal checkpoint state", err)
+}
@@ -17,6 +17,8 @@ var (
 	chainMetadataBucket     = []byte("chain-metadata")
 	checkpointBucket        = []byte("check-point")
 	powchainBucket          = []byte("powchain")
+	forkChoiceBucket        = []byte("fork-choice")
+	stateDiffBucket         = []byte("state-diff")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -35,12 +37,15 @@ var (
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
 
 	// Specific item keys.
-	headBlockRootKey          = []byte("head-root")
-	genesisBlockRootKey       = []byte("genesis-root")
-	depositContractAddressKey = []byte("deposit-contract")
-	justifiedCheckpointKey    = []byte("justified-checkpoint")
-	finalizedCheckpointKey    = []byte("finalized-checkpoint")
-	powchainDataKey           = []byte("powchain-data")
+	headBlockRootKey             = []byte("head-root")
+	genesisBlockRootKey          = []byte("genesis-root")
+	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
+	backfillBlockRootKey         = []byte("backfill-block-root")
+	depositContractAddressKey    = []byte("deposit-contract")
+	justifiedCheckpointKey       = []byte("justified-checkpoint")
+	finalizedCheckpointKey       = []byte("finalized-checkpoint")
+	powchainDataKey              = []byte("powchain-data")
+	forkChoiceStoreKey           = []byte("fork-choice-store")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
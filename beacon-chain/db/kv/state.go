@@ -169,6 +169,10 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 		if bytes.Equal(blockRoot[:], checkpoint.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], headBlkRoot) {
 			return errors.New("cannot delete genesis, finalized, or head state")
 		}
+		// The state of a checkpoint sync origin stands in for the genesis state.
+		if bytes.Equal(blockRoot[:], blockBkt.Get(originCheckpointBlockRootKey)) {
+			return errors.New("cannot delete origin checkpoint state")
+		}
 
 		slot, err := slotByBlockRoot(ctx, tx, blockRoot[:])
 		if err != nil {
@@ -367,11 +371,15 @@ func (s *Store) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint ty
 	deletedRoots := make([][32]byte, 0)
 
 	err = s.db.View(func(tx *bolt.Tx) error {
+		originRoot := tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey)
 		bkt := tx.Bucket(stateSlotIndicesBucket)
 		return bkt.ForEach(func(k, v []byte) error {
 			if ctx.Err() != nil {
 				return ctx.Err()
 			}
+			if bytes.Equal(v, originRoot) {
+				return nil
+			}
 
 			finalizedChkpt := bytesutil.ToBytes32(f.Root) == bytesutil.ToBytes32(v)
 			slot := bytesutil.BytesToSlotBigEndian(k)
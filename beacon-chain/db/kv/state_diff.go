@@ -0,0 +1,162 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Kinds of the entries of the state diff bucket. A snapshot entry holds a full state, a diff entry
+// holds the difference from the state of the previous epoch.
+const (
+	stateSnapshotKind byte = iota
+	stateDiffKind
+)
+
+// SaveStateSnapshot saves the full state at the start slot of an epoch, from which the states of
+// the following epochs can be rebuilt with the differences saved by SaveStateDiff.
+func (s *Store) SaveStateSnapshot(ctx context.Context, epoch types.Epoch, st state.ReadOnlyBeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateSnapshot")
+	defer span.End()
+
+	if err := checkEpochStateSlot(epoch, st); err != nil {
+		return err
+	}
+	pbState, err := v1.ProtobufBeaconState(st.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	enc, err := encode(ctx, pbState)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateDiffBucket).Put(bytesutil.EpochToBytesBigEndian(epoch), append([]byte{stateSnapshotKind}, enc...))
+	})
+}
+
+// SaveStateDiff saves the difference from the state at the start slot of the previous epoch to
+// the state at the start slot of the given epoch. The state of the previous epoch must have been
+// saved as a snapshot or a difference.
+func (s *Store) SaveStateDiff(ctx context.Context, epoch types.Epoch, base, target state.ReadOnlyBeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	if epoch == 0 {
+		return errors.New("cannot save a state difference for epoch 0")
+	}
+	if err := checkEpochStateSlot(epoch-1, base); err != nil {
+		return err
+	}
+	if err := checkEpochStateSlot(epoch, target); err != nil {
+		return err
+	}
+	basePb, err := v1.ProtobufBeaconState(base.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	targetPb, err := v1.ProtobufBeaconState(target.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	diff, err := statediff.Compute(basePb, targetPb)
+	if err != nil {
+		return errors.Wrap(err, "could not compute state difference")
+	}
+	enc, err := diff.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		if bkt.Get(bytesutil.EpochToBytesBigEndian(epoch-1)) == nil {
+			return fmt.Errorf("no state saved for epoch %d to apply the difference to", epoch-1)
+		}
+		return bkt.Put(bytesutil.EpochToBytesBigEndian(epoch), append([]byte{stateDiffKind}, snappy.Encode(nil, enc)...))
+	})
+}
+
+// EpochState returns the state at the start slot of an epoch, rebuilt from the closest snapshot at
+// or before the epoch and the differences saved since. It returns nil if no snapshot or
+// difference was saved for the epoch.
+func (s *Store) EpochState(ctx context.Context, epoch types.Epoch) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.EpochState")
+	defer span.End()
+
+	// Differences are collected from the requested epoch backwards until the snapshot.
+	var diffs [][]byte
+	var snapshot []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		for e := epoch; ; e-- {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			v := bkt.Get(bytesutil.EpochToBytesBigEndian(e))
+			if v == nil {
+				if e == epoch {
+					return nil
+				}
+				return fmt.Errorf("missing state difference for epoch %d", e)
+			}
+			switch {
+			case v[0] == stateSnapshotKind:
+				// Bolt values are only valid for the life of the transaction.
+				snapshot = make([]byte, len(v)-1)
+				copy(snapshot, v[1:])
+				return nil
+			case v[0] == stateDiffKind && e > 0:
+				enc, err := snappy.Decode(nil, v[1:])
+				if err != nil {
+					return errors.Wrapf(err, "could not decompress state difference for epoch %d", e)
+				}
+				diffs = append(diffs, enc)
+			default:
+				return fmt.Errorf("invalid state difference entry for epoch %d", e)
+			}
+		}
+	})
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+
+	st, err := createState(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(diffs) - 1; i >= 0; i-- {
+		diff, err := statediff.Unmarshal(diffs[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal state difference")
+		}
+		if err := diff.Apply(st); err != nil {
+			return nil, errors.Wrap(err, "could not apply state difference")
+		}
+	}
+	return v1.InitializeFromProtoUnsafe(st)
+}
+
+func checkEpochStateSlot(epoch types.Epoch, st state.ReadOnlyBeaconState) error {
+	if st == nil || st.IsNil() {
+		return errors.New("nil state")
+	}
+	slot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return err
+	}
+	if st.Slot() != slot {
+		return fmt.Errorf("state slot %d is not the start slot %d of epoch %d", st.Slot(), slot, epoch)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// epochStates returns the states at the start slots of the first epochs, with validator balances
+// and randao mixes changing from one epoch to the next.
+func epochStates(t *testing.T, count int) []state.BeaconState {
+	genesis, _ := testutil.DeterministicGenesisState(t, 32)
+	states := []state.BeaconState{genesis}
+	for i := 1; i < count; i++ {
+		st := states[i-1].Copy()
+		require.NoError(t, st.SetSlot(types.Slot(i)*params.BeaconConfig().SlotsPerEpoch))
+		require.NoError(t, st.UpdateBalancesAtIndex(types.ValidatorIndex(i), uint64(i)*1e9))
+		require.NoError(t, st.UpdateRandaoMixesAtIndex(uint64(i), bytesutil.PadTo([]byte{byte(i)}, 32)))
+		states = append(states, st)
+	}
+	return states
+}
+
+func TestStore_StateDiff_SaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	states := epochStates(t, 4)
+
+	require.NoError(t, db.SaveStateSnapshot(ctx, 0, states[0]))
+	for i := 1; i < len(states); i++ {
+		require.NoError(t, db.SaveStateDiff(ctx, types.Epoch(i), states[i-1], states[i]))
+	}
+	// The state of an epoch can be replaced by a snapshot.
+	require.NoError(t, db.SaveStateSnapshot(ctx, 2, states[2]))
+
+	for i, want := range states {
+		got, err := db.EpochState(ctx, types.Epoch(i))
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		require.DeepSSZEqual(t, want.InnerStateUnsafe(), got.InnerStateUnsafe())
+	}
+
+	st, err := db.EpochState(ctx, types.Epoch(len(states)))
+	require.NoError(t, err)
+	require.Equal(t, nil, st)
+}
+
+func TestStore_SaveStateDiff_MissingBase(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	states := epochStates(t, 3)
+
+	err := db.SaveStateDiff(ctx, 2, states[1], states[2])
+	require.ErrorContains(t, "no state saved for epoch 1", err)
+	err = db.SaveStateDiff(ctx, 1, states[1], states[2])
+	require.ErrorContains(t, "is not the start slot", err)
+	err = db.SaveStateSnapshot(ctx, 2, states[1])
+	require.ErrorContains(t, "is not the start slot", err)
+}
@@ -32,15 +32,24 @@ func (s *Store) PruneProposals(
 
 	// We retrieve the lowest stored slot in the proposals bucket.
 	var lowestSlot types.Slot
+	var empty bool
 	if err = s.db.View(func(tx *bolt.Tx) error {
 		proposalBkt := tx.Bucket(proposalRecordsBucket)
 		c := proposalBkt.Cursor()
 		k, _ := c.First()
+		if k == nil {
+			empty = true
+			return nil
+		}
 		lowestSlot = slotFromProposalKey(k)
 		return nil
 	}); err != nil {
 		return err
 	}
+	if empty {
+		log.Debug("No proposals stored, nothing to prune")
+		return nil
+	}
 
 	// If the lowest slot is greater than or equal to the end pruning slot,
 	// there is nothing to prune, so we return early.
@@ -121,15 +130,24 @@ func (s *Store) PruneAttestations(
 
 	// We retrieve the lowest stored epoch in the proposals bucket.
 	var lowestEpoch types.Epoch
+	var empty bool
 	if err := s.db.View(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(attestationDataRootsBucket)
 		c := bkt.Cursor()
 		k, _ := c.First()
+		if k == nil {
+			empty = true
+			return nil
+		}
 		lowestEpoch = types.Epoch(binary.LittleEndian.Uint64(k))
 		return nil
 	}); err != nil {
 		return err
 	}
+	if empty {
+		log.Debug("No attestations stored, nothing to prune")
+		return nil
+	}
 
 	// If the lowest slot is greater than or equal to the end pruning slot,
 	// there is nothing to prune, so we return early.
@@ -31,6 +31,15 @@ func TestStore_PruneProposals(t *testing.T) {
 		require.LogsContain(t, hook, "Current epoch 1 < history length 2, nothing to prune")
 	})
 
+	// If there are no proposals stored at all, there is nothing to prune.
+	t.Run("no_stored_proposals", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		beaconDB := setupDB(t)
+		err := beaconDB.PruneProposals(ctx, types.Epoch(10), types.Epoch(100), types.Epoch(2))
+		require.NoError(t, err)
+		require.LogsContain(t, hook, "No proposals stored, nothing to prune")
+	})
+
 	// If the lowest stored epoch in the database is >= the end epoch of the pruning process,
 	// there is nothing to prune, so we also expect exiting early.
 	t.Run("lowest_stored_epoch_greater_than_pruning_limit_epoch", func(t *testing.T) {
@@ -166,6 +175,15 @@ func TestStore_PruneAttestations_OK(t *testing.T) {
 		require.LogsContain(t, hook, "Current epoch 1 < history length 2, nothing to prune")
 	})
 
+	// If there are no attestations stored at all, there is nothing to prune.
+	t.Run("no_stored_attestations", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		beaconDB := setupDB(t)
+		err := beaconDB.PruneAttestations(ctx, types.Epoch(10), types.Epoch(100), types.Epoch(2))
+		require.NoError(t, err)
+		require.LogsContain(t, hook, "No attestations stored, nothing to prune")
+	})
+
 	// If the lowest stored epoch in the database is >= the end epoch of the pruning process,
 	// there is nothing to prune, so we also expect exiting early.
 	t.Run("lowest_stored_epoch_greater_than_pruning_limit_epoch", func(t *testing.T) {
@@ -2,9 +2,11 @@ package forkchoice
 
 import (
 	"context"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	v2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 )
 
 // ForkChoicer represents the full fork choice interface composed of all of the sub-interfaces.
@@ -12,8 +14,10 @@ type ForkChoicer interface {
 	HeadRetriever        // to compute head.
 	BlockProcessor       // to track new block for fork choice.
 	AttestationProcessor // to track new attestation for fork choice.
+	ProposerBooster      // to boost the score of timely blocks.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
+	Exporter             // to persist fork choice across restarts.
 }
 
 // HeadRetriever retrieves head root of the current chain.
@@ -29,6 +33,13 @@ type BlockProcessor interface {
 // AttestationProcessor processes the attestation that's used for accounting fork choice.
 type AttestationProcessor interface {
 	ProcessAttestation(context.Context, []uint64, [32]byte, types.Epoch)
+	InsertSlashedIndex(context.Context, types.ValidatorIndex)
+}
+
+// ProposerBooster boosts the score of the timely block of the current slot in fork choice.
+type ProposerBooster interface {
+	BoostProposerRoot(ctx context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime time.Time)
+	ResetBoostedProposerRoot(ctx context.Context)
 }
 
 // Pruner prunes the fork choice upon new finalization. This is used to keep fork choice sane.
@@ -46,3 +57,8 @@ type Getter interface {
 	AncestorRoot(ctx context.Context, root [32]byte, slot types.Slot) ([]byte, error)
 	IsCanonical(root [32]byte) bool
 }
+
+// Exporter exports the fork choice store in the form it is persisted in the database.
+type Exporter interface {
+	ToProto() *v2.ForkChoiceStore
+}
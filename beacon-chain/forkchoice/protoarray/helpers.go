@@ -3,6 +3,7 @@ package protoarray
 import (
 	"context"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
@@ -14,6 +15,7 @@ func computeDeltas(
 	blockIndices map[[32]byte]uint64,
 	votes []Vote,
 	oldBalances, newBalances []uint64,
+	slashedIndices map[types.ValidatorIndex]bool,
 ) ([]int, []Vote, error) {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.computeDeltas")
 	defer span.End()
@@ -38,6 +40,20 @@ func computeDeltas(
 			newBalance = newBalances[validatorIndex]
 		}
 
+		// The weight of an equivocating validator is removed from the block it last voted for,
+		// after which its vote is cleared so it is not accounted for again.
+		if slashedIndices[types.ValidatorIndex(validatorIndex)] {
+			currentDeltaIndex, ok := blockIndices[vote.currentRoot]
+			if ok {
+				if int(currentDeltaIndex) >= len(deltas) {
+					return nil, nil, errInvalidNodeDelta
+				}
+				deltas[currentDeltaIndex] -= int(oldBalance)
+			}
+			votes[validatorIndex] = Vote{currentRoot: params.BeaconConfig().ZeroHash, nextRoot: params.BeaconConfig().ZeroHash}
+			continue
+		}
+
 		// Perform delta only if the validator's balance or vote has changed.
 		if vote.currentRoot != vote.nextRoot || oldBalance != newBalance {
 			// Ignore the vote if it's not known in `blockIndices`,
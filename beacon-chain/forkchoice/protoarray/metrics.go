@@ -42,6 +42,12 @@ var (
 			Help: "The number of times an attestation is processed for fork choice.",
 		},
 	)
+	slashedIndicesCount = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "proto_array_slashed_indices_count",
+			Help: "The number of equivocating validators whose votes are not accounted for.",
+		},
+	)
 	prunedCount = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "proto_array_pruned_count",
@@ -0,0 +1,135 @@
+package protoarray
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	v2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ToProto returns a copy of the fork choice store, the latest votes and the justified balances
+// in the form they are persisted in the database.
+func (f *ForkChoice) ToProto() *v2.ForkChoiceStore {
+	f.votesLock.RLock()
+	defer f.votesLock.RUnlock()
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	f.store.proposerBoostLock.RLock()
+	defer f.store.proposerBoostLock.RUnlock()
+
+	nodes := make([]*v2.ForkChoiceNode, len(f.store.nodes))
+	for i, n := range f.store.nodes {
+		nodes[i] = &v2.ForkChoiceNode{
+			Slot:           n.slot,
+			Root:           bytesutil.SafeCopyBytes(n.root[:]),
+			Parent:         n.parent,
+			JustifiedEpoch: n.justifiedEpoch,
+			FinalizedEpoch: n.finalizedEpoch,
+			Weight:         n.weight,
+			BestChild:      n.bestChild,
+			BestDescendant: n.bestDescendant,
+			Graffiti:       bytesutil.SafeCopyBytes(n.graffiti[:]),
+		}
+	}
+	votes := make([]*v2.ForkChoiceVote, len(f.votes))
+	for i, v := range f.votes {
+		votes[i] = &v2.ForkChoiceVote{
+			CurrentRoot: bytesutil.SafeCopyBytes(v.currentRoot[:]),
+			NextRoot:    bytesutil.SafeCopyBytes(v.nextRoot[:]),
+			NextEpoch:   v.nextEpoch,
+		}
+	}
+	slashedIndices := make([]types.ValidatorIndex, 0, len(f.slashedIndices))
+	for index := range f.slashedIndices {
+		slashedIndices = append(slashedIndices, index)
+	}
+	sort.Slice(slashedIndices, func(i, j int) bool {
+		return slashedIndices[i] < slashedIndices[j]
+	})
+
+	return &v2.ForkChoiceStore{
+		PruneThreshold:             f.store.pruneThreshold,
+		JustifiedEpoch:             f.store.justifiedEpoch,
+		FinalizedEpoch:             f.store.finalizedEpoch,
+		FinalizedRoot:              bytesutil.SafeCopyBytes(f.store.finalizedRoot[:]),
+		Nodes:                      nodes,
+		Votes:                      votes,
+		Balances:                   append([]uint64{}, f.balances...),
+		SlashedIndices:             slashedIndices,
+		PreviousProposerBoostRoot:  bytesutil.SafeCopyBytes(f.store.previousProposerBoostRoot[:]),
+		PreviousProposerBoostScore: f.store.previousProposerBoostScore,
+	}
+}
+
+// NewFromProto restores a fork choice store from its persisted form. It verifies that the node
+// indices form a valid proto array and that all roots are well formed. The canonical nodes are
+// recomputed the next time head is computed.
+func NewFromProto(p *v2.ForkChoiceStore) (*ForkChoice, error) {
+	if p == nil {
+		return nil, errors.Wrap(errInvalidPersistedStore, "nil store")
+	}
+	if len(p.FinalizedRoot) != 32 || len(p.PreviousProposerBoostRoot) != 32 {
+		return nil, errors.Wrap(errInvalidPersistedStore, "invalid root length")
+	}
+
+	f := New(p.JustifiedEpoch, p.FinalizedEpoch, bytesutil.ToBytes32(p.FinalizedRoot))
+	s := f.store
+	s.pruneThreshold = p.PruneThreshold
+	s.previousProposerBoostRoot = bytesutil.ToBytes32(p.PreviousProposerBoostRoot)
+	s.previousProposerBoostScore = p.PreviousProposerBoostScore
+
+	count := uint64(len(p.Nodes))
+	for i, n := range p.Nodes {
+		index := uint64(i)
+		if n == nil || len(n.Root) != 32 || len(n.Graffiti) != 32 {
+			return nil, errors.Wrapf(errInvalidPersistedStore, "invalid node at index %d", i)
+		}
+		// Nodes are appended to the proto array after their parent, and before their children.
+		if n.Parent != NonExistentNode && n.Parent >= index {
+			return nil, errors.Wrapf(errInvalidPersistedStore, "invalid parent index of node at index %d", i)
+		}
+		if n.BestChild != NonExistentNode && (n.BestChild <= index || n.BestChild >= count) {
+			return nil, errors.Wrapf(errInvalidPersistedStore, "invalid best child index of node at index %d", i)
+		}
+		if n.BestDescendant != NonExistentNode && (n.BestDescendant <= index || n.BestDescendant >= count) {
+			return nil, errors.Wrapf(errInvalidPersistedStore, "invalid best descendant index of node at index %d", i)
+		}
+		root := bytesutil.ToBytes32(n.Root)
+		if _, ok := s.nodesIndices[root]; ok {
+			return nil, errors.Wrapf(errInvalidPersistedStore, "duplicated node root %#x", root)
+		}
+		s.nodesIndices[root] = index
+		s.nodes = append(s.nodes, &Node{
+			slot:           n.Slot,
+			root:           root,
+			parent:         n.Parent,
+			justifiedEpoch: n.JustifiedEpoch,
+			finalizedEpoch: n.FinalizedEpoch,
+			weight:         n.Weight,
+			bestChild:      n.BestChild,
+			bestDescendant: n.BestDescendant,
+			graffiti:       bytesutil.ToBytes32(n.Graffiti),
+		})
+	}
+
+	f.votes = make([]Vote, len(p.Votes))
+	for i, v := range p.Votes {
+		if v == nil || len(v.CurrentRoot) != 32 || len(v.NextRoot) != 32 {
+			return nil, errors.Wrapf(errInvalidPersistedStore, "invalid vote of validator %d", i)
+		}
+		f.votes[i] = Vote{
+			currentRoot: bytesutil.ToBytes32(v.CurrentRoot),
+			nextRoot:    bytesutil.ToBytes32(v.NextRoot),
+			nextEpoch:   v.NextEpoch,
+		}
+	}
+	f.balances = append(f.balances, p.Balances...)
+	for _, index := range p.SlashedIndices {
+		f.slashedIndices[index] = true
+	}
+	slashedIndicesCount.Set(float64(len(f.slashedIndices)))
+
+	return f, nil
+}
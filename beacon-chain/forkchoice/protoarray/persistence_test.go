@@ -0,0 +1,92 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestForkChoice_ToProto_NewFromProto(t *testing.T) {
+	ctx := context.Background()
+	balances := []uint64{1, 1, 1}
+	f := setup(1, 1)
+
+	// Insert blocks into the following tree:
+	//         0
+	//        / \
+	//       1   2
+	//       |
+	//       3
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'a'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{'b'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(3), indexToHash(1), [32]byte{'c'}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(2), 2)
+	f.ProcessAttestation(ctx, []uint64{1}, indexToHash(2), 2)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(3), 2)
+	f.InsertSlashedIndex(ctx, 5)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head")
+
+	restored, err := NewFromProto(f.ToProto())
+	require.NoError(t, err)
+	require.Equal(t, len(f.store.nodes), len(restored.store.nodes))
+	for i, n := range f.store.nodes {
+		assert.DeepEqual(t, n, restored.store.nodes[i])
+	}
+	assert.DeepEqual(t, f.store.nodesIndices, restored.store.nodesIndices)
+	assert.DeepEqual(t, f.votes, restored.votes)
+	assert.DeepEqual(t, f.balances, restored.balances)
+	assert.DeepEqual(t, f.slashedIndices, restored.slashedIndices)
+	assert.Equal(t, f.store.finalizedRoot, restored.store.finalizedRoot)
+	assert.Equal(t, f.store.justifiedEpoch, restored.store.justifiedEpoch)
+	assert.Equal(t, f.store.pruneThreshold, restored.store.pruneThreshold)
+
+	// The restored store keeps accounting votes on top of the persisted weights.
+	restored.ProcessAttestation(ctx, []uint64{0}, indexToHash(3), 3)
+	r, err = restored.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), r, "Incorrect head after restore")
+	assert.Equal(t, uint64(2), restored.Node(indexToHash(3)).Weight())
+	assert.Equal(t, uint64(1), restored.Node(indexToHash(2)).Weight())
+	assert.Equal(t, true, restored.IsCanonical(indexToHash(3)))
+}
+
+func TestNewFromProto_Invalid(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{}, 1)
+	require.NoError(t, err)
+
+	_, err = NewFromProto(nil)
+	assert.ErrorContains(t, errInvalidPersistedStore.Error(), err)
+
+	p := f.ToProto()
+	p.FinalizedRoot = []byte{'a'}
+	_, err = NewFromProto(p)
+	assert.ErrorContains(t, "invalid root length", err)
+
+	p = f.ToProto()
+	p.Nodes[1].Parent = 1
+	_, err = NewFromProto(p)
+	assert.ErrorContains(t, "invalid parent index of node at index 1", err)
+
+	p = f.ToProto()
+	p.Nodes[0].BestChild = 2
+	_, err = NewFromProto(p)
+	assert.ErrorContains(t, "invalid best child index of node at index 0", err)
+
+	p = f.ToProto()
+	p.Nodes[0].BestDescendant = 0
+	_, err = NewFromProto(p)
+	assert.ErrorContains(t, "invalid best descendant index of node at index 0", err)
+
+	p = f.ToProto()
+	p.Nodes[1].Root = p.Nodes[0].Root
+	_, err = NewFromProto(p)
+	assert.ErrorContains(t, "duplicated node root", err)
+}
@@ -0,0 +1,73 @@
+package protoarray
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// BoostProposerRoot sets the block root which should be boosted during
+// the LMD fork choice algorithm calculations. This is meant to reward timely,
+// proposed blocks which occur before a cutoff interval set to
+// SECONDS_PER_SLOT // INTERVALS_PER_SLOT.
+//
+// Spec pseudocode definition:
+//    # Add proposer score boost if the block is timely
+//    time_into_slot = (store.time - store.genesis_time) % SECONDS_PER_SLOT
+//    is_before_attesting_interval = time_into_slot < SECONDS_PER_SLOT // INTERVALS_PER_SLOT
+//    if get_current_slot(store) == block.slot and is_before_attesting_interval:
+//        store.proposer_boost_root = hash_tree_root(block)
+func (f *ForkChoice) BoostProposerRoot(ctx context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime time.Time) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.BoostProposerRoot")
+	defer span.End()
+
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	timeIntoSlot := uint64(time.Since(genesisTime).Seconds()) % secondsPerSlot
+	isBeforeAttestingInterval := timeIntoSlot < secondsPerSlot/params.BeaconConfig().IntervalsPerSlot
+	currentSlot := helpers.SlotsSince(genesisTime)
+
+	// Only update the boosted proposer root to the incoming block root
+	// if the block is for the current, clock-based slot and the block was timely.
+	if currentSlot == blockSlot && isBeforeAttestingInterval {
+		f.store.proposerBoostLock.Lock()
+		f.store.proposerBoostRoot = blockRoot
+		f.store.proposerBoostLock.Unlock()
+	}
+}
+
+// ResetBoostedProposerRoot resets the proposer boost root, which is done at the
+// start of every slot so the boost only applies to the slot of the boosted block.
+//
+// Spec pseudocode definition:
+//    # Reset store.proposer_boost_root if this is a new slot
+//    if current_slot > previous_slot:
+//        store.proposer_boost_root = Root()
+func (f *ForkChoice) ResetBoostedProposerRoot(ctx context.Context) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.ResetBoostedProposerRoot")
+	defer span.End()
+
+	f.store.proposerBoostLock.Lock()
+	f.store.proposerBoostRoot = [32]byte{}
+	f.store.proposerBoostLock.Unlock()
+}
+
+// Given a list of validator balances, we compute the proposer boost score
+// that should be given to a proposer based on their committee weight, derived from
+// the total active balances, the size of a committee, and a boost score constant.
+// The balances of inactive validators are expected to be 0, as in the justified balances of the store.
+//
+// Spec pseudocode definition:
+//    committee_weight = get_total_active_balance(state) // SLOTS_PER_EPOCH
+//    proposer_score = (committee_weight * PROPOSER_SCORE_BOOST) // 100
+func computeProposerBoostScore(validatorBalances []uint64) uint64 {
+	totalActiveBalance := uint64(0)
+	for _, balance := range validatorBalances {
+		totalActiveBalance += balance
+	}
+	committeeWeight := totalActiveBalance / uint64(params.BeaconConfig().SlotsPerEpoch)
+	return committeeWeight * params.BeaconConfig().ProposerScoreBoost / 100
+}
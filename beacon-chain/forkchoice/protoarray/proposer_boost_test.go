@@ -0,0 +1,86 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestComputeProposerBoostScore(t *testing.T) {
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = 10
+	}
+	// The total active balance of 640 gives a committee weight of 640 / 32 = 20,
+	// of which 70% is the boost.
+	assert.Equal(t, uint64(14), computeProposerBoostScore(balances))
+	assert.Equal(t, uint64(0), computeProposerBoostScore(nil))
+}
+
+func TestForkChoice_BoostProposerRoot(t *testing.T) {
+	ctx := context.Background()
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	f := setup(1, 1)
+
+	// A block of a past slot is not boosted.
+	genesis := time.Now().Add(-5 * secondsPerSlot)
+	f.BoostProposerRoot(ctx, 4, indexToHash(1), genesis)
+	assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+
+	// A block received after the attestation deadline of its slot is not boosted.
+	late := genesis.Add(-secondsPerSlot / 2)
+	f.BoostProposerRoot(ctx, 5, indexToHash(1), late)
+	assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+
+	// A timely block of the current slot is boosted.
+	f.BoostProposerRoot(ctx, 5, indexToHash(1), genesis)
+	assert.Equal(t, indexToHash(1), f.store.proposerBoostRoot)
+
+	f.ResetBoostedProposerRoot(ctx)
+	assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+}
+
+func TestForkChoice_ProposerBoostChangesHead(t *testing.T) {
+	ctx := context.Background()
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = 10
+	}
+	f := setup(1, 1)
+
+	// Insert blocks 1 and 2 with a vote for block 1:
+	//            0
+	//           / \
+	//  vote -> 1   2
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with a vote for block 1")
+
+	// Boosting block 2 outweighs the single vote for block 1.
+	genesis := time.Now().Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	f.BoostProposerRoot(ctx, 1, indexToHash(2), genesis)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with a boost for block 2")
+	assert.Equal(t, uint64(14), f.store.nodes[f.store.nodesIndices[indexToHash(2)]].weight)
+
+	// Computing head again does not apply the boost twice.
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with a boost for block 2")
+	assert.Equal(t, uint64(14), f.store.nodes[f.store.nodesIndices[indexToHash(2)]].weight)
+
+	// The boost is removed at the next slot.
+	f.ResetBoostedProposerRoot(ctx)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after the boost is reset")
+	assert.Equal(t, uint64(0), f.store.nodes[f.store.nodesIndices[indexToHash(2)]].weight)
+}
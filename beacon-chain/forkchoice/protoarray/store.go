@@ -34,7 +34,7 @@ func New(justifiedEpoch, finalizedEpoch types.Epoch, finalizedRoot [32]byte) *Fo
 	b := make([]uint64, 0)
 	v := make([]Vote, 0)
 
-	return &ForkChoice{store: s, balances: b, votes: v}
+	return &ForkChoice{store: s, balances: b, votes: v, slashedIndices: make(map[types.ValidatorIndex]bool)}
 }
 
 // Head returns the head root from fork choice store.
@@ -58,13 +58,13 @@ func (f *ForkChoice) Head(
 	// Using the write lock here because `updateCanonicalNodes` that gets called subsequently requires a write operation.
 	f.store.nodesLock.Lock()
 	defer f.store.nodesLock.Unlock()
-	deltas, newVotes, err := computeDeltas(ctx, f.store.nodesIndices, f.votes, f.balances, newBalances)
+	deltas, newVotes, err := computeDeltas(ctx, f.store.nodesIndices, f.votes, f.balances, newBalances, f.slashedIndices)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not compute deltas")
 	}
 	f.votes = newVotes
 
-	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
+	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, newBalances, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
 	}
 	f.balances = newBalances
@@ -81,6 +81,11 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	defer f.votesLock.Unlock()
 
 	for _, index := range validatorIndices {
+		// Votes of equivocating validators are not accounted for.
+		if f.slashedIndices[types.ValidatorIndex(index)] {
+			continue
+		}
+
 		// Validator indices will grow the vote cache.
 		for index >= uint64(len(f.votes)) {
 			f.votes = append(f.votes, Vote{currentRoot: params.BeaconConfig().ZeroHash, nextRoot: params.BeaconConfig().ZeroHash})
@@ -100,6 +105,21 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	processedAttestationCount.Inc()
 }
 
+// InsertSlashedIndex marks a validator as equivocating. Its latest vote is removed from
+// the weight of the tree the next time head is computed, and its future votes are ignored.
+func (f *ForkChoice) InsertSlashedIndex(ctx context.Context, index types.ValidatorIndex) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.InsertSlashedIndex")
+	defer span.End()
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
+
+	if f.slashedIndices[index] {
+		return
+	}
+	f.slashedIndices[index] = true
+	slashedIndicesCount.Set(float64(len(f.slashedIndices)))
+}
+
 // ProcessBlock processes a new block by inserting it to the fork choice store.
 func (f *ForkChoice) ProcessBlock(
 	ctx context.Context,
@@ -241,6 +261,21 @@ func (s *Store) NodesIndices() map[[32]byte]uint64 {
 	return s.nodesIndices
 }
 
+// IsViableForHead returns true if the node has the justified and finalized epochs
+// of the fork choice store, so it can be selected as head.
+func (s *Store) IsViableForHead(node *Node) bool {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+	return s.viableForHead(node)
+}
+
+// LeadsToViableHead returns true if the node or its best descendant is viable for head.
+func (s *Store) LeadsToViableHead(node *Node) (bool, error) {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+	return s.leadsToViableHead(node)
+}
+
 // head starts from justified root and then follows the best descendant links
 // to find the best block for head.
 func (s *Store) head(ctx context.Context, justifiedRoot [32]byte) ([32]byte, error) {
@@ -379,7 +414,9 @@ func (s *Store) insert(ctx context.Context,
 // and its best child. For each node, it updates the weight with input delta and
 // back propagate the nodes delta to its parents delta. After scoring changes,
 // the best child is then updated along with best descendant.
-func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalizedEpoch types.Epoch, delta []int) error {
+func (s *Store) applyWeightChanges(
+	ctx context.Context, justifiedEpoch, finalizedEpoch types.Epoch, newBalances []uint64, delta []int,
+) error {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.applyWeightChanges")
 	defer span.End()
 
@@ -394,6 +431,15 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		s.finalizedEpoch = finalizedEpoch
 	}
 
+	// The score of the timely block of the current slot is boosted, while the boost of the
+	// previously boosted block is removed.
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+	proposerScore := uint64(0)
+	if s.proposerBoostRoot != params.BeaconConfig().ZeroHash {
+		proposerScore = computeProposerBoostScore(newBalances)
+	}
+
 	// Iterate backwards through all index to node in store.
 	for i := len(s.nodes) - 1; i >= 0; i-- {
 		n := s.nodes[i]
@@ -405,6 +451,12 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		}
 
 		nodeDelta := delta[i]
+		if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash && s.previousProposerBoostRoot == n.root {
+			nodeDelta -= int(s.previousProposerBoostScore)
+		}
+		if s.proposerBoostRoot != params.BeaconConfig().ZeroHash && s.proposerBoostRoot == n.root {
+			nodeDelta += int(proposerScore)
+		}
 
 		if nodeDelta < 0 {
 			// A node's weight can not be negative but the delta can be negative.
@@ -431,6 +483,8 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 			delta[n.parent] += nodeDelta
 		}
 	}
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = proposerScore
 
 	for i := len(s.nodes) - 1; i >= 0; i-- {
 		n := s.nodes[i]
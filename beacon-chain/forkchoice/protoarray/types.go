@@ -8,22 +8,27 @@ import (
 
 // ForkChoice defines the overall fork choice store which includes all block nodes, validator's latest votes and balances.
 type ForkChoice struct {
-	store     *Store
-	votes     []Vote // tracks individual validator's last vote.
-	votesLock sync.RWMutex
-	balances  []uint64 // tracks individual validator's last justified balances.
+	store          *Store
+	votes          []Vote // tracks individual validator's last vote.
+	votesLock      sync.RWMutex
+	balances       []uint64                      // tracks individual validator's last justified balances.
+	slashedIndices map[types.ValidatorIndex]bool // tracks equivocating validators whose votes are not counted.
 }
 
 // Store defines the fork choice store which includes block nodes and the last view of checkpoint information.
 type Store struct {
-	pruneThreshold uint64              // do not prune tree unless threshold is reached.
-	justifiedEpoch types.Epoch         // latest justified epoch in store.
-	finalizedEpoch types.Epoch         // latest finalized epoch in store.
-	finalizedRoot  [32]byte            // latest finalized root in store.
-	nodes          []*Node             // list of block nodes, each node is a representation of one block.
-	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
-	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
-	nodesLock      sync.RWMutex
+	pruneThreshold             uint64              // do not prune tree unless threshold is reached.
+	justifiedEpoch             types.Epoch         // latest justified epoch in store.
+	finalizedEpoch             types.Epoch         // latest finalized epoch in store.
+	finalizedRoot              [32]byte            // latest finalized root in store.
+	nodes                      []*Node             // list of block nodes, each node is a representation of one block.
+	nodesIndices               map[[32]byte]uint64 // the root of block node and the nodes index in the list.
+	canonicalNodes             map[[32]byte]bool   // the canonical block nodes.
+	proposerBoostRoot          [32]byte            // latest block root that was boosted after being received in a timely manner.
+	previousProposerBoostRoot  [32]byte            // previous block root that was boosted after being received in a timely manner.
+	previousProposerBoostScore uint64              // previous proposer boosted root score.
+	nodesLock                  sync.RWMutex
+	proposerBoostLock          sync.RWMutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
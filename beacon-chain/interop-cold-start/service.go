@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/interop"
@@ -121,6 +122,11 @@ func (s *Service) AllDeposits(_ context.Context, _ *big.Int) []*ethpb.Deposit {
 	return []*ethpb.Deposit{}
 }
 
+// AllDepositContainers mocks out the deposit cache functionality for interop.
+func (s *Service) AllDepositContainers(_ context.Context) []*dbpb.DepositContainer {
+	return []*dbpb.DepositContainer{}
+}
+
 // ChainStartDeposits mocks out the powchain functionality for interop.
 func (s *Service) ChainStartDeposits() []*ethpb.Deposit {
 	return s.chainStartDeposits
@@ -151,6 +157,11 @@ func (s *Service) DepositsNumberAndRootAtHeight(_ context.Context, _ *big.Int) (
 	return 0, [32]byte{}
 }
 
+// DepositSnapshot mocks out the deposit cache functionality for interop.
+func (s *Service) DepositSnapshot(_ context.Context) *dbpb.DepositSnapshot {
+	return nil
+}
+
 // FinalizedDeposits mocks out the deposit cache functionality for interop.
 func (s *Service) FinalizedDeposits(_ context.Context) *depositcache.FinalizedDeposits {
 	return nil
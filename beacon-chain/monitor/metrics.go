@@ -0,0 +1,72 @@
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	balanceGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "validator_monitor_balance_gwei",
+			Help: "The balance of a monitored validator after the last epoch transition.",
+		},
+		[]string{"validator_index"},
+	)
+	inclusionDelayGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "validator_monitor_inclusion_delay",
+			Help: "The inclusion delay in slots of the last included attestation of a monitored validator.",
+		},
+		[]string{"validator_index"},
+	)
+	attestationsIncludedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_monitor_attestations_included_total",
+			Help: "The number of attestations of a monitored validator included in processed blocks.",
+		},
+		[]string{"validator_index"},
+	)
+	correctVotesCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_monitor_correct_votes_total",
+			Help: "The number of epochs in which a monitored validator voted for the correct source, target or head.",
+		},
+		[]string{"validator_index", "vote"},
+	)
+	missedAttestationsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_monitor_missed_attestations_total",
+			Help: "The number of epochs in which no attestation of an active monitored validator was included.",
+		},
+		[]string{"validator_index"},
+	)
+	proposedBlocksCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_monitor_proposed_blocks_total",
+			Help: "The number of processed blocks proposed by a monitored validator.",
+		},
+		[]string{"validator_index"},
+	)
+	missedBlocksCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_monitor_missed_blocks_total",
+			Help: "The number of slots assigned to a monitored validator without a processed block.",
+		},
+		[]string{"validator_index"},
+	)
+	slashingsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_monitor_slashings_total",
+			Help: "The number of slashings of a monitored validator included in processed blocks.",
+		},
+		[]string{"validator_index"},
+	)
+	exitsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_monitor_voluntary_exits_total",
+			Help: "The number of voluntary exits of a monitored validator included in processed blocks.",
+		},
+		[]string{"validator_index"},
+	)
+)
@@ -0,0 +1,160 @@
+package monitor
+
+import (
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/sirupsen/logrus"
+)
+
+// updateProposerDuties computes the slots of the epoch assigned to monitored proposers, from a
+// state of that epoch.
+func (s *Service) updateProposerDuties(st state.BeaconState, epoch types.Epoch) error {
+	s.proposerDuties = make(map[types.Slot]types.ValidatorIndex)
+	s.dutiesEpoch = epoch
+	if len(s.tracked) == 0 {
+		return nil
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return err
+	}
+	// The proposer index is computed from the state slot, which must not change on the shared state.
+	st = st.Copy()
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if slot == 0 {
+			continue
+		}
+		if err := st.SetSlot(slot); err != nil {
+			return err
+		}
+		idx, err := helpers.BeaconProposerIndex(st)
+		if err != nil {
+			return fmt.Errorf("could not compute proposer at slot %d: %v", slot, err)
+		}
+		if s.isTracked(idx) {
+			s.proposerDuties[slot] = idx
+		}
+	}
+	return nil
+}
+
+// processMissedProposals reports the monitored proposers of the slots in [start, end), for which no
+// block was processed.
+func (s *Service) processMissedProposals(start, end types.Slot) {
+	for slot := start; slot < end; slot++ {
+		idx, ok := s.proposerDuties[slot]
+		if !ok {
+			continue
+		}
+		missedBlocksCounter.WithLabelValues(indexLabel(idx)).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": idx,
+			"slot":           slot,
+		}).Warn("Missed block proposal")
+	}
+}
+
+func (s *Service) processProposal(blk interfaces.BeaconBlock, root [32]byte) {
+	idx := blk.ProposerIndex()
+	if !s.isTracked(idx) {
+		return
+	}
+	proposedBlocksCounter.WithLabelValues(indexLabel(idx)).Inc()
+	log.WithFields(logrus.Fields{
+		"validatorIndex": idx,
+		"slot":           blk.Slot(),
+		"blockRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+	}).Info("Proposed block")
+}
+
+// processAttestations reports the first inclusion of the attestations of monitored validators, with
+// their inclusion delay. The committees are computed from the post-state of the block, which has
+// the seeds of both the current and the previous epoch.
+func (s *Service) processAttestations(st state.ReadOnlyBeaconState, blk interfaces.BeaconBlock) error {
+	if len(s.tracked) == 0 {
+		return nil
+	}
+	for _, att := range blk.Body().Attestations() {
+		if att == nil || att.Data == nil {
+			continue
+		}
+		committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return err
+		}
+		indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+		if err != nil {
+			return err
+		}
+		for _, i := range indices {
+			idx := types.ValidatorIndex(i)
+			v, ok := s.tracked[idx]
+			if !ok || (v.hasAttestation && att.Data.Slot <= v.lastAttestationSlot) {
+				continue
+			}
+			v.lastAttestationSlot = att.Data.Slot
+			v.hasAttestation = true
+			delay := blk.Slot() - att.Data.Slot
+			attestationsIncludedCounter.WithLabelValues(indexLabel(idx)).Inc()
+			inclusionDelayGauge.WithLabelValues(indexLabel(idx)).Set(float64(delay))
+			log.WithFields(logrus.Fields{
+				"validatorIndex":  idx,
+				"attestationSlot": att.Data.Slot,
+				"inclusionSlot":   blk.Slot(),
+				"inclusionDelay":  delay,
+			}).Info("Attestation included")
+		}
+	}
+	return nil
+}
+
+func (s *Service) processSlashings(blk interfaces.BeaconBlock) {
+	for _, slashing := range blk.Body().ProposerSlashings() {
+		if slashing == nil || slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+			continue
+		}
+		s.reportSlashing(slashing.Header_1.Header.ProposerIndex, blk.Slot(), "proposer")
+	}
+	for _, slashing := range blk.Body().AttesterSlashings() {
+		if slashing == nil || slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+			continue
+		}
+		for _, i := range sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices) {
+			s.reportSlashing(types.ValidatorIndex(i), blk.Slot(), "attester")
+		}
+	}
+}
+
+func (s *Service) reportSlashing(idx types.ValidatorIndex, slot types.Slot, kind string) {
+	if !s.isTracked(idx) {
+		return
+	}
+	slashingsCounter.WithLabelValues(indexLabel(idx)).Inc()
+	log.WithFields(logrus.Fields{
+		"validatorIndex": idx,
+		"slot":           slot,
+		"kind":           kind,
+	}).Warn("Validator slashed")
+}
+
+func (s *Service) processExits(blk interfaces.BeaconBlock) {
+	for _, exit := range blk.Body().VoluntaryExits() {
+		if exit == nil || exit.Exit == nil || !s.isTracked(exit.Exit.ValidatorIndex) {
+			continue
+		}
+		exitsCounter.WithLabelValues(indexLabel(exit.Exit.ValidatorIndex)).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": exit.Exit.ValidatorIndex,
+			"slot":           blk.Slot(),
+			"exitEpoch":      exit.Exit.Epoch,
+		}).Info("Voluntary exit included")
+	}
+}
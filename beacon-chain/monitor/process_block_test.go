@@ -0,0 +1,120 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProcessAttestations_ReportsFirstInclusion(t *testing.T) {
+	hook := logTest.NewGlobal()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	committee, err := helpers.BeaconCommitteeFromState(st, 2, 0)
+	require.NoError(t, err)
+	s := NewService(context.Background(), &Config{Indices: []types.ValidatorIndex{committee[0]}})
+
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 5
+	b.Block.Body.Attestations = []*ethpb.Attestation{
+		testutil.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bits,
+			Data:            &ethpb.AttestationData{Slot: 2},
+		}),
+	}
+	blk := wrapper.WrappedPhase0SignedBeaconBlock(b).Block()
+	require.NoError(t, s.processAttestations(st, blk))
+	require.LogsContain(t, hook, "Attestation included")
+	require.LogsContain(t, hook, "inclusionDelay=3")
+
+	// The same attestation included again is not reported.
+	hook.Reset()
+	require.NoError(t, s.processAttestations(st, blk))
+	require.LogsDoNotContain(t, hook, "Attestation included")
+}
+
+func TestProcessAttestations_IgnoresOtherValidators(t *testing.T) {
+	hook := logTest.NewGlobal()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	committee, err := helpers.BeaconCommitteeFromState(st, 2, 0)
+	require.NoError(t, err)
+	s := NewService(context.Background(), &Config{Indices: []types.ValidatorIndex{committee[1]}})
+
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 5
+	b.Block.Body.Attestations = []*ethpb.Attestation{
+		testutil.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bits,
+			Data:            &ethpb.AttestationData{Slot: 2},
+		}),
+	}
+	require.NoError(t, s.processAttestations(st, wrapper.WrappedPhase0SignedBeaconBlock(b).Block()))
+	require.LogsDoNotContain(t, hook, "Attestation included")
+}
+
+func TestProcessSlashingsAndExits(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := NewService(context.Background(), &Config{Indices: []types.ValidatorIndex{2, 3, 4}})
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 10
+	b.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{{
+		Header_1: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{ProposerIndex: 2},
+		}),
+		Header_2: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{ProposerIndex: 2},
+		}),
+	}}
+	b.Block.Body.AttesterSlashings = []*ethpb.AttesterSlashing{{
+		Attestation_1: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 3}}),
+		Attestation_2: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{3, 5}}),
+	}}
+	b.Block.Body.VoluntaryExits = []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 4, Epoch: 1}},
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 5, Epoch: 1}},
+	}
+	blk := wrapper.WrappedPhase0SignedBeaconBlock(b).Block()
+	s.processSlashings(blk)
+	s.processExits(blk)
+
+	require.LogsContain(t, hook, "kind=proposer")
+	require.LogsContain(t, hook, "validatorIndex=2")
+	require.LogsContain(t, hook, "kind=attester")
+	require.LogsContain(t, hook, "validatorIndex=3")
+	require.LogsDoNotContain(t, hook, "validatorIndex=1")
+	require.LogsContain(t, hook, "Voluntary exit included")
+	require.LogsContain(t, hook, "validatorIndex=4")
+	require.LogsDoNotContain(t, hook, "validatorIndex=5")
+}
+
+func TestProcessMissedProposals(t *testing.T) {
+	hook := logTest.NewGlobal()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	indices := make([]types.ValidatorIndex, 64)
+	for i := range indices {
+		indices[i] = types.ValidatorIndex(i)
+	}
+	s := NewService(context.Background(), &Config{Indices: indices})
+	require.NoError(t, s.updateProposerDuties(st, 0))
+	// Every slot but the genesis slot has a monitored proposer.
+	require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch)-1, len(s.proposerDuties))
+	require.Equal(t, types.Slot(0), st.Slot())
+
+	s.processMissedProposals(3, 5)
+	require.LogsContain(t, hook, "slot=3")
+	require.LogsContain(t, hook, "slot=4")
+	require.LogsDoNotContain(t, hook, "slot=5")
+}
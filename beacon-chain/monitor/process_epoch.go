@@ -0,0 +1,74 @@
+package monitor
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/sirupsen/logrus"
+)
+
+// processEpoch reports the attestation performance of the monitored validators in the previous
+// epoch of the state, and their balances after the rewards and penalties of that epoch. The state
+// is the last one of its epoch, so that every attestation of the previous epoch is included.
+func (s *Service) processEpoch(ctx context.Context, st state.BeaconState) error {
+	if len(s.tracked) == 0 || helpers.CurrentEpoch(st) == 0 {
+		return nil
+	}
+	// Precompute processing modifies the balances of the state.
+	st = st.Copy()
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return err
+	}
+	vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return err
+	}
+	if _, err := precompute.ProcessRewardsAndPenaltiesPrecompute(st, bp, vp, precompute.AttestationsDelta, precompute.ProposersDelta); err != nil {
+		return err
+	}
+
+	epoch := helpers.PrevEpoch(st)
+	for idx, v := range s.tracked {
+		if uint64(idx) >= uint64(len(vp)) || !vp[idx].IsActivePrevEpoch {
+			continue
+		}
+		summary := vp[idx]
+		label := indexLabel(idx)
+		fields := logrus.Fields{
+			"validatorIndex": idx,
+			"epoch":          epoch,
+			"correctSource":  summary.IsPrevEpochAttester,
+			"correctTarget":  summary.IsPrevEpochTargetAttester,
+			"correctHead":    summary.IsPrevEpochHeadAttester,
+			"balance":        summary.AfterEpochTransitionBalance,
+		}
+		if summary.IsPrevEpochAttester {
+			fields["inclusionDistance"] = summary.InclusionDistance
+			correctVotesCounter.WithLabelValues(label, "source").Inc()
+		} else {
+			missedAttestationsCounter.WithLabelValues(label).Inc()
+		}
+		if summary.IsPrevEpochTargetAttester {
+			correctVotesCounter.WithLabelValues(label, "target").Inc()
+		}
+		if summary.IsPrevEpochHeadAttester {
+			correctVotesCounter.WithLabelValues(label, "head").Inc()
+		}
+		if v.hasBalance {
+			fields["balanceChange"] = int64(summary.AfterEpochTransitionBalance - v.lastBalance)
+		}
+		v.lastBalance = summary.AfterEpochTransitionBalance
+		v.hasBalance = true
+		balanceGauge.WithLabelValues(label).Set(float64(summary.AfterEpochTransitionBalance))
+
+		if summary.IsPrevEpochAttester {
+			log.WithFields(fields).Info("Attestation performance")
+		} else {
+			log.WithFields(fields).Warn("Missed attestation")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProcessEpoch_AttestationPerformance(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	committee, err := helpers.BeaconCommitteeFromState(st, 0, 0)
+	require.NoError(t, err)
+	var absent types.ValidatorIndex
+	for absent = 0; absent < 64; absent++ {
+		inCommittee := false
+		for _, idx := range committee {
+			inCommittee = inCommittee || idx == absent
+		}
+		if !inCommittee {
+			break
+		}
+	}
+
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		bits.SetBitAt(uint64(i), true)
+	}
+	require.NoError(t, st.AppendPreviousEpochAttestations(&statepb.PendingAttestation{
+		AggregationBits: bits,
+		Data:            testutil.HydrateAttestationData(&ethpb.AttestationData{}),
+		InclusionDelay:  1,
+	}))
+	require.NoError(t, st.SetSlot(2*params.BeaconConfig().SlotsPerEpoch-1))
+
+	s := NewService(ctx, &Config{Indices: []types.ValidatorIndex{committee[0], absent}})
+	require.NoError(t, s.processEpoch(ctx, st))
+	require.LogsContain(t, hook, "Attestation performance")
+	require.LogsContain(t, hook, "correctHead=true correctSource=true correctTarget=true")
+	require.LogsContain(t, hook, "inclusionDistance=1")
+	require.LogsContain(t, hook, "Missed attestation")
+	require.Equal(t, true, s.tracked[committee[0]].hasBalance)
+	require.Equal(t, types.Slot(2*params.BeaconConfig().SlotsPerEpoch-1), st.Slot())
+
+	// The balance change is reported from the second epoch on.
+	hook.Reset()
+	require.NoError(t, s.processEpoch(ctx, st))
+	require.LogsContain(t, hook, "balanceChange=")
+}
+
+func TestProcessEpoch_GenesisEpoch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	s := NewService(ctx, &Config{Indices: []types.ValidatorIndex{0}})
+	require.NoError(t, s.processEpoch(ctx, st))
+	require.LogsDoNotContain(t, hook, "attestation")
+}
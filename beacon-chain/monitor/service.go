@@ -0,0 +1,258 @@
+// Package monitor follows the processed blocks and epochs of the beacon chain for a configured set
+// of validators, and reports their performance in logs and Prometheus metrics.
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// maxSkippedEpochs is the longest run of epochs without blocks which is evaluated.
+const maxSkippedEpochs = 32
+
+// Config to set up the validator monitor service.
+type Config struct {
+	StateNotifier statefeed.Notifier
+	StateGen      stategen.StateManager
+	InitialSync   prysmsync.Checker
+	// Indices and PublicKeys are the validators to monitor. Public keys which are not in the
+	// validator registry yet are monitored as soon as they appear in a processed state.
+	Indices    []types.ValidatorIndex
+	PublicKeys [][48]byte
+}
+
+// trackedValidator holds what is remembered about a monitored validator between blocks and epochs.
+type trackedValidator struct {
+	// lastAttestationSlot is the slot of the last attestation of the validator included in a block.
+	lastAttestationSlot types.Slot
+	hasAttestation      bool
+	// lastBalance is the balance of the validator after the last reported epoch transition.
+	lastBalance uint64
+	hasBalance  bool
+}
+
+// Service follows the blocks processed by the blockchain service. For every block it reports
+// the proposals, attestation inclusions, slashings and voluntary exits of the monitored validators,
+// and at every epoch change the attestation performance and balance changes computed by the
+// precompute epoch processing.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	tracked     map[types.ValidatorIndex]*trackedValidator
+	pendingKeys map[[48]byte]bool
+	// lastState is the post-state of the last processed block, at lastSlot.
+	lastState state.BeaconState
+	lastSlot  types.Slot
+	// proposerDuties maps the slots of dutiesEpoch to the monitored validators assigned to propose.
+	proposerDuties map[types.Slot]types.ValidatorIndex
+	dutiesEpoch    types.Epoch
+}
+
+// NewService configures the validator monitor service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:            cfg,
+		ctx:            ctx,
+		cancel:         cancel,
+		tracked:        make(map[types.ValidatorIndex]*trackedValidator, len(cfg.Indices)),
+		pendingKeys:    make(map[[48]byte]bool, len(cfg.PublicKeys)),
+		proposerDuties: make(map[types.Slot]types.ValidatorIndex),
+	}
+	for _, idx := range cfg.Indices {
+		s.tracked[idx] = &trackedValidator{}
+	}
+	for _, key := range cfg.PublicKeys {
+		s.pendingKeys[key] = true
+	}
+	return s
+}
+
+// Start following the processed blocks.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"indices":    len(s.cfg.Indices),
+		"publicKeys": len(s.cfg.PublicKeys),
+	}).Info("Starting validator monitor")
+	go s.run()
+}
+
+// Stop the validator monitor.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the validator monitor.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := ev.Data.(*statefeed.BlockProcessedData)
+			if !ok || data == nil || data.SignedBlock == nil || data.SignedBlock.IsNil() {
+				continue
+			}
+			// Blocks are processed in batches during initial sync, without their states being
+			// cached, so the monitor starts over once the node is synced.
+			if s.cfg.InitialSync.Syncing() {
+				s.lastState = nil
+				continue
+			}
+			if err := s.processBlock(s.ctx, data.SignedBlock, data.BlockRoot); err != nil {
+				log.WithError(err).WithField("slot", data.Slot).Error("Could not process block for validator monitor")
+			}
+		case <-s.ctx.Done():
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Validator monitor subscription to state feed failed")
+			return
+		}
+	}
+}
+
+// processBlock reports the contents of a processed block which concern the monitored validators.
+// When the block is the first one processed in a new epoch, the epoch summary is reported first
+// from the post-state of the previous block, followed by the epochs skipped since that block.
+func (s *Service) processBlock(ctx context.Context, signed interfaces.SignedBeaconBlock, root [32]byte) error {
+	st, err := s.cfg.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return err
+	}
+	if st == nil || st.IsNil() {
+		return fmt.Errorf("missing post-state of block %#x", root)
+	}
+	blk := signed.Block()
+	slot := blk.Slot()
+	epoch := helpers.SlotToEpoch(slot)
+	s.resolvePublicKeys(st)
+
+	switch {
+	case s.lastState == nil:
+		if err := s.updateProposerDuties(st, epoch); err != nil {
+			return err
+		}
+	case slot > s.lastSlot:
+		s.processMissedProposals(s.lastSlot+1, slot)
+		if epoch > helpers.SlotToEpoch(s.lastSlot) {
+			if err := s.processEpoch(ctx, s.lastState); err != nil {
+				return err
+			}
+			if err := s.processSkippedEpochs(ctx, epoch); err != nil {
+				return err
+			}
+			if err := s.updateProposerDuties(st, epoch); err != nil {
+				return err
+			}
+			s.processMissedProposals(s.lastSlot+1, slot)
+		}
+	}
+
+	s.processProposal(blk, root)
+	if err := s.processAttestations(st, blk); err != nil {
+		return err
+	}
+	s.processSlashings(blk)
+	s.processExits(blk)
+
+	if s.lastState == nil || slot >= s.lastSlot {
+		s.lastState = st
+		s.lastSlot = slot
+	}
+	return nil
+}
+
+// processSkippedEpochs reports the epochs between the epoch of the last block and the input epoch,
+// which have no block. The post-state of the last block is advanced to the last slot of each of
+// them, to compute its proposer duties and the summary of the epoch before it. Too long gaps are
+// not evaluated, as advancing the state through them is too costly.
+func (s *Service) processSkippedEpochs(ctx context.Context, epoch types.Epoch) error {
+	lastEpoch := helpers.SlotToEpoch(s.lastSlot)
+	if epoch <= lastEpoch+1 {
+		return nil
+	}
+	if epoch-lastEpoch-1 > maxSkippedEpochs {
+		log.WithFields(logrus.Fields{
+			"fromEpoch": lastEpoch + 1,
+			"toEpoch":   epoch - 1,
+		}).Warn("Too many epochs without blocks, their proposals and attestations are not evaluated")
+		return nil
+	}
+	st := s.lastState.Copy()
+	for e := lastEpoch + 1; e < epoch; e++ {
+		startSlot, err := helpers.StartSlot(e)
+		if err != nil {
+			return err
+		}
+		endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch - 1
+		st, err = transition.ProcessSlots(ctx, st, endSlot)
+		if err != nil {
+			return errors.Wrapf(err, "could not process slots up to epoch %d", e)
+		}
+		if err := s.updateProposerDuties(st, e); err != nil {
+			return err
+		}
+		s.processMissedProposals(startSlot, endSlot+1)
+		if err := s.processEpoch(ctx, st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolvePublicKeys starts monitoring the validators configured by public key which are in the
+// validator registry of the state.
+func (s *Service) resolvePublicKeys(st state.ReadOnlyBeaconState) {
+	for key := range s.pendingKeys {
+		idx, ok := st.ValidatorIndexByPubkey(key)
+		if !ok {
+			continue
+		}
+		delete(s.pendingKeys, key)
+		if _, ok := s.tracked[idx]; !ok {
+			s.tracked[idx] = &trackedValidator{}
+		}
+		log.WithFields(logrus.Fields{
+			"validatorIndex": idx,
+			"pubKey":         fmt.Sprintf("%#x", key),
+		}).Info("Monitoring validator")
+	}
+}
+
+func (s *Service) isTracked(idx types.ValidatorIndex) bool {
+	_, ok := s.tracked[idx]
+	return ok
+}
+
+func indexLabel(idx types.ValidatorIndex) string {
+	return strconv.FormatUint(uint64(idx), 10)
+}
@@ -0,0 +1,218 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_ReceivesProcessedBlocks(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(1))
+	gen := stategen.NewMockService()
+	root := [32]byte{'a'}
+	gen.AddStateForRoot(st, root)
+
+	notifier := &mock.MockStateNotifier{}
+	s := NewService(ctx, &Config{
+		StateNotifier: notifier,
+		StateGen:      gen,
+		InitialSync:   &mockSync.Sync{IsSyncing: false},
+		Indices:       []types.ValidatorIndex{7},
+	})
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 1
+	b.Block.ProposerIndex = 7
+	ev := &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{
+			Slot:        1,
+			BlockRoot:   root,
+			SignedBlock: wrapper.WrappedPhase0SignedBeaconBlock(b),
+		},
+	}
+	// Wait for the service to subscribe to the state feed.
+	for notifier.StateFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.NoError(t, waitForLog(hook, "Proposed block"))
+	assert.Equal(t, types.Slot(1), s.lastSlot)
+}
+
+func TestService_SkipsBlocksDuringInitialSync(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	notifier := &mock.MockStateNotifier{}
+	s := NewService(ctx, &Config{
+		StateNotifier: notifier,
+		StateGen:      stategen.NewMockService(),
+		InitialSync:   &mockSync.Sync{IsSyncing: true},
+		Indices:       []types.ValidatorIndex{7},
+	})
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	b := testutil.NewBeaconBlock()
+	b.Block.ProposerIndex = 7
+	ev := &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{SignedBlock: wrapper.WrappedPhase0SignedBeaconBlock(b)},
+	}
+	for notifier.StateFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	// A second event is only received once the first one was handled.
+	notifier.StateFeed().Send(&feed.Event{Type: statefeed.Synced})
+	require.LogsDoNotContain(t, hook, "Proposed block")
+	require.LogsDoNotContain(t, hook, "Could not process block")
+}
+
+func TestService_ProcessBlock_EpochSummary(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	gen := stategen.NewMockService()
+	s := NewService(ctx, &Config{StateGen: gen, Indices: []types.ValidatorIndex{3}})
+
+	// Two blocks in consecutive epochs, after the first epoch.
+	for i, slot := range []types.Slot{2*slotsPerEpoch - 1, 2 * slotsPerEpoch} {
+		st, _ := testutil.DeterministicGenesisState(t, 64)
+		require.NoError(t, st.SetSlot(slot))
+		root := bytesutil.ToBytes32([]byte{byte(i + 1)})
+		gen.AddStateForRoot(st, root)
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = 10
+		require.NoError(t, s.processBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b), root))
+		if i == 0 {
+			require.LogsDoNotContain(t, hook, "Missed attestation")
+		}
+	}
+	require.LogsContain(t, hook, "Missed attestation")
+	assert.Equal(t, types.Epoch(2), s.dutiesEpoch)
+}
+
+func TestService_ProcessBlock_SkippedEpochs(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	gen := stategen.NewMockService()
+	indices := make([]types.ValidatorIndex, 64)
+	for i := range indices {
+		indices[i] = types.ValidatorIndex(i)
+	}
+	s := NewService(ctx, &Config{StateGen: gen, Indices: indices})
+
+	// The epochs 1 and 2 have no blocks, every slot has a monitored proposer.
+	for i, slot := range []types.Slot{1, 3*slotsPerEpoch + 1} {
+		st, _ := testutil.DeterministicGenesisState(t, 64)
+		require.NoError(t, st.SetSlot(slot))
+		root := bytesutil.ToBytes32([]byte{byte(i + 1)})
+		gen.AddStateForRoot(st, root)
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		require.NoError(t, s.processBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b), root))
+	}
+	missed := 0
+	for _, e := range hook.AllEntries() {
+		if e.Message == "Missed block proposal" {
+			missed++
+		}
+	}
+	assert.Equal(t, int(3*slotsPerEpoch-1), missed)
+	require.LogsContain(t, hook, fmt.Sprintf("slot=%d", slotsPerEpoch+1))
+	require.LogsContain(t, hook, fmt.Sprintf("slot=%d", 2*slotsPerEpoch+1))
+	assert.Equal(t, types.Epoch(3), s.dutiesEpoch)
+
+	// The summaries of the epochs 0 and 1 are reported from the state advanced through the skipped epochs.
+	summaries := make(map[interface{}]int)
+	for _, e := range hook.AllEntries() {
+		if e.Message == "Missed attestation" {
+			summaries[e.Data["epoch"]]++
+		}
+	}
+	assert.Equal(t, 64, summaries[types.Epoch(0)])
+	assert.Equal(t, 64, summaries[types.Epoch(1)])
+	assert.Equal(t, 2, len(summaries))
+}
+
+func TestService_ProcessBlock_TooManySkippedEpochs(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	gen := stategen.NewMockService()
+	indices := make([]types.ValidatorIndex, 64)
+	for i := range indices {
+		indices[i] = types.ValidatorIndex(i)
+	}
+	s := NewService(ctx, &Config{StateGen: gen, Indices: indices})
+
+	lastEpoch := types.Epoch(maxSkippedEpochs + 2)
+	for i, slot := range []types.Slot{1, types.Slot(lastEpoch)*slotsPerEpoch + 1} {
+		st, _ := testutil.DeterministicGenesisState(t, 64)
+		require.NoError(t, st.SetSlot(slot))
+		root := bytesutil.ToBytes32([]byte{byte(i + 1)})
+		gen.AddStateForRoot(st, root)
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		require.NoError(t, s.processBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b), root))
+	}
+	require.LogsContain(t, hook, "Too many epochs without blocks")
+	missed := 0
+	for _, e := range hook.AllEntries() {
+		if e.Message == "Missed block proposal" {
+			missed++
+		}
+	}
+	// Only the slots of the epochs of the two blocks are evaluated.
+	assert.Equal(t, int(slotsPerEpoch-2+1), missed)
+	assert.Equal(t, lastEpoch, s.dutiesEpoch)
+}
+
+func TestService_ResolvesPublicKeys(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	key := st.PubkeyAtIndex(5)
+	s := NewService(context.Background(), &Config{PublicKeys: [][48]byte{key, {'b'}}})
+	s.resolvePublicKeys(st)
+	_, ok := s.tracked[5]
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, len(s.tracked))
+	assert.Equal(t, 1, len(s.pendingKeys))
+}
+
+func waitForLog(hook *logTest.Hook, msg string) error {
+	for i := 0; i < 100; i++ {
+		for _, e := range hook.AllEntries() {
+			if e.Message == msg {
+				return nil
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return errors.Errorf("log message %q not found", msg)
+}
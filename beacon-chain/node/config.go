@@ -52,6 +52,14 @@ func configureSlotsPerArchivedPoint(cliCtx *cli.Context) {
 	}
 }
 
+func configureEpochsPerStateSnapshot(cliCtx *cli.Context) {
+	if cliCtx.IsSet(flags.EpochsPerStateSnapshot.Name) {
+		c := params.BeaconConfig()
+		c.EpochsPerStateSnapshot = types.Epoch(cliCtx.Int(flags.EpochsPerStateSnapshot.Name))
+		params.OverrideBeaconConfig(c)
+	}
+}
+
 func configureEth1Config(cliCtx *cli.Context) {
 	if cliCtx.IsSet(flags.ChainID.Name) {
 		c := params.BeaconConfig()
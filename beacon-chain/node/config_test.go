@@ -50,6 +50,20 @@ func TestConfigureSlotsPerArchivedPoint(t *testing.T) {
 	assert.Equal(t, types.Slot(100), params.BeaconConfig().SlotsPerArchivedPoint)
 }
 
+func TestConfigureEpochsPerStateSnapshot(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Int(flags.EpochsPerStateSnapshot.Name, 0, "")
+	require.NoError(t, set.Set(flags.EpochsPerStateSnapshot.Name, strconv.Itoa(64)))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	configureEpochsPerStateSnapshot(cliCtx)
+
+	assert.Equal(t, types.Epoch(64), params.BeaconConfig().EpochsPerStateSnapshot)
+}
+
 func TestConfigureProofOfWork(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 
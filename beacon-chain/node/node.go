@@ -6,6 +6,7 @@ package node
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"os/signal"
@@ -16,29 +17,36 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	gateway2 "github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -59,23 +67,27 @@ const testSkipPowFlag = "test-skip-pow"
 // full PoS node. It handles the lifecycle of the entire system and registers
 // services to a service registry.
 type BeaconNode struct {
-	cliCtx          *cli.Context
-	ctx             context.Context
-	cancel          context.CancelFunc
-	services        *shared.ServiceRegistry
-	lock            sync.RWMutex
-	stop            chan struct{} // Channel to wait for termination notifications.
-	db              db.Database
-	attestationPool attestations.Pool
-	exitPool        voluntaryexits.PoolManager
-	slashingsPool   slashings.PoolManager
-	depositCache    *depositcache.DepositCache
-	stateFeed       *event.Feed
-	blockFeed       *event.Feed
-	opFeed          *event.Feed
-	forkChoiceStore forkchoice.ForkChoicer
-	stateGen        *stategen.State
-	collector       *bcnodeCollector
+	cliCtx                  *cli.Context
+	ctx                     context.Context
+	cancel                  context.CancelFunc
+	services                *shared.ServiceRegistry
+	lock                    sync.RWMutex
+	stop                    chan struct{} // Channel to wait for termination notifications.
+	db                      db.Database
+	slasherDB               db.SlasherDatabase
+	attestationPool         attestations.Pool
+	exitPool                voluntaryexits.PoolManager
+	slashingsPool           slashings.PoolManager
+	syncCommsPool           synccommittee.Pool
+	depositCache            *depositcache.DepositCache
+	stateFeed               *event.Feed
+	blockFeed               *event.Feed
+	opFeed                  *event.Feed
+	forkChoiceStore         forkchoice.ForkChoicer
+	stateGen                *stategen.State
+	collector               *bcnodeCollector
+	slasherBlockHeadersFeed *event.Feed
+	slasherAttestationsFeed *event.Feed
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -91,6 +103,7 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 	configureChainConfig(cliCtx)
 	configureHistoricalSlasher(cliCtx)
 	configureSlotsPerArchivedPoint(cliCtx)
+	configureEpochsPerStateSnapshot(cliCtx)
 	configureEth1Config(cliCtx)
 	configureNetwork(cliCtx)
 	configureInteropConfig(cliCtx)
@@ -99,17 +112,20 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 
 	ctx, cancel := context.WithCancel(cliCtx.Context)
 	beacon := &BeaconNode{
-		cliCtx:          cliCtx,
-		ctx:             ctx,
-		cancel:          cancel,
-		services:        registry,
-		stop:            make(chan struct{}),
-		stateFeed:       new(event.Feed),
-		blockFeed:       new(event.Feed),
-		opFeed:          new(event.Feed),
-		attestationPool: attestations.NewPool(),
-		exitPool:        voluntaryexits.NewPool(),
-		slashingsPool:   slashings.NewPool(),
+		cliCtx:                  cliCtx,
+		ctx:                     ctx,
+		cancel:                  cancel,
+		services:                registry,
+		stop:                    make(chan struct{}),
+		stateFeed:               new(event.Feed),
+		blockFeed:               new(event.Feed),
+		opFeed:                  new(event.Feed),
+		attestationPool:         attestations.NewPool(),
+		exitPool:                voluntaryexits.NewPool(),
+		slashingsPool:           slashings.NewPool(),
+		syncCommsPool:           synccommittee.NewPool(),
+		slasherBlockHeadersFeed: new(event.Feed),
+		slasherAttestationsFeed: new(event.Feed),
 	}
 
 	depositAddress, err := registration.DepositContractAddress()
@@ -120,6 +136,10 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.startSlasherDB(cliCtx); err != nil {
+		return nil, err
+	}
+
 	beacon.startStateGen()
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
@@ -152,6 +172,18 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerSlasherService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerValidatorMonitorService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -237,6 +269,11 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
+	if b.slasherDB != nil {
+		if err := b.slasherDB.Close(); err != nil {
+			log.Errorf("Failed to close slasher database: %v", err)
+		}
+	}
 	b.collector.unregister()
 	b.cancel()
 	close(b.stop)
@@ -324,6 +361,10 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		return err
 	}
 
+	if err := b.initFromCheckpoint(cliCtx); err != nil {
+		return err
+	}
+
 	knownContract, err := b.db.DepositContractAddress(b.ctx)
 	if err != nil {
 		return err
@@ -345,6 +386,82 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	return nil
 }
 
+// initFromCheckpoint initializes a new database from the finalized checkpoint given on the
+// command line, so that the node syncs forward from it instead of from genesis.
+func (b *BeaconNode) initFromCheckpoint(cliCtx *cli.Context) error {
+	if !registration.CheckpointSyncEnabled(cliCtx) {
+		return nil
+	}
+	finalized, err := b.db.FinalizedCheckpoint(b.ctx)
+	if err != nil {
+		return err
+	}
+	if bytesutil.ToBytes32(finalized.Root) != params.BeaconConfig().ZeroHash {
+		log.Warn("Database already contains chain data, ignoring the checkpoint sync flags")
+		return nil
+	}
+	stateBytes, blockBytes, err := registration.CheckpointSync(b.ctx, cliCtx)
+	if err != nil {
+		return err
+	}
+	if err := b.db.LoadOrigin(b.ctx, bytes.NewReader(stateBytes), bytes.NewReader(blockBytes)); err != nil {
+		return errors.Wrap(err, "could not initialize database from checkpoint")
+	}
+	originRoot, err := b.db.OriginCheckpointBlockRoot(b.ctx)
+	if err != nil {
+		return err
+	}
+	log.WithField("blockRoot", fmt.Sprintf("%#x", originRoot)).Info("Initialized database from finalized checkpoint")
+	return nil
+}
+
+func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
+	if !featureconfig.Get().EnableSlasher {
+		return nil
+	}
+	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
+	dbPath := filepath.Join(baseDir, slasherkv.SlasherDbDirName)
+	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
+	forceClearDB := cliCtx.Bool(cmd.ForceClearDB.Name)
+
+	log.WithField("database-path", dbPath).Info("Checking slasher DB")
+
+	d, err := slasherkv.NewKVStore(b.ctx, dbPath, &slasherkv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	clearDBConfirmed := false
+	if clearDB && !forceClearDB {
+		actionText := "This will delete your slasher database stored in your data directory. " +
+			"Your database backups will not be removed - do you want to proceed? (Y/N)"
+		deniedText := "Database will not be deleted. No changes have been made."
+		clearDBConfirmed, err = cmd.ConfirmAction(actionText, deniedText)
+		if err != nil {
+			return err
+		}
+	}
+	if clearDBConfirmed || forceClearDB {
+		log.Warning("Removing slasher database")
+		if err := d.Close(); err != nil {
+			return errors.Wrap(err, "could not close db prior to clearing")
+		}
+		if err := d.ClearDB(); err != nil {
+			return errors.Wrap(err, "could not clear database")
+		}
+		d, err = slasherkv.NewKVStore(b.ctx, dbPath, &slasherkv.Config{
+			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not create new database")
+		}
+	}
+
+	b.slasherDB = d
+	return nil
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db)
 }
@@ -358,6 +475,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
 		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
 		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		TrustedPeers:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.TrustedPeers.Name)),
 		BootstrapNodeAddr: bootstrapNodeAddrs,
 		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
 		DataDir:           dataDir,
@@ -448,6 +566,14 @@ func (b *BeaconNode) registerPOWChainService() error {
 	if err != nil {
 		return err
 	}
+	depositSnapshot, err := registration.DepositSnapshot(b.cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not read deposit snapshot")
+	}
+	executionEndpoint, jwtSecret, err := registration.ExecutionEngineConfig(b.cliCtx)
+	if err != nil {
+		return err
+	}
 
 	bs, err := powchain.NewPowchainCollector(b.ctx)
 	if err != nil {
@@ -463,6 +589,9 @@ func (b *BeaconNode) registerPOWChainService() error {
 		StateGen:               b.stateGen,
 		Eth1HeaderReqLimit:     b.cliCtx.Uint64(flags.Eth1HeaderReqLimit.Name),
 		BeaconNodeStatsUpdater: bs,
+		DepositSnapshot:        depositSnapshot,
+		ExecutionEndpoint:      executionEndpoint,
+		JWTSecret:              jwtSecret,
 	}
 
 	web3Service, err := powchain.NewService(b.ctx, cfg)
@@ -490,22 +619,113 @@ func (b *BeaconNode) registerSyncService() error {
 	}
 
 	rs := regularsync.NewService(b.ctx, &regularsync.Config{
-		DB:                b.db,
-		P2P:               b.fetchP2P(),
-		Chain:             chainService,
-		InitialSync:       initSync,
-		StateNotifier:     b,
-		BlockNotifier:     b,
-		OperationNotifier: b,
-		AttPool:           b.attestationPool,
-		ExitPool:          b.exitPool,
-		SlashingPool:      b.slashingsPool,
-		StateGen:          b.stateGen,
+		DB:                      b.db,
+		P2P:                     b.fetchP2P(),
+		Chain:                   chainService,
+		InitialSync:             initSync,
+		StateNotifier:           b,
+		BlockNotifier:           b,
+		OperationNotifier:       b,
+		AttPool:                 b.attestationPool,
+		ExitPool:                b.exitPool,
+		SlashingPool:            b.slashingsPool,
+		SyncCommsPool:           b.syncCommsPool,
+		StateGen:                b.stateGen,
+		SlasherAttestationsFeed: b.slasherAttestationsFeed,
+		SlasherBlockHeadersFeed: b.slasherBlockHeadersFeed,
 	})
 
 	return b.services.RegisterService(rs)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		DB:          b.db,
+		P2P:         b.fetchP2P(),
+		Chain:       chainService,
+		InitialSync: initSync,
+	})
+	return b.services.RegisterService(bs)
+}
+
+func (b *BeaconNode) registerValidatorMonitorService() error {
+	indices := b.cliCtx.IntSlice(flags.MonitorIndices.Name)
+	keys := b.cliCtx.StringSlice(flags.MonitorPublicKeys.Name)
+	if len(indices) == 0 && len(keys) == 0 {
+		return nil
+	}
+	cfg := &monitor.Config{
+		StateNotifier: b,
+		StateGen:      b.stateGen,
+		Indices:       make([]types.ValidatorIndex, 0, len(indices)),
+		PublicKeys:    make([][48]byte, 0, len(keys)),
+	}
+	for _, idx := range indices {
+		if idx < 0 {
+			return fmt.Errorf("invalid validator index to monitor: %d", idx)
+		}
+		cfg.Indices = append(cfg.Indices, types.ValidatorIndex(idx))
+	}
+	for _, key := range keys {
+		enc, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil || len(enc) != 48 {
+			return fmt.Errorf("invalid validator public key to monitor: %s", key)
+		}
+		cfg.PublicKeys = append(cfg.PublicKeys, bytesutil.ToBytes48(enc))
+	}
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+	cfg.InitialSync = initSync
+
+	return b.services.RegisterService(monitor.NewService(b.ctx, cfg))
+}
+
+func (b *BeaconNode) registerSlasherService() error {
+	if !featureconfig.Get().EnableSlasher {
+		return nil
+	}
+	if b.slasherDB == nil {
+		return errors.New("slasher database is not initialized")
+	}
+	if b.slasherAttestationsFeed == nil || b.slasherBlockHeadersFeed == nil {
+		return errors.New("slasher feeds are not initialized")
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	var syncService *initialsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return err
+	}
+
+	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: b.slasherAttestationsFeed,
+		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
+		Database:                b.slasherDB,
+		StateNotifier:           b,
+		HeadStateFetcher:        chainService,
+		SlashingPoolInserter:    b.slashingsPool,
+		SyncChecker:             syncService,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not register slasher service")
+	}
+	return b.services.RegisterService(slasherSrv)
+}
+
 func (b *BeaconNode) registerInitialSyncService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -565,41 +785,44 @@ func (b *BeaconNode) registerRPCService() error {
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
-		Host:                    host,
-		Port:                    port,
-		BeaconMonitoringHost:    beaconMonitoringHost,
-		BeaconMonitoringPort:    beaconMonitoringPort,
-		CertFlag:                cert,
-		KeyFlag:                 key,
-		BeaconDB:                b.db,
-		Broadcaster:             p2pService,
-		PeersFetcher:            p2pService,
-		PeerManager:             p2pService,
-		MetadataProvider:        p2pService,
-		ChainInfoFetcher:        chainService,
-		HeadFetcher:             chainService,
-		CanonicalFetcher:        chainService,
-		ForkFetcher:             chainService,
-		FinalizationFetcher:     chainService,
-		BlockReceiver:           chainService,
-		AttestationReceiver:     chainService,
-		GenesisTimeFetcher:      chainService,
-		GenesisFetcher:          chainService,
-		AttestationsPool:        b.attestationPool,
-		ExitPool:                b.exitPool,
-		SlashingsPool:           b.slashingsPool,
-		POWChainService:         web3Service,
-		ChainStartFetcher:       chainStartFetcher,
-		MockEth1Votes:           mockEth1DataVotes,
-		SyncService:             syncService,
-		DepositFetcher:          depositFetcher,
-		PendingDepositFetcher:   b.depositCache,
-		BlockNotifier:           b,
-		StateNotifier:           b,
-		OperationNotifier:       b,
-		StateGen:                b.stateGen,
-		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
-		MaxMsgSize:              maxMsgSize,
+		Host:                          host,
+		Port:                          port,
+		BeaconMonitoringHost:          beaconMonitoringHost,
+		BeaconMonitoringPort:          beaconMonitoringPort,
+		CertFlag:                      cert,
+		KeyFlag:                       key,
+		BeaconDB:                      b.db,
+		Broadcaster:                   p2pService,
+		PeersFetcher:                  p2pService,
+		PeerManager:                   p2pService,
+		PeerAdmin:                     p2pService,
+		MetadataProvider:              p2pService,
+		ChainInfoFetcher:              chainService,
+		HeadFetcher:                   chainService,
+		CanonicalFetcher:              chainService,
+		ForkFetcher:                   chainService,
+		FinalizationFetcher:           chainService,
+		BlockReceiver:                 chainService,
+		AttestationReceiver:           chainService,
+		GenesisTimeFetcher:            chainService,
+		GenesisFetcher:                chainService,
+		ProposalPreparationSubscriber: chainService,
+		AttestationsPool:              b.attestationPool,
+		ExitPool:                      b.exitPool,
+		SlashingsPool:                 b.slashingsPool,
+		SyncCommitteeObjectPool:       b.syncCommsPool,
+		POWChainService:               web3Service,
+		ChainStartFetcher:             chainStartFetcher,
+		MockEth1Votes:                 mockEth1DataVotes,
+		SyncService:                   syncService,
+		DepositFetcher:                depositFetcher,
+		PendingDepositFetcher:         b.depositCache,
+		BlockNotifier:                 b,
+		StateNotifier:                 b,
+		OperationNotifier:             b,
+		StateGen:                      b.stateGen,
+		EnableDebugRPCEndpoints:       enableDebugRPCEndpoints,
+		MaxMsgSize:                    maxMsgSize,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -658,6 +881,20 @@ func (b *BeaconNode) registerGRPCGateway() error {
 
 	gatewayConfig := gateway2.DefaultConfig(enableDebugRPCEndpoints)
 
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	eventBroker := apimiddleware.NewEventBroker(b.ctx, &apimiddleware.EventBrokerConfig{
+		StateNotifier:                 b,
+		BlockNotifier:                 b,
+		OperationNotifier:             b,
+		ProposalPreparationSubscriber: chainService,
+	})
+	if err := b.services.RegisterService(eventBroker); err != nil {
+		return err
+	}
+
 	g := gateway.New(
 		b.ctx,
 		[]gateway.PbMux{gatewayConfig.V1Alpha1PbMux, gatewayConfig.V1PbMux},
@@ -667,7 +904,7 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	).WithAllowedOrigins(allowedOrigins).
 		WithRemoteCert(selfCert).
 		WithMaxCallRecvMsgSize(maxCallSize).
-		WithApiMiddleware(apiMiddlewareAddress, &apimiddleware.BeaconEndpointFactory{})
+		WithApiMiddleware(apiMiddlewareAddress, &apimiddleware.BeaconEndpointFactory{EventBroker: eventBroker})
 
 	return b.services.RegisterService(g)
 }
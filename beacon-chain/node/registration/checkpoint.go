@@ -0,0 +1,48 @@
+package registration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/checkpoint"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/urfave/cli/v2"
+)
+
+// CheckpointSyncEnabled returns true if a finalized checkpoint to start from is given on the command line.
+func CheckpointSyncEnabled(cliCtx *cli.Context) bool {
+	return cliCtx.String(flags.CheckpointSyncURL.Name) != "" ||
+		cliCtx.String(flags.CheckpointStatePath.Name) != "" ||
+		cliCtx.String(flags.CheckpointBlockPath.Name) != ""
+}
+
+// CheckpointSync returns the ssz encoded finalized state and block given on the command line, either
+// read from files or downloaded from a trusted beacon node.
+func CheckpointSync(ctx context.Context, cliCtx *cli.Context) (stateBytes, blockBytes []byte, err error) {
+	url := cliCtx.String(flags.CheckpointSyncURL.Name)
+	statePath := cliCtx.String(flags.CheckpointStatePath.Name)
+	blockPath := cliCtx.String(flags.CheckpointBlockPath.Name)
+	if url != "" {
+		if statePath != "" || blockPath != "" {
+			return nil, nil, errors.Errorf("--%s cannot be used together with --%s or --%s",
+				flags.CheckpointSyncURL.Name, flags.CheckpointStatePath.Name, flags.CheckpointBlockPath.Name)
+		}
+		log.WithField("url", url).Info("Downloading finalized checkpoint")
+		return checkpoint.DownloadFinalized(ctx, &http.Client{Timeout: checkpoint.DefaultTimeout}, url)
+	}
+	if statePath == "" || blockPath == "" {
+		return nil, nil, errors.Errorf("--%s and --%s must be used together",
+			flags.CheckpointStatePath.Name, flags.CheckpointBlockPath.Name)
+	}
+	stateBytes, err = fileutil.ReadFileAsBytes(statePath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read checkpoint state file")
+	}
+	blockBytes, err = fileutil.ReadFileAsBytes(blockPath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read checkpoint block file")
+	}
+	return stateBytes, blockBytes, nil
+}
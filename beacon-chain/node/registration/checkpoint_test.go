@@ -0,0 +1,46 @@
+package registration
+
+import (
+	"context"
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestCheckpointSync(t *testing.T) {
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.ssz")
+	blockPath := filepath.Join(dir, "block.ssz")
+	require.NoError(t, fileutil.WriteFile(statePath, []byte("state")))
+	require.NoError(t, fileutil.WriteFile(blockPath, []byte("block")))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.CheckpointStatePath.Name, statePath, "")
+	set.String(flags.CheckpointBlockPath.Name, blockPath, "")
+	set.String(flags.CheckpointSyncURL.Name, "", "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	assert.Equal(t, true, CheckpointSyncEnabled(cliCtx))
+	stateBytes, blockBytes, err := CheckpointSync(context.Background(), cliCtx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("state"), stateBytes)
+	assert.DeepEqual(t, []byte("block"), blockBytes)
+
+	require.NoError(t, set.Set(flags.CheckpointSyncURL.Name, "http://localhost:3500"))
+	_, _, err = CheckpointSync(context.Background(), cliCtx)
+	assert.ErrorContains(t, "cannot be used together", err)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String(flags.CheckpointStatePath.Name, statePath, "")
+	cliCtx = cli.NewContext(&app, set, nil)
+	_, _, err = CheckpointSync(context.Background(), cliCtx)
+	assert.ErrorContains(t, "must be used together", err)
+
+	assert.Equal(t, false, CheckpointSyncEnabled(cli.NewContext(&app, flag.NewFlagSet("test", 0), nil)))
+}
@@ -1,12 +1,15 @@
 package registration
 
 import (
-	"errors"
-
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/proto"
 )
 
 // PowchainPreregistration prepares data for powchain.Service's registration.
@@ -30,6 +33,41 @@ func PowchainPreregistration(cliCtx *cli.Context) (depositContractAddress string
 	return
 }
 
+// ExecutionEngineConfig returns the engine API endpoint given on the command line, along with
+// the secret authenticating the requests to it.
+func ExecutionEngineConfig(cliCtx *cli.Context) (endpoint string, jwtSecret []byte, err error) {
+	endpoint = cliCtx.String(flags.ExecutionEngineEndpoint.Name)
+	if endpoint == "" {
+		return "", nil, nil
+	}
+	secretPath := cliCtx.String(flags.JWTSecretFlag.Name)
+	if secretPath == "" {
+		return "", nil, errors.Errorf("--%s is required to connect to the execution engine", flags.JWTSecretFlag.Name)
+	}
+	jwtSecret, err = powchain.LoadJWTSecret(secretPath)
+	if err != nil {
+		return "", nil, err
+	}
+	return endpoint, jwtSecret, nil
+}
+
+// DepositSnapshot reads the deposit snapshot file given on the command line, if any.
+func DepositSnapshot(cliCtx *cli.Context) (*protodb.DepositSnapshot, error) {
+	path := cliCtx.String(flags.DepositSnapshotPath.Name)
+	if path == "" {
+		return nil, nil
+	}
+	enc, err := fileutil.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &protodb.DepositSnapshot{}
+	if err := proto.Unmarshal(enc, snapshot); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal deposit snapshot")
+	}
+	return snapshot, nil
+}
+
 // DepositContractAddress returns the address of the deposit contract.
 func DepositContractAddress() (string, error) {
 	address := params.BeaconConfig().DepositContractAddress
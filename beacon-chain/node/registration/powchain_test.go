@@ -1,15 +1,22 @@
 package registration
 
 import (
+	"bytes"
 	"flag"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestPowchainPreregistration(t *testing.T) {
@@ -69,3 +76,56 @@ func TestDepositContractAddress_NotHexAddress(t *testing.T) {
 	_, err := DepositContractAddress()
 	assert.ErrorContains(t, "invalid deposit contract address given", err)
 }
+
+func TestDepositSnapshot(t *testing.T) {
+	snapshot := &protodb.DepositSnapshot{
+		Finalized:            [][]byte{bytesutil.PadTo([]byte{'a'}, 32)},
+		DepositRoot:          bytesutil.PadTo([]byte{'b'}, 32),
+		DepositCount:         1,
+		ExecutionBlockHeight: 10,
+	}
+	enc, err := proto.Marshal(snapshot)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "deposit_snapshot")
+	require.NoError(t, fileutil.WriteFile(path, enc))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.DepositSnapshotPath.Name, path, "")
+	received, err := DepositSnapshot(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot, received)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String(flags.DepositSnapshotPath.Name, "", "")
+	received, err = DepositSnapshot(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	assert.Equal(t, true, received == nil, "Unexpected snapshot")
+}
+
+func TestExecutionEngineConfig(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, fileutil.WriteFile(secretPath, []byte("0x"+strings.Repeat("ab", 32))))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ExecutionEngineEndpoint.Name, "http://localhost:8551", "")
+	set.String(flags.JWTSecretFlag.Name, secretPath, "")
+	endpoint, secret, err := ExecutionEngineConfig(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8551", endpoint)
+	assert.DeepEqual(t, bytes.Repeat([]byte{0xab}, 32), secret)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String(flags.ExecutionEngineEndpoint.Name, "http://localhost:8551", "")
+	set.String(flags.JWTSecretFlag.Name, "", "")
+	_, _, err = ExecutionEngineConfig(cli.NewContext(&app, set, nil))
+	assert.ErrorContains(t, "--jwt-secret is required", err)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String(flags.ExecutionEngineEndpoint.Name, "", "")
+	endpoint, secret, err = ExecutionEngineConfig(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "", endpoint)
+	assert.Equal(t, true, secret == nil)
+}
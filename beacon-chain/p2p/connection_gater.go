@@ -36,11 +36,19 @@ func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow b
 	if s.peers.IsBad(pid) {
 		return false
 	}
+	if s.isBannedAddr(m) {
+		return false
+	}
 	return filterConnections(s.addrFilter, m)
 }
 
 // InterceptAccept checks whether the incidental inbound connection is allowed.
 func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
+	if s.isBannedAddr(n.RemoteMultiaddr()) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "banned ip address"}).Trace("Not accepting inbound dial from ip address")
+		return false
+	}
 	if !s.validateDial(n.RemoteMultiaddr()) {
 		// Allow other go-routines to run in the event
 		// we receive a large amount of junk connections.
@@ -49,7 +57,9 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
 		return false
 	}
-	if s.isPeerAtLimit(true /* inbound */) {
+	// The identity of the remote peer is not known yet, so inbound dials from the
+	// address of a trusted peer are let through until the connection is secured.
+	if s.isPeerAtLimit(true /* inbound */) && !s.isTrustedPeerAddr(n.RemoteMultiaddr()) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
 		return false
@@ -59,7 +69,18 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (s *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(dir network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	if s.peers.IsBanned(pid) {
+		log.WithFields(logrus.Fields{"peer": pid,
+			"reason": "banned peer"}).Trace("Not accepting connection")
+		return false
+	}
+	// Trusted peers are exempt from the inbound peer limit.
+	if dir == network.DirInbound && !s.peers.IsTrustedPeer(pid) && s.isPeerAtLimit(true /* inbound */) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "at peer limit"}).Trace("Not accepting inbound connection")
+		return false
+	}
 	return true
 }
 
@@ -68,6 +89,32 @@ func (s *Service) InterceptUpgraded(_ network.Conn) (allow bool, reason control.
 	return true, 0
 }
 
+// isBannedAddr checks whether the given address has an ip address in a banned ip range.
+func (s *Service) isBannedAddr(addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	return s.peers.IsIPBanned(ip)
+}
+
+// isTrustedPeerAddr checks whether the given address has the ip address of a trusted peer.
+func (s *Service) isTrustedPeerAddr(addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	for _, info := range s.trustedPeers {
+		for _, trustedAddr := range info.Addrs {
+			trustedIP, err := manet.ToIP(trustedAddr)
+			if err == nil && trustedIP.Equal(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (s *Service) validateDial(addr multiaddr.Multiaddr) bool {
 	ip, err := manet.ToIP(addr)
 	if err != nil {
@@ -3,10 +3,12 @@ package p2p
 import (
 	"context"
 	"fmt"
+	"net"
 	"testing"
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
@@ -132,6 +134,72 @@ func TestService_RejectInboundPeersBeyondLimit(t *testing.T) {
 	}
 }
 
+func TestService_AcceptTrustedPeersBeyondLimit(t *testing.T) {
+	limit := 20
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    limit,
+			ScorerParams: &scorers.Config{},
+		}),
+		host: mockp2p.NewTestP2P(t).BHost,
+		cfg:  &Config{MaxPeers: uint(limit)},
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	trustedAddress, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	otherAddress, err := ma.NewMultiaddr("/ip4/212.67.10.123/tcp/3000")
+	require.NoError(t, err)
+	trustedID := peer.ID("trusted")
+	s.trustedPeers = []peer.AddrInfo{{ID: trustedID, Addrs: []ma.Multiaddr{trustedAddress}}}
+	s.peers.SetTrustedPeers([]peer.ID{trustedID})
+
+	inboundLimit := int(float64(limit)*peers.InboundRatio) + highWatermarkBuffer + 1
+	for i := 0; i < inboundLimit; i++ {
+		addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: otherAddress}))
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: trustedAddress}))
+
+	// Once secured, only the trusted peer itself is let through.
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, trustedID, &maEndpoints{raddr: trustedAddress}))
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, "other", &maEndpoints{raddr: trustedAddress}))
+	assert.Equal(t, true, s.InterceptSecured(network.DirOutbound, "other", &maEndpoints{raddr: otherAddress}))
+}
+
+func TestService_RejectBannedPeers(t *testing.T) {
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    20,
+			ScorerParams: &scorers.Config{},
+		}),
+		host: mockp2p.NewTestP2P(t).BHost,
+		cfg:  &Config{MaxPeers: 20},
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	bannedAddress, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	otherAddress, err := ma.NewMultiaddr("/ip4/212.68.10.122/tcp/3000")
+	require.NoError(t, err)
+	_, ipNet, err := net.ParseCIDR("212.67.0.0/16")
+	require.NoError(t, err)
+	s.peers.BanIPRange(ipNet, 0)
+	s.peers.BanPeer("banned", 0)
+
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: bannedAddress}))
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: otherAddress}))
+	assert.Equal(t, false, s.InterceptAddrDial("other", bannedAddress))
+	assert.Equal(t, false, s.InterceptAddrDial("banned", otherAddress))
+	assert.Equal(t, true, s.InterceptAddrDial("other", otherAddress))
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, "banned", &maEndpoints{raddr: otherAddress}))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, "other", &maEndpoints{raddr: otherAddress}))
+}
+
 func TestPeer_BelowMaxLimit(t *testing.T) {
 	// create host and remote peer
 	ipAddr, pkey := createAddrAndPrivKey(t)
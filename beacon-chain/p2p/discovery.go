@@ -44,15 +44,26 @@ func (s *Service) RefreshENR() {
 	for _, idx := range committees {
 		bitV.SetBitAt(idx, true)
 	}
+	syncBitV := bitfield.NewBitvector4()
+	syncCommittees := cache.SyncSubnetIDs.GetAllSubnets()
+	for _, idx := range syncCommittees {
+		syncBitV.SetBitAt(idx, true)
+	}
 	currentBitV, err := bitvector(s.dv5Listener.Self().Record())
 	if err != nil {
 		log.Errorf("Could not retrieve bitfield: %v", err)
 		return
 	}
-	if bytes.Equal(bitV, currentBitV) {
-		// return early if bitfield hasn't changed
+	currentSyncBitV, err := syncBitvector(s.dv5Listener.Self().Record())
+	if err != nil {
+		log.Errorf("Could not retrieve sync bitfield: %v", err)
+		return
+	}
+	if bytes.Equal(bitV, currentBitV) && bytes.Equal(syncBitV, currentSyncBitV) {
+		// return early if bitfields haven't changed
 		return
 	}
+	s.updateSyncSubnetRecord(syncBitV)
 	s.updateSubnetRecordWithMetadata(bitV)
 	// ping all peers to inform them of new metadata
 	s.pingPeers()
@@ -206,7 +217,8 @@ func (s *Service) createLocalNode(
 	if err != nil {
 		return nil, errors.Wrap(err, "could not add eth2 fork version entry to enr")
 	}
-	return intializeAttSubnets(localNode), nil
+	localNode = intializeAttSubnets(localNode)
+	return initializeSyncCommSubnets(localNode), nil
 }
 
 func (s *Service) startDiscoveryV5(
@@ -78,4 +78,10 @@ func TestLoggingParameters(t *testing.T) {
 	logGossipParameters("testing", defaultAttesterSlashingTopicParams())
 	logGossipParameters("testing", defaultProposerSlashingTopicParams())
 	logGossipParameters("testing", defaultVoluntaryExitTopicParams())
+	p, err = defaultSyncContributionTopicParams()
+	assert.NoError(t, err)
+	logGossipParameters("testing", p)
+	p, err = defaultSyncSubnetTopicParams()
+	assert.NoError(t, err)
+	logGossipParameters("testing", p)
 }
@@ -2,6 +2,8 @@ package p2p
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/connmgr"
@@ -12,8 +14,10 @@ import (
 	"github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -25,6 +29,7 @@ type P2P interface {
 	PubSubProvider
 	PubSubTopicUser
 	PeerManager
+	PeerAdmin
 	Sender
 	ConnectionHandler
 	PeersProvider
@@ -35,6 +40,7 @@ type P2P interface {
 type Broadcaster interface {
 	Broadcast(context.Context, proto.Message) error
 	BroadcastAttestation(ctx context.Context, subnet uint64, att *ethpb.Attestation) error
+	BroadcastSyncCommitteeMessage(ctx context.Context, subnet uint64, sMsg *prysmv2.SyncCommitteeMessage) error
 }
 
 // SetStreamHandler configures p2p to handle streams of a certain topic ID.
@@ -48,6 +54,7 @@ type PubSubTopicUser interface {
 	LeaveTopic(topic string) error
 	PublishToTopic(ctx context.Context, topic string, data []byte, opts ...pubsub.PubOpt) error
 	SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub.Subscription, error)
+	CancelSubscription(sub *pubsub.Subscription)
 }
 
 // ConnectionHandler configures p2p to handle connections with a peer.
@@ -80,6 +87,16 @@ type PeerManager interface {
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
+// PeerAdmin provides the manual peer management operations available to node operators.
+type PeerAdmin interface {
+	BanPeer(ctx context.Context, pid peer.ID, duration time.Duration) error
+	UnbanPeer(pid peer.ID) bool
+	BanIPRange(ctx context.Context, ipNet *net.IPNet, duration time.Duration) error
+	UnbanIPRange(ipNet *net.IPNet) bool
+	DialPeer(ctx context.Context, addr string) (peer.ID, error)
+	DisconnectPeer(ctx context.Context, pid peer.ID, code p2ptypes.RPCGoodbyeCode) error
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, interface{}, string, peer.ID) (network.Stream, error)
@@ -1,8 +1,11 @@
 package p2p
 
 import (
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 )
 
 var (
@@ -11,6 +14,11 @@ var (
 		Help: "The number of peers in a given state.",
 	},
 		[]string{"state"})
+	p2pSubnetPeerCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_subnet_peer_count",
+		Help: "The number of connected peers advertising each of the subscribed subnets in their metadata.",
+	},
+		[]string{"type", "subnet"})
 	repeatPeerConnections = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "p2p_repeat_attempts",
 		Help: "The number of repeat attempts the connection handler is triggered for a peer.",
@@ -25,6 +33,16 @@ var (
 		Name: "p2p_attestation_subnet_attempted_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast.",
 	})
+	savedSyncCommitteeBroadcasts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_sync_committee_subnet_recovered_broadcasts",
+		Help: "The number of sync committee messages that were attempted to be broadcast with no peers on " +
+			"the subnet. The beacon node increments this counter when the broadcast is blocked " +
+			"until a subnet peer can be found.",
+	})
+	syncCommitteeBroadcastAttempts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_sync_committee_subnet_attempted_broadcasts",
+		Help: "The number of sync committee messages that were attempted to be broadcast.",
+	})
 )
 
 func (s *Service) updateMetrics() {
@@ -33,4 +51,11 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Connecting").Set(float64(len(s.peers.Connecting())))
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
+
+	p2pSubnetPeerCount.Reset()
+	for _, subnetType := range []peers.SubnetType{peers.AttestationSubnet, peers.SyncCommitteeSubnet} {
+		for index, count := range s.peers.SubnetPeerCounts(subnetType) {
+			p2pSubnetPeerCount.WithLabelValues(subnetType.String(), strconv.FormatUint(index, 10)).Set(float64(count))
+		}
+	}
 }
@@ -0,0 +1,130 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/sirupsen/logrus"
+)
+
+var _ PeerAdmin = (*Service)(nil)
+
+// goodbyeTimeout is the time given to a peer to close the stream after receiving a goodbye message.
+const goodbyeTimeout = 5 * time.Second
+
+// ErrPeerNotConnected is returned when disconnecting from a peer we are not connected to.
+var ErrPeerNotConnected = errors.New("peer is not connected")
+
+// BanPeer bans the given peer for the given duration, disconnecting from it if it is
+// connected. A zero duration bans the peer until it is unbanned.
+func (s *Service) BanPeer(ctx context.Context, pid peer.ID, duration time.Duration) error {
+	if pid == s.PeerID() {
+		return errors.New("cannot ban the local peer")
+	}
+	s.peers.BanPeer(pid, duration)
+	log.WithFields(logrus.Fields{
+		"peer":     pid,
+		"duration": duration,
+	}).Info("Banned peer")
+	if s.host.Network().Connectedness(pid) != network.Connected {
+		return nil
+	}
+	return s.DisconnectPeer(ctx, pid, p2ptypes.GoodbyeCodeBanned)
+}
+
+// UnbanPeer lifts the ban of the given peer, returning false if the peer was not banned.
+func (s *Service) UnbanPeer(pid peer.ID) bool {
+	if !s.peers.UnbanPeer(pid) {
+		return false
+	}
+	log.WithField("peer", pid).Info("Unbanned peer")
+	return true
+}
+
+// BanIPRange bans all peers with an address in the given IP range for the given duration,
+// disconnecting from the connected ones. A zero duration bans the range until it is unbanned.
+func (s *Service) BanIPRange(ctx context.Context, ipNet *net.IPNet, duration time.Duration) error {
+	s.peers.BanIPRange(ipNet, duration)
+	log.WithFields(logrus.Fields{
+		"ipRange":  ipNet,
+		"duration": duration,
+	}).Info("Banned IP range")
+	for _, conn := range s.host.Network().Conns() {
+		ip, err := manet.ToIP(conn.RemoteMultiaddr())
+		if err != nil || !ipNet.Contains(ip) {
+			continue
+		}
+		err = s.DisconnectPeer(ctx, conn.RemotePeer(), p2ptypes.GoodbyeCodeBanned)
+		if err != nil && !errors.Is(err, ErrPeerNotConnected) {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnbanIPRange lifts the ban of the given IP range, returning false if the range was not banned.
+func (s *Service) UnbanIPRange(ipNet *net.IPNet) bool {
+	if !s.peers.UnbanIPRange(ipNet) {
+		return false
+	}
+	log.WithField("ipRange", ipNet).Info("Unbanned IP range")
+	return true
+}
+
+// DialPeer connects to the peer with the given multiaddr or ENR, returning its peer ID.
+// Banned peers and peers otherwise considered bad are refused.
+func (s *Service) DialPeer(ctx context.Context, addr string) (peer.ID, error) {
+	addrs, err := peersFromStringAddrs([]string{addr})
+	if err != nil {
+		return "", err
+	}
+	if len(addrs) != 1 {
+		return "", errors.Errorf("could not parse %q as a multiaddr or ENR", addr)
+	}
+	info, err := peer.AddrInfoFromP2pAddr(addrs[0])
+	if err != nil {
+		return "", errors.Wrap(err, "could not get peer address info")
+	}
+	if info.ID == s.PeerID() {
+		return "", errors.New("cannot dial the local peer")
+	}
+	if s.isBannedAddr(addrs[0]) {
+		return "", errors.New("refused to connect to peer in a banned IP range")
+	}
+	if err := s.connectWithPeer(ctx, *info); err != nil {
+		return "", err
+	}
+	return info.ID, nil
+}
+
+// DisconnectPeer says goodbye to the given peer with the given reason and disconnects from it.
+func (s *Service) DisconnectPeer(ctx context.Context, pid peer.ID, code p2ptypes.RPCGoodbyeCode) error {
+	if s.host.Network().Connectedness(pid) != network.Connected {
+		return ErrPeerNotConnected
+	}
+	if err := s.sendGoodbye(ctx, code, pid); err != nil {
+		log.WithError(err).WithField("peer", pid).Debug("Could not send goodbye message to peer")
+	}
+	return s.Disconnect(pid)
+}
+
+// sendGoodbye sends a goodbye message to the given peer. Goodbye messages have no response, but
+// the peer is given some time to read the message and close the stream before we disconnect.
+func (s *Service) sendGoodbye(ctx context.Context, code p2ptypes.RPCGoodbyeCode, pid peer.ID) error {
+	stream, err := s.Send(ctx, &code, RPCGoodByeTopicV1, pid)
+	if err != nil {
+		return err
+	}
+	if err := stream.SetReadDeadline(time.Now().Add(goodbyeTimeout)); err != nil {
+		log.WithError(err).Debug("Could not set stream read deadline")
+	}
+	// Wait for the peer to close the stream, or for the deadline to pass.
+	_, _ = stream.Read([]byte{0})
+	return stream.Close()
+}
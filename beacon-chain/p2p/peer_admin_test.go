@@ -0,0 +1,106 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	testp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func newPeerAdminService(p *testp2p.TestP2P) *Service {
+	return &Service{
+		ctx:  context.Background(),
+		host: p.BHost,
+		cfg:  &Config{},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+}
+
+func TestService_BanPeer(t *testing.T) {
+	p1 := testp2p.NewTestP2P(t)
+	p2 := testp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	s := newPeerAdminService(p1)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.SetStreamHandler(RPCGoodByeTopicV1+s.Encoding().ProtocolSuffix(), func(stream network.Stream) {
+		defer wg.Done()
+		code := new(p2ptypes.RPCGoodbyeCode)
+		assert.NoError(t, s.Encoding().DecodeWithMaxLength(stream, code))
+		assert.Equal(t, p2ptypes.GoodbyeCodeBanned, *code)
+		assert.NoError(t, stream.Close())
+	})
+
+	require.NoError(t, s.BanPeer(context.Background(), p2.BHost.ID(), time.Hour))
+	if testutil.WaitTimeout(&wg, time.Second) {
+		t.Fatal("Did not receive goodbye message")
+	}
+	assert.Equal(t, network.NotConnected, p1.BHost.Network().Connectedness(p2.BHost.ID()))
+	assert.Equal(t, true, s.peers.IsBanned(p2.BHost.ID()))
+
+	// Banned peers can't be dialed.
+	addr := fmt.Sprintf("%s/p2p/%s", p2.BHost.Addrs()[0], p2.BHost.ID())
+	_, err := s.DialPeer(context.Background(), addr)
+	assert.ErrorContains(t, "refused to connect to bad peer", err)
+
+	assert.Equal(t, true, s.UnbanPeer(p2.BHost.ID()))
+	assert.Equal(t, false, s.UnbanPeer(p2.BHost.ID()))
+	pid, err := s.DialPeer(context.Background(), addr)
+	require.NoError(t, err)
+	assert.Equal(t, p2.BHost.ID(), pid)
+	assert.Equal(t, network.Connected, p1.BHost.Network().Connectedness(p2.BHost.ID()))
+
+	require.ErrorContains(t, "cannot ban the local peer", s.BanPeer(context.Background(), p1.BHost.ID(), 0))
+}
+
+func TestService_BanIPRange(t *testing.T) {
+	p1 := testp2p.NewTestP2P(t)
+	p2 := testp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	s := newPeerAdminService(p1)
+
+	_, ipNet, err := net.ParseCIDR("127.0.0.0/8")
+	require.NoError(t, err)
+	require.NoError(t, s.BanIPRange(context.Background(), ipNet, 0))
+	assert.Equal(t, network.NotConnected, p1.BHost.Network().Connectedness(p2.BHost.ID()))
+
+	addr := fmt.Sprintf("%s/p2p/%s", p2.BHost.Addrs()[0], p2.BHost.ID())
+	_, err = s.DialPeer(context.Background(), addr)
+	assert.ErrorContains(t, "banned IP range", err)
+
+	assert.Equal(t, true, s.UnbanIPRange(ipNet))
+	_, err = s.DialPeer(context.Background(), addr)
+	require.NoError(t, err)
+}
+
+func TestService_DisconnectPeer(t *testing.T) {
+	p1 := testp2p.NewTestP2P(t)
+	p2 := testp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	s := newPeerAdminService(p1)
+
+	require.NoError(t, s.DisconnectPeer(context.Background(), p2.BHost.ID(), p2ptypes.GoodbyeCodeClientShutdown))
+	assert.Equal(t, network.NotConnected, p1.BHost.Network().Connectedness(p2.BHost.ID()))
+	assert.ErrorContains(t, ErrPeerNotConnected.Error(), s.DisconnectPeer(context.Background(), p2.BHost.ID(), p2ptypes.GoodbyeCodeClientShutdown))
+}
+
+func TestService_DialPeer_InvalidAddress(t *testing.T) {
+	s := newPeerAdminService(testp2p.NewTestP2P(t))
+	_, err := s.DialPeer(context.Background(), "not an address")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,87 @@
+package p2p
+
+import (
+	"path"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"google.golang.org/protobuf/proto"
+)
+
+// peerStorePath is the name of the file, in the p2p data directory, known peers are persisted to.
+const peerStorePath = "peerstore"
+
+// Interval at which known peers are persisted, on top of persisting them when the service stops.
+var peerStoreInterval = 5 * time.Minute
+
+// restorePeerStore loads the peers persisted by a previous run into the peer status, and dials
+// the restored peers which can be reached through their ENR.
+func (s *Service) restorePeerStore() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	store, err := loadPeerStore(path.Join(s.cfg.DataDir, peerStorePath))
+	if err != nil {
+		return err
+	}
+	pids := s.peers.RestorePeerRecords(store.Peers)
+	nodes := make([]*enode.Node, 0, len(pids))
+	for _, pid := range pids {
+		if len(nodes) >= int(s.cfg.MaxPeers) {
+			break
+		}
+		record, err := s.peers.ENR(pid)
+		if err != nil || record == nil {
+			continue
+		}
+		node, err := enode.New(enode.ValidSchemes, record)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Could not restore node from ENR")
+			continue
+		}
+		if s.filterPeer(node) {
+			nodes = append(nodes, node)
+		}
+	}
+	log.WithField("restoredPeers", len(pids)).WithField("dialedPeers", len(nodes)).Info("Restored persisted peers")
+	s.connectWithAllPeers(convertToMultiAddr(nodes))
+	return nil
+}
+
+// persistPeerStore saves the most recently seen peers, up to the size of the peer status, in
+// the p2p data directory.
+func (s *Service) persistPeerStore() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	records, err := s.peers.PeerRecords(s.peers.MaxPeerLimit())
+	if err != nil {
+		return err
+	}
+	enc, err := proto.Marshal(&pb.PeerStore{Peers: records})
+	if err != nil {
+		return errors.Wrap(err, "could not marshal peer store")
+	}
+	if err := fileutil.WriteFile(path.Join(s.cfg.DataDir, peerStorePath), enc); err != nil {
+		return errors.Wrap(err, "could not write peer store")
+	}
+	return nil
+}
+
+func loadPeerStore(storePath string) (*pb.PeerStore, error) {
+	store := &pb.PeerStore{}
+	if !fileutil.FileExists(storePath) {
+		return store, nil
+	}
+	enc, err := fileutil.ReadFileAsBytes(storePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read peer store")
+	}
+	if err := proto.Unmarshal(enc, store); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal peer store")
+	}
+	return store, nil
+}
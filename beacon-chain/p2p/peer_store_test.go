@@ -0,0 +1,73 @@
+package p2p
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_PersistAndRestorePeerStore(t *testing.T) {
+	dataDir := t.TempDir()
+	newService := func() *Service {
+		return &Service{
+			cfg: &Config{DataDir: dataDir, MaxPeers: 30},
+			peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+				PeerLimit: 30,
+				ScorerParams: &scorers.Config{
+					BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+						Threshold: maxBadResponses,
+					},
+				},
+			}),
+		}
+	}
+	s := newService()
+	// Nothing is restored without a persisted store.
+	require.NoError(t, s.restorePeerStore())
+	assert.Equal(t, 0, len(s.peers.All()))
+
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	pid := addPeer(t, s.peers, peers.PeerConnected)
+	s.peers.Add(nil, pid, address, network.DirOutbound)
+	s.peers.Scorers().BlockProviderScorer().IncrementProcessedBlocks(pid, 128)
+	s.peers.SetConnectionState(pid, peers.PeerDisconnected)
+	require.NoError(t, s.persistPeerStore())
+	assert.Equal(t, true, fileutil.FileExists(path.Join(dataDir, peerStorePath)))
+
+	restored := newService()
+	require.NoError(t, restored.restorePeerStore())
+	require.Equal(t, 1, len(restored.peers.All()))
+	restoredAddress, err := restored.peers.Address(pid)
+	require.NoError(t, err)
+	assert.Equal(t, true, address.Equal(restoredAddress))
+	assert.Equal(t, uint64(128), restored.peers.Scorers().BlockProviderScorer().ProcessedBlocks(pid))
+}
+
+func TestService_PersistPeerStore_NoDataDir(t *testing.T) {
+	s := &Service{
+		cfg: &Config{},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	require.NoError(t, s.persistPeerStore())
+	require.NoError(t, s.restorePeerStore())
+	assert.Equal(t, false, fileutil.FileExists(peerStorePath))
+}
+
+func TestLoadPeerStore_Corrupted(t *testing.T) {
+	storePath := path.Join(t.TempDir(), peerStorePath)
+	require.NoError(t, fileutil.WriteFile(storePath, []byte("not a peer store")))
+	_, err := loadPeerStore(storePath)
+	assert.ErrorContains(t, "could not unmarshal peer store", err)
+}
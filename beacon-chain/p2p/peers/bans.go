@@ -0,0 +1,159 @@
+package peers
+
+import (
+	"net"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// Ban is a ban of either a single peer or of an IP range, issued by the node operator.
+type Ban struct {
+	// PeerID is the banned peer, empty for an IP range ban.
+	PeerID peer.ID
+	// IPRange is the banned IP range, nil for a peer ban.
+	IPRange *net.IPNet
+	// Expiry is the time at which the ban is lifted, zero if the ban does not expire.
+	Expiry time.Time
+}
+
+// Expired checks whether the ban has been lifted at the given time.
+func (b *Ban) Expired(now time.Time) bool {
+	return !b.Expiry.IsZero() && !now.Before(b.Expiry)
+}
+
+// BanPeer bans the given peer for the given duration. A zero duration bans the peer
+// until it is explicitly unbanned. Banning an already banned peer replaces the ban.
+func (p *Status) BanPeer(pid peer.ID, duration time.Duration) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.peerBans[pid] = &Ban{PeerID: pid, Expiry: banExpiry(duration)}
+}
+
+// UnbanPeer lifts the ban of the given peer, returning false if the peer was not banned.
+func (p *Status) UnbanPeer(pid peer.ID) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+	ban, ok := p.peerBans[pid]
+	if !ok {
+		return false
+	}
+	delete(p.peerBans, pid)
+	return !ban.Expired(timeutils.Now())
+}
+
+// BanIPRange bans all peers with an address in the given IP range for the given duration.
+// A zero duration bans the range until it is explicitly unbanned.
+func (p *Status) BanIPRange(ipNet *net.IPNet, duration time.Duration) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.ipRangeBans[ipNet.String()] = &Ban{IPRange: ipNet, Expiry: banExpiry(duration)}
+}
+
+// UnbanIPRange lifts the ban of the given IP range, returning false if the range was not banned.
+// Only a range matching a previously banned range exactly can be unbanned.
+func (p *Status) UnbanIPRange(ipNet *net.IPNet) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+	ban, ok := p.ipRangeBans[ipNet.String()]
+	if !ok {
+		return false
+	}
+	delete(p.ipRangeBans, ipNet.String())
+	return !ban.Expired(timeutils.Now())
+}
+
+// IsBanned checks whether the given peer is banned, either directly or because its
+// address is in a banned IP range.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	now := timeutils.Now()
+	if ban, ok := p.peerBans[pid]; ok && !ban.Expired(now) {
+		return true
+	}
+	peerData, ok := p.store.PeerData(pid)
+	if !ok || peerData.Address == nil {
+		return false
+	}
+	ip, err := manet.ToIP(peerData.Address)
+	if err != nil {
+		return false
+	}
+	return p.isIPBanned(ip, now)
+}
+
+// IsIPBanned checks whether the given IP address is in a banned IP range.
+func (p *Status) IsIPBanned(ip net.IP) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.isIPBanned(ip, timeutils.Now())
+}
+
+// Bans returns the bans in effect, peer bans first, each group sorted by expiry with
+// the bans that do not expire last.
+func (p *Status) Bans() []*Ban {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	now := timeutils.Now()
+	peerBans := make([]*Ban, 0, len(p.peerBans))
+	for _, ban := range p.peerBans {
+		if !ban.Expired(now) {
+			peerBans = append(peerBans, ban)
+		}
+	}
+	ipRangeBans := make([]*Ban, 0, len(p.ipRangeBans))
+	for _, ban := range p.ipRangeBans {
+		if !ban.Expired(now) {
+			ipRangeBans = append(ipRangeBans, ban)
+		}
+	}
+	sortBans(peerBans)
+	sortBans(ipRangeBans)
+	return append(peerBans, ipRangeBans...)
+}
+
+// isIPBanned checks whether the given IP address is in a banned IP range.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (p *Status) isIPBanned(ip net.IP, now time.Time) bool {
+	for _, ban := range p.ipRangeBans {
+		if !ban.Expired(now) && ban.IPRange.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneExpiredBans removes the bans which have been lifted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (p *Status) pruneExpiredBans(now time.Time) {
+	for pid, ban := range p.peerBans {
+		if ban.Expired(now) {
+			delete(p.peerBans, pid)
+		}
+	}
+	for key, ban := range p.ipRangeBans {
+		if ban.Expired(now) {
+			delete(p.ipRangeBans, key)
+		}
+	}
+}
+
+func banExpiry(duration time.Duration) time.Time {
+	if duration <= 0 {
+		return time.Time{}
+	}
+	return timeutils.Now().Add(duration)
+}
+
+func sortBans(bans []*Ban) {
+	sort.Slice(bans, func(i, j int) bool {
+		if bans[i].Expiry.IsZero() || bans[j].Expiry.IsZero() {
+			return !bans[i].Expiry.IsZero()
+		}
+		return bans[i].Expiry.Before(bans[j].Expiry)
+	})
+}
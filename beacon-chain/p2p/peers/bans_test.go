@@ -0,0 +1,94 @@
+package peers_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBanPeer(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	banned := createPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+	trusted := createPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+	other := createPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+	p.SetTrustedPeers([]peer.ID{trusted})
+
+	p.BanPeer(banned, 0)
+	p.BanPeer(trusted, time.Hour)
+	assert.Equal(t, true, p.IsBanned(banned))
+	assert.Equal(t, true, p.IsBad(banned))
+	// Bans take precedence over trust.
+	assert.Equal(t, true, p.IsBad(trusted))
+	assert.Equal(t, false, p.IsBanned(other))
+	assert.Equal(t, false, p.IsBad(other))
+
+	bans := p.Bans()
+	require.Equal(t, 2, len(bans))
+	assert.Equal(t, trusted, bans[0].PeerID)
+	assert.Equal(t, banned, bans[1].PeerID)
+	assert.Equal(t, true, bans[1].Expiry.IsZero())
+
+	assert.Equal(t, true, p.UnbanPeer(banned))
+	assert.Equal(t, false, p.UnbanPeer(banned))
+	assert.Equal(t, false, p.UnbanPeer(other))
+	assert.Equal(t, false, p.IsBanned(banned))
+	assert.Equal(t, false, p.IsBad(banned))
+}
+
+func TestBanPeer_Expires(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	pid := createPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+	p.BanPeer(pid, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, false, p.IsBanned(pid))
+	assert.Equal(t, 0, len(p.Bans()))
+	// Expired bans can no longer be lifted.
+	assert.Equal(t, false, p.UnbanPeer(pid))
+}
+
+func TestBanIPRange(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	inRange, err := ma.NewMultiaddr("/ip4/52.23.23.253/tcp/13000")
+	require.NoError(t, err)
+	outOfRange, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	bannedPeer := createPeer(t, p, inRange, network.DirInbound, peers.PeerConnected)
+	otherPeer := createPeer(t, p, outOfRange, network.DirInbound, peers.PeerConnected)
+
+	_, ipNet, err := net.ParseCIDR("52.23.0.0/16")
+	require.NoError(t, err)
+	p.BanIPRange(ipNet, time.Hour)
+	assert.Equal(t, true, p.IsIPBanned(net.ParseIP("52.23.1.1")))
+	assert.Equal(t, false, p.IsIPBanned(net.ParseIP("52.24.1.1")))
+	assert.Equal(t, true, p.IsBanned(bannedPeer))
+	assert.Equal(t, true, p.IsBad(bannedPeer))
+	assert.Equal(t, false, p.IsBanned(otherPeer))
+
+	bans := p.Bans()
+	require.Equal(t, 1, len(bans))
+	assert.Equal(t, ipNet.String(), bans[0].IPRange.String())
+
+	_, otherNet, err := net.ParseCIDR("52.0.0.0/8")
+	require.NoError(t, err)
+	assert.Equal(t, false, p.UnbanIPRange(otherNet))
+	assert.Equal(t, true, p.UnbanIPRange(ipNet))
+	assert.Equal(t, false, p.IsBanned(bannedPeer))
+}
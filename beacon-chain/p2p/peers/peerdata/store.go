@@ -35,9 +35,10 @@ type StoreConfig struct {
 // the mutex when accessing data.
 type Store struct {
 	sync.RWMutex
-	ctx    context.Context
-	config *StoreConfig
-	peers  map[peer.ID]*PeerData
+	ctx          context.Context
+	config       *StoreConfig
+	peers        map[peer.ID]*PeerData
+	trustedPeers map[peer.ID]bool
 }
 
 // PeerData aggregates protocol and application level info about a single peer.
@@ -48,6 +49,7 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	LastSeen      time.Time
 	// Chain related data.
 	MetaData                  interfaces.Metadata
 	ChainState                *pb.Status
@@ -66,9 +68,10 @@ type PeerData struct {
 // NewStore creates new peer data store.
 func NewStore(ctx context.Context, config *StoreConfig) *Store {
 	return &Store{
-		ctx:    ctx,
-		config: config,
-		peers:  make(map[peer.ID]*PeerData),
+		ctx:          ctx,
+		config:       config,
+		peers:        make(map[peer.ID]*PeerData),
+		trustedPeers: make(map[peer.ID]bool),
 	}
 }
 
@@ -108,6 +111,30 @@ func (s *Store) Peers() map[peer.ID]*PeerData {
 	return s.peers
 }
 
+// SetTrustedPeers marks the given peers as trusted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) SetTrustedPeers(pids []peer.ID) {
+	for _, pid := range pids {
+		s.trustedPeers[pid] = true
+	}
+}
+
+// IsTrustedPeer checks whether a given peer is trusted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) IsTrustedPeer(pid peer.ID) bool {
+	return s.trustedPeers[pid]
+}
+
+// TrustedPeers returns the list of trusted peers.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) TrustedPeers() []peer.ID {
+	pids := make([]peer.ID, 0, len(s.trustedPeers))
+	for pid := range s.trustedPeers {
+		pids = append(pids, pid)
+	}
+	return pids
+}
+
 // Config exposes store configuration params.
 func (s *Store) Config() *StoreConfig {
 	return s.config
@@ -80,3 +80,22 @@ func TestStore_PeerDataGetOrCreate(t *testing.T) {
 	assert.Equal(t, uint64(0), peerData.ProcessedBlocks)
 	require.Equal(t, 1, len(store.Peers()))
 }
+
+func TestStore_TrustedPeers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := peerdata.NewStore(ctx, &peerdata.StoreConfig{
+		MaxPeers: 12,
+	})
+	assert.Equal(t, 0, len(store.TrustedPeers()))
+	assert.Equal(t, false, store.IsTrustedPeer("00001"))
+
+	store.SetTrustedPeers([]peer.ID{"00001", "00002"})
+	assert.Equal(t, true, store.IsTrustedPeer("00001"))
+	assert.Equal(t, true, store.IsTrustedPeer("00002"))
+	assert.Equal(t, false, store.IsTrustedPeer("00003"))
+	assert.Equal(t, 2, len(store.TrustedPeers()))
+	// Trusted peers are independent of the stored peer data.
+	assert.Equal(t, 0, len(store.Peers()))
+}
@@ -0,0 +1,126 @@
+package peers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// MaxPeerRecordAge is the duration after which a peer which has not been seen connected
+// is neither persisted nor restored.
+const MaxPeerRecordAge = 7 * 24 * time.Hour
+
+// PeerRecords returns records of the peers which have been connected to within MaxPeerRecordAge,
+// the most recently seen first. At most limit records are returned.
+func (p *Status) PeerRecords(limit int) ([]*pb.PeerRecord, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	oldest := timeutils.Now().Add(-MaxPeerRecordAge)
+	records := make([]*pb.PeerRecord, 0)
+	for pid, peerData := range p.store.Peers() {
+		if peerData.Address == nil || peerData.LastSeen.Before(oldest) {
+			continue
+		}
+		record, err := peerRecord(pid, peerData)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create record of peer %s", pid)
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].LastSeen > records[j].LastSeen
+	})
+	if len(records) > limit {
+		records = records[:limit]
+	}
+	return records, nil
+}
+
+// RestorePeerRecords adds the peers of the given records as disconnected peers, along with their
+// scoring data. Peers which are already known, records older than MaxPeerRecordAge and malformed
+// records are skipped. The identifiers of the restored peers are returned.
+func (p *Status) RestorePeerRecords(records []*pb.PeerRecord) []peer.ID {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	oldest := timeutils.Now().Add(-MaxPeerRecordAge)
+	pids := make([]peer.ID, 0, len(records))
+	for _, record := range records {
+		lastSeen := time.Unix(int64(record.LastSeen), 0)
+		if lastSeen.Before(oldest) {
+			continue
+		}
+		pid, peerData, err := peerDataFromRecord(record)
+		if err != nil {
+			continue
+		}
+		if _, ok := p.store.PeerData(pid); ok {
+			continue
+		}
+		peerData.LastSeen = lastSeen
+		p.store.SetPeerData(pid, peerData)
+		p.addIpToTracker(pid)
+		pids = append(pids, pid)
+	}
+	return pids
+}
+
+func peerRecord(pid peer.ID, peerData *peerdata.PeerData) (*pb.PeerRecord, error) {
+	pidBytes, err := pid.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	var enrBytes []byte
+	// Unsigned records cannot be encoded, and are of no use to discovery anyway.
+	if peerData.Enr != nil && peerData.Enr.Signature() != nil {
+		enrBytes, err = rlp.EncodeToBytes(peerData.Enr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &pb.PeerRecord{
+		PeerId:           pidBytes,
+		Enr:              enrBytes,
+		Multiaddr:        peerData.Address.Bytes(),
+		BadResponses:     uint64(peerData.BadResponses),
+		ProcessedBlocks:  peerData.ProcessedBlocks,
+		GossipScore:      peerData.GossipScore,
+		BehaviourPenalty: peerData.BehaviourPenalty,
+		LastSeen:         uint64(peerData.LastSeen.Unix()),
+	}, nil
+}
+
+func peerDataFromRecord(record *pb.PeerRecord) (peer.ID, *peerdata.PeerData, error) {
+	pid, err := peer.IDFromBytes(record.PeerId)
+	if err != nil {
+		return "", nil, err
+	}
+	address, err := ma.NewMultiaddrBytes(record.Multiaddr)
+	if err != nil {
+		return "", nil, err
+	}
+	peerData := &peerdata.PeerData{
+		Address:          address,
+		ConnState:        PeerDisconnected,
+		BadResponses:     int(record.BadResponses),
+		ProcessedBlocks:  record.ProcessedBlocks,
+		GossipScore:      record.GossipScore,
+		BehaviourPenalty: record.BehaviourPenalty,
+	}
+	if len(record.Enr) > 0 {
+		peerData.Enr = &enr.Record{}
+		if err := rlp.DecodeBytes(record.Enr, peerData.Enr); err != nil {
+			return "", nil, err
+		}
+	}
+	return pid, peerData, nil
+}
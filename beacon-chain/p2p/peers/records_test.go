@@ -0,0 +1,111 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestPeerRecords_RestoreRoundTrip(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 5,
+			},
+		},
+	})
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	record := &enr.Record{}
+	record.Set(enr.IPv4{127, 0, 0, 1})
+	require.NoError(t, enode.SignV4(record, key))
+
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	seen := createPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	p.Add(record, seen, address, network.DirOutbound)
+	p.SetConnectionState(seen, peers.PeerDisconnected)
+	p.Scorers().BadResponsesScorer().Increment(seen)
+	p.Scorers().BlockProviderScorer().IncrementProcessedBlocks(seen, 64)
+
+	otherAddress, err := ma.NewMultiaddr("/ip4/52.23.23.253/tcp/13000")
+	require.NoError(t, err)
+	other := createPeer(t, p, otherAddress, network.DirInbound, peers.PeerConnected)
+	// Peers which were never connected, or have no address, are not persisted.
+	createPeer(t, p, address, network.DirUnknown, peers.PeerDisconnected)
+	createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+
+	records, err := p.PeerRecords(1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	records, err = p.PeerRecords(10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+
+	restored := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	pids := restored.RestorePeerRecords(records)
+	require.Equal(t, 2, len(pids))
+	assert.Equal(t, true, (pids[0] == seen && pids[1] == other) || (pids[0] == other && pids[1] == seen))
+	state, err := restored.ConnectionState(seen)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerDisconnected, state)
+	restoredAddress, err := restored.Address(seen)
+	require.NoError(t, err)
+	assert.Equal(t, true, address.Equal(restoredAddress))
+	restoredRecord, err := restored.ENR(seen)
+	require.NoError(t, err)
+	assert.DeepEqual(t, record.Signature(), restoredRecord.Signature())
+	badResponses, err := restored.Scorers().BadResponsesScorer().Count(seen)
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses)
+	assert.Equal(t, uint64(64), restored.Scorers().BlockProviderScorer().ProcessedBlocks(seen))
+
+	// Known peers are not overwritten.
+	assert.Equal(t, 0, len(restored.RestorePeerRecords(records)))
+}
+
+func TestRestorePeerRecords_SkipsStaleAndMalformed(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	pid := createPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	records, err := p.PeerRecords(10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+
+	stale := &pb.PeerRecord{
+		PeerId:    records[0].PeerId,
+		Multiaddr: records[0].Multiaddr,
+		LastSeen:  uint64(time.Now().Add(-peers.MaxPeerRecordAge - time.Hour).Unix()),
+	}
+	malformed := &pb.PeerRecord{
+		PeerId:    []byte("not a peer id"),
+		Multiaddr: records[0].Multiaddr,
+		LastSeen:  records[0].LastSeen,
+	}
+	restored := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	assert.Equal(t, 0, len(restored.RestorePeerRecords([]*pb.PeerRecord{stale, malformed})))
+	assert.DeepEqual(t, []peer.ID{pid}, restored.RestorePeerRecords(records))
+}
@@ -19,7 +19,14 @@
 //
 // Peer information is persistent for the run of the service. This allows for collection of useful
 // long-term statistics such as number of bad responses obtained from the peer, giving the basis for
-// decisions to not talk to known-bad peers (by de-scoring them).
+// decisions to not talk to known-bad peers (by de-scoring them). Peers which have been connected to
+// can be exported as records and restored across restarts.
+//
+// Pruning keeps a minimum number of peers on each of the subnets the node is subscribed to, and
+// prefers dropping peers whose subnets are over-represented.
+//
+// Trusted peers are never considered bad and are exempt from pruning. Operators can also manually ban
+// peers and IP ranges, banned peers are always considered bad.
 package peers
 
 import (
@@ -76,11 +83,14 @@ const (
 
 // Status is the structure holding the peer status information.
 type Status struct {
-	ctx       context.Context
-	scorers   *scorers.Service
-	store     *peerdata.Store
-	ipTracker map[string]uint64
-	rand      *rand.Rand
+	ctx               context.Context
+	scorers           *scorers.Service
+	store             *peerdata.Store
+	ipTracker         map[string]uint64
+	peerBans          map[peer.ID]*Ban
+	ipRangeBans       map[string]*Ban
+	subscribedSubnets map[SubnetType]map[uint64]bool
+	rand              *rand.Rand
 }
 
 // StatusConfig represents peer status service params.
@@ -97,10 +107,13 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 		MaxPeers: maxLimitBuffer + config.PeerLimit,
 	})
 	return &Status{
-		ctx:       ctx,
-		store:     store,
-		scorers:   scorers.NewService(ctx, store, config.ScorerParams),
-		ipTracker: map[string]uint64{},
+		ctx:               ctx,
+		store:             store,
+		scorers:           scorers.NewService(ctx, store, config.ScorerParams),
+		ipTracker:         map[string]uint64{},
+		peerBans:          make(map[peer.ID]*Ban),
+		ipRangeBans:       make(map[string]*Ban),
+		subscribedSubnets: make(map[SubnetType]map[uint64]bool),
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
 		rand: rand.NewDeterministicGenerator(),
@@ -296,6 +309,11 @@ func (p *Status) SetConnectionState(pid peer.ID, state peerdata.PeerConnectionSt
 	defer p.store.Unlock()
 
 	peerData := p.store.PeerDataGetOrCreate(pid)
+	// Track the last time the peer was seen connected, either on connection or
+	// when a connected peer starts disconnecting.
+	if state == PeerConnected || peerData.ConnState == PeerConnected {
+		peerData.LastSeen = timeutils.Now()
+	}
 	peerData.ConnState = state
 }
 
@@ -325,8 +343,34 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 
 // IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
+// Trusted peers are never considered bad, unless they have been banned.
 func (p *Status) IsBad(pid peer.ID) bool {
-	return p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid)
+	if p.IsBanned(pid) {
+		return true
+	}
+	return !p.IsTrustedPeer(pid) && (p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid))
+}
+
+// SetTrustedPeers marks the given peers as trusted. Trusted peers are never pruned,
+// nor considered bad.
+func (p *Status) SetTrustedPeers(pids []peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.SetTrustedPeers(pids)
+}
+
+// IsTrustedPeer checks whether the given peer is trusted.
+func (p *Status) IsTrustedPeer(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.IsTrustedPeer(pid)
+}
+
+// TrustedPeers returns the list of trusted peers.
+func (p *Status) TrustedPeers() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.TrustedPeers()
 }
 
 // NextValidTime gets the earliest possible time it is to contact/dial
@@ -534,6 +578,7 @@ func (p *Status) All() []peer.ID {
 func (p *Status) Prune() {
 	p.store.Lock()
 	defer p.store.Unlock()
+	p.pruneExpiredBans(timeutils.Now())
 
 	// Exit early if there is nothing to prune.
 	if len(p.store.Peers()) <= p.store.Config().MaxPeers {
@@ -550,7 +595,7 @@ func (p *Status) Prune() {
 	peersToPrune := make([]*peerResp, 0)
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && !p.store.IsTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -686,6 +731,10 @@ func (p *Status) BestNonFinalized(minPeers int, ourHeadEpoch types.Epoch) (types
 // the pruning relies on simple heuristics such as
 // bad response count. In the future scoring will be used
 // to determine the most suitable peers to take out.
+// Peers on over-represented subnets are preferred, and
+// peers needed to keep the minimum amount of peers on
+// one of our subscribed subnets are kept. Trusted peers
+// are never selected.
 func (p *Status) PeersToPrune() []peer.ID {
 	connLimit := p.ConnectedPeerLimit()
 	inBoundLimit := p.InboundLimit()
@@ -702,23 +751,33 @@ func (p *Status) PeersToPrune() []peer.ID {
 	type peerResp struct {
 		pid     peer.ID
 		badResp int
+		subnets []subnet
+		surplus int
 	}
+	subnetCounts := p.subnetPeerCounts()
 	peersToPrune := make([]*peerResp, 0)
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.store.IsTrustedPeer(pid) {
+			subnets := p.neededSubnets(peerData)
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
+				subnets: subnets,
+				surplus: subnetSurplus(subnets, subnetCounts),
 			})
 		}
 	}
 
 	// Sort in descending order to favour pruning peers with a
-	// higher bad response count.
+	// higher bad response count, and then peers whose subnets
+	// are the most over-represented.
 	sort.Slice(peersToPrune, func(i, j int) bool {
-		return peersToPrune[i].badResp > peersToPrune[j].badResp
+		if peersToPrune[i].badResp != peersToPrune[j].badResp {
+			return peersToPrune[i].badResp > peersToPrune[j].badResp
+		}
+		return peersToPrune[i].surplus > peersToPrune[j].surplus
 	})
 
 	// Determine amount of peers to prune using our
@@ -735,11 +794,27 @@ func (p *Status) PeersToPrune() []peer.ID {
 	if excessInbound > amountToPrune {
 		amountToPrune = excessInbound
 	}
-	if amountToPrune < len(peersToPrune) {
-		peersToPrune = peersToPrune[:amountToPrune]
-	}
-	ids := make([]peer.ID, 0, len(peersToPrune))
+	minPeersInSubnet := int(params.BeaconNetworkConfig().MinimumPeersInSubnet)
+	ids := make([]peer.ID, 0, amountToPrune)
 	for _, pr := range peersToPrune {
+		if len(ids) >= amountToPrune {
+			break
+		}
+		// Keep peers which would take one of our subnets
+		// below the minimum amount of peers.
+		needed := false
+		for _, sn := range pr.subnets {
+			if subnetCounts[sn] <= minPeersInSubnet {
+				needed = true
+				break
+			}
+		}
+		if needed {
+			continue
+		}
+		for _, sn := range pr.subnets {
+			subnetCounts[sn]--
+		}
 		ids = append(ids, pr.pid)
 	}
 	return ids
@@ -1022,3 +1022,60 @@ func createPeer(t *testing.T, p *peers.Status, addr ma.Multiaddr,
 	p.SetConnectionState(id, state)
 	return id
 }
+
+func TestTrustedPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	for i := 0; i < 15; i++ {
+		createPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+	}
+	trusted := make([]peer.ID, 0)
+	for i := 0; i < 18; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+		if i < 16 {
+			trusted = append(trusted, pid)
+		}
+	}
+	p.SetTrustedPeers(trusted)
+	assert.Equal(t, 16, len(p.TrustedPeers()))
+
+	// Only the untrusted inbound peers can be pruned.
+	peersToPrune := p.PeersToPrune()
+	require.Equal(t, 2, len(peersToPrune))
+	for _, pid := range peersToPrune {
+		assert.Equal(t, false, p.IsTrustedPeer(pid))
+	}
+
+	// Trusted peers are never bad.
+	p.Scorers().BadResponsesScorer().Increment(trusted[0])
+	p.Scorers().BadResponsesScorer().Increment(peersToPrune[0])
+	assert.Equal(t, false, p.IsBad(trusted[0]))
+	assert.Equal(t, true, p.IsBad(peersToPrune[0]))
+}
+
+func TestPrune_KeepsTrustedPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+	trusted := addPeer(t, p, peers.PeerDisconnected)
+	p.SetTrustedPeers([]peer.ID{trusted})
+	for i := 0; i < p.MaxPeerLimit()+10; i++ {
+		addPeer(t, p, peers.PeerDisconnected)
+	}
+
+	p.Prune()
+	assert.Equal(t, p.MaxPeerLimit(), len(p.All()))
+	_, err := p.ConnectionState(trusted)
+	assert.NoError(t, err, "Trusted peer was pruned")
+}
@@ -0,0 +1,139 @@
+package peers
+
+import (
+	"math"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// SubnetType is the type of a gossip subnet which peers advertise in their metadata.
+type SubnetType uint8
+
+const (
+	// AttestationSubnet is an attestation subnet, advertised in the attnets metadata field.
+	AttestationSubnet SubnetType = iota
+	// SyncCommitteeSubnet is a sync committee subnet, advertised in the syncnets metadata field.
+	SyncCommitteeSubnet
+)
+
+// String returns the name of the subnet type.
+func (t SubnetType) String() string {
+	switch t {
+	case AttestationSubnet:
+		return "attestation"
+	case SyncCommitteeSubnet:
+		return "sync_committee"
+	default:
+		return "unknown"
+	}
+}
+
+// subnet identifies a single subnet of a given type.
+type subnet struct {
+	subnetType SubnetType
+	index      uint64
+}
+
+// SetSubscribedSubnets sets the subnets of the given type that the node is subscribed to, replacing
+// the previously set ones. Pruning keeps the minimum number of peers in subnet on each of those
+// subnets whenever possible.
+func (p *Status) SetSubscribedSubnets(subnetType SubnetType, indices []uint64) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	subscribed := make(map[uint64]bool, len(indices))
+	for _, idx := range indices {
+		subscribed[idx] = true
+	}
+	p.subscribedSubnets[subnetType] = subscribed
+}
+
+// SubscribedSubnets returns the subnets of the given type that the node is subscribed to, in ascending order.
+func (p *Status) SubscribedSubnets(subnetType SubnetType) []uint64 {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	indices := make([]uint64, 0, len(p.subscribedSubnets[subnetType]))
+	for idx := range p.subscribedSubnets[subnetType] {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return indices
+}
+
+// SubnetPeerCounts returns the number of connected peers advertising each of the subscribed
+// subnets of the given type in their metadata.
+func (p *Status) SubnetPeerCounts(subnetType SubnetType) map[uint64]int {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	counts := make(map[uint64]int, len(p.subscribedSubnets[subnetType]))
+	for idx := range p.subscribedSubnets[subnetType] {
+		counts[idx] = 0
+	}
+	for sn, count := range p.subnetPeerCounts() {
+		if sn.subnetType == subnetType {
+			counts[sn.index] = count
+		}
+	}
+	return counts
+}
+
+// subnetPeerCounts counts the connected peers advertising each of the subscribed subnets.
+// This method assumes the store lock is acquired before executing the method.
+func (p *Status) subnetPeerCounts() map[subnet]int {
+	counts := make(map[subnet]int)
+	for _, peerData := range p.store.Peers() {
+		if peerData.ConnState != PeerConnected {
+			continue
+		}
+		for _, sn := range p.neededSubnets(peerData) {
+			counts[sn]++
+		}
+	}
+	return counts
+}
+
+// neededSubnets returns the subscribed subnets which the peer advertises in its metadata.
+// This method assumes the store lock is acquired before executing the method.
+func (p *Status) neededSubnets(peerData *peerdata.PeerData) []subnet {
+	if peerData.MetaData == nil || peerData.MetaData.IsNil() {
+		return nil
+	}
+	var subnets []subnet
+	if attSubnets := p.subscribedSubnets[AttestationSubnet]; len(attSubnets) > 0 {
+		for _, idx := range indicesFromBitfield(peerData.MetaData.AttnetsBitfield()) {
+			if attSubnets[idx] {
+				subnets = append(subnets, subnet{subnetType: AttestationSubnet, index: idx})
+			}
+		}
+	}
+	syncSubnets := p.subscribedSubnets[SyncCommitteeSubnet]
+	md := peerData.MetaData.MetadataObjV1()
+	if len(syncSubnets) == 0 || md == nil {
+		return subnets
+	}
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount && i < md.Syncnets.Len(); i++ {
+		if md.Syncnets.BitAt(i) && syncSubnets[i] {
+			subnets = append(subnets, subnet{subnetType: SyncCommitteeSubnet, index: i})
+		}
+	}
+	return subnets
+}
+
+// subnetSurplus returns by how many peers the least represented of the given subnets exceeds
+// the minimum number of peers in subnet. Peers on no subscribed subnet have the largest surplus.
+func subnetSurplus(subnets []subnet, counts map[subnet]int) int {
+	surplus := math.MaxInt32
+	minPeers := int(params.BeaconNetworkConfig().MinimumPeersInSubnet)
+	for _, sn := range subnets {
+		if s := counts[sn] - minPeers; s < surplus {
+			surplus = s
+		}
+	}
+	return surplus
+}
@@ -0,0 +1,106 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSubnetPeerCounts(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	p.SetSubscribedSubnets(peers.AttestationSubnet, []uint64{3, 1})
+	p.SetSubscribedSubnets(peers.SyncCommitteeSubnet, []uint64{2})
+	assert.DeepEqual(t, []uint64{1, 3}, p.SubscribedSubnets(peers.AttestationSubnet))
+	assert.DeepEqual(t, []uint64{2}, p.SubscribedSubnets(peers.SyncCommitteeSubnet))
+
+	connected := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+	setSubnets(p, connected, []uint64{1, 5}, []uint64{2})
+	connectedV0 := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+	p.SetMetadata(connectedV0, wrapper.WrappedMetadataV0(&pb.MetaDataV0{Attnets: attnets(1, 3)}))
+	disconnected := createPeer(t, p, nil, network.DirInbound, peers.PeerDisconnected)
+	setSubnets(p, disconnected, []uint64{1, 3}, []uint64{2})
+
+	assert.DeepEqual(t, map[uint64]int{1: 2, 3: 1}, p.SubnetPeerCounts(peers.AttestationSubnet))
+	assert.DeepEqual(t, map[uint64]int{2: 1}, p.SubnetPeerCounts(peers.SyncCommitteeSubnet))
+
+	p.SetSubscribedSubnets(peers.AttestationSubnet, nil)
+	assert.Equal(t, 0, len(p.SubnetPeerCounts(peers.AttestationSubnet)))
+}
+
+func TestPeersToPrune_KeepsSubnetPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	minPeers := int(params.BeaconNetworkConfig().MinimumPeersInSubnet)
+	p.SetSubscribedSubnets(peers.AttestationSubnet, []uint64{1, 2})
+
+	for i := 0; i < 25; i++ {
+		createPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+	}
+	// Subnet 1 is at the minimum amount of peers, subnet 2 has one peer to spare.
+	onlyPeers := make(map[peer.ID]bool)
+	for i := 0; i < minPeers; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+		setSubnets(p, pid, []uint64{1}, nil)
+		onlyPeers[pid] = true
+	}
+	overRepresented := make(map[peer.ID]bool)
+	for i := 0; i < minPeers+1; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+		setSubnets(p, pid, []uint64{2, 7}, nil)
+		overRepresented[pid] = true
+	}
+	unneeded := make(map[peer.ID]bool)
+	for i := 0; i < 2; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+		setSubnets(p, pid, []uint64{7}, nil)
+		unneeded[pid] = true
+	}
+
+	peersToPrune := p.PeersToPrune()
+	require.Equal(t, 3, len(peersToPrune))
+	// Peers on none of our subnets are pruned first.
+	assert.Equal(t, true, unneeded[peersToPrune[0]])
+	assert.Equal(t, true, unneeded[peersToPrune[1]])
+	assert.Equal(t, true, overRepresented[peersToPrune[2]])
+	for _, pid := range peersToPrune {
+		assert.Equal(t, false, onlyPeers[pid], "Pruned a peer needed on its subnet")
+	}
+
+	// Without subscribed subnets any inbound peer can be pruned.
+	p.SetSubscribedSubnets(peers.AttestationSubnet, nil)
+	assert.Equal(t, 2*minPeers+3+25-30, len(p.PeersToPrune()))
+}
+
+func setSubnets(p *peers.Status, pid peer.ID, attSubnets, syncSubnets []uint64) {
+	syncnets := bitfield.NewBitvector512()
+	for _, idx := range syncSubnets {
+		syncnets.SetBitAt(idx, true)
+	}
+	p.SetMetadata(pid, wrapper.WrappedMetadataV1(&pb.MetaDataV1{
+		Attnets:  attnets(attSubnets...),
+		Syncnets: syncnets,
+	}))
+}
+
+func attnets(indices ...uint64) bitfield.Bitvector64 {
+	bitV := bitfield.NewBitvector64()
+	for _, idx := range indices {
+		bitV.SetBitAt(idx, true)
+	}
+	return bitV
+}
@@ -107,7 +107,20 @@ func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 		}
 		logGossipParameters(topic, scoringParams)
 	}
-	return topicHandle.Subscribe(opts...)
+	sub, err := topicHandle.Subscribe(opts...)
+	if err != nil {
+		return nil, err
+	}
+	// Make sure the peers of a newly subscribed subnet are not pruned.
+	s.updateSubscribedSubnets()
+	return sub, nil
+}
+
+// CancelSubscription cancels a PubSub subscription, so that the peers of a subnet
+// which is no longer subscribed to can be pruned.
+func (s *Service) CancelSubscription(sub *pubsub.Subscription) {
+	sub.Cancel()
+	s.updateSubscribedSubnets()
 }
 
 // peerInspector will scrape all the relevant scoring data and add it to our
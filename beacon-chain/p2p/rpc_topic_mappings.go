@@ -7,46 +7,58 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // SchemaVersionV1 specifies the schema version for our rpc protocol ID.
 const SchemaVersionV1 = "/1"
 
+// SchemaVersionV2 specifies the next schema version for our rpc protocol ID.
+const SchemaVersionV2 = "/2"
+
 // Specifies the protocol prefix for all our Req/Resp topics.
 const protocolPrefix = "/eth2/beacon_chain/req"
 
 // Specifies the name for the status message topic.
-const statusMessageName = "/status"
+const StatusMessageName = "/status"
 
 // Specifies the name for the goodbye message topic.
-const goodbyeMessageName = "/goodbye"
+const GoodbyeMessageName = "/goodbye"
 
 // Specifies the name for the beacon blocks by range message topic.
-const beaconBlocksByRangeMessageName = "/beacon_blocks_by_range"
+const BeaconBlocksByRangeMessageName = "/beacon_blocks_by_range"
 
 // Specifies the name for the beacon blocks by root message topic.
-const beaconBlocksByRootsMessageName = "/beacon_blocks_by_root"
+const BeaconBlocksByRootsMessageName = "/beacon_blocks_by_root"
 
 // Specifies the name for the ping message topic.
-const pingMessageName = "/ping"
+const PingMessageName = "/ping"
 
 // Specifies the name for the metadata message topic.
-const metadataMessageName = "/metadata"
+const MetadataMessageName = "/metadata"
 
 const (
 	// V1 RPC Topics
 	// RPCStatusTopicV1 defines the v1 topic for the status rpc method.
-	RPCStatusTopicV1 = protocolPrefix + statusMessageName + SchemaVersionV1
+	RPCStatusTopicV1 = protocolPrefix + StatusMessageName + SchemaVersionV1
 	// RPCGoodByeTopicV1 defines the v1 topic for the goodbye rpc method.
-	RPCGoodByeTopicV1 = protocolPrefix + goodbyeMessageName + SchemaVersionV1
+	RPCGoodByeTopicV1 = protocolPrefix + GoodbyeMessageName + SchemaVersionV1
 	// RPCBlocksByRangeTopicV1 defines v1 the topic for the blocks by range rpc method.
-	RPCBlocksByRangeTopicV1 = protocolPrefix + beaconBlocksByRangeMessageName + SchemaVersionV1
+	RPCBlocksByRangeTopicV1 = protocolPrefix + BeaconBlocksByRangeMessageName + SchemaVersionV1
 	// RPCBlocksByRootTopicV1 defines the v1 topic for the blocks by root rpc method.
-	RPCBlocksByRootTopicV1 = protocolPrefix + beaconBlocksByRootsMessageName + SchemaVersionV1
+	RPCBlocksByRootTopicV1 = protocolPrefix + BeaconBlocksByRootsMessageName + SchemaVersionV1
 	// RPCPingTopicV1 defines the v1 topic for the ping rpc method.
-	RPCPingTopicV1 = protocolPrefix + pingMessageName + SchemaVersionV1
+	RPCPingTopicV1 = protocolPrefix + PingMessageName + SchemaVersionV1
 	// RPCMetaDataTopicV1 defines the v1 topic for the metadata rpc method.
-	RPCMetaDataTopicV1 = protocolPrefix + metadataMessageName + SchemaVersionV1
+	RPCMetaDataTopicV1 = protocolPrefix + MetadataMessageName + SchemaVersionV1
+
+	// V2 RPC Topics
+	// RPCBlocksByRangeTopicV2 defines v2 the topic for the blocks by range rpc method.
+	RPCBlocksByRangeTopicV2 = protocolPrefix + BeaconBlocksByRangeMessageName + SchemaVersionV2
+	// RPCBlocksByRootTopicV2 defines the v2 topic for the blocks by root rpc method.
+	RPCBlocksByRootTopicV2 = protocolPrefix + BeaconBlocksByRootsMessageName + SchemaVersionV2
+	// RPCMetaDataTopicV2 defines the v2 topic for the metadata rpc method.
+	RPCMetaDataTopicV2 = protocolPrefix + MetadataMessageName + SchemaVersionV2
 )
 
 // RPCTopicMappings map the base message type to the rpc request.
@@ -57,6 +69,9 @@ var RPCTopicMappings = map[string]interface{}{
 	RPCBlocksByRootTopicV1:  new(p2ptypes.BeaconBlockByRootsReq),
 	RPCPingTopicV1:          new(types.SSZUint64),
 	RPCMetaDataTopicV1:      new(interface{}),
+	RPCBlocksByRangeTopicV2: new(pb.BeaconBlocksByRangeRequest),
+	RPCBlocksByRootTopicV2:  new(p2ptypes.BeaconBlockByRootsReq),
+	RPCMetaDataTopicV2:      new(interface{}),
 }
 
 // Maps all registered protocol prefixes.
@@ -67,16 +82,25 @@ var protocolMapping = map[string]bool{
 // Maps all the protocol message names for the different rpc
 // topics.
 var messageMapping = map[string]bool{
-	statusMessageName:              true,
-	goodbyeMessageName:             true,
-	beaconBlocksByRangeMessageName: true,
-	beaconBlocksByRootsMessageName: true,
-	pingMessageName:                true,
-	metadataMessageName:            true,
+	StatusMessageName:              true,
+	GoodbyeMessageName:             true,
+	BeaconBlocksByRangeMessageName: true,
+	BeaconBlocksByRootsMessageName: true,
+	PingMessageName:                true,
+	MetadataMessageName:            true,
 }
 
 var versionMapping = map[string]bool{
 	SchemaVersionV1: true,
+	SchemaVersionV2: true,
+}
+
+// Maps all the rpc messages which are to be updated to a new
+// schema version from the Altair fork onwards.
+var altairMapping = map[string]bool{
+	BeaconBlocksByRangeMessageName: true,
+	BeaconBlocksByRootsMessageName: true,
+	MetadataMessageName:            true,
 }
 
 // VerifyTopicMapping verifies that the topic and its accompanying
@@ -97,6 +121,30 @@ func VerifyTopicMapping(topic string, msg interface{}) error {
 	return nil
 }
 
+// TopicFromMessage constructs the rpc topic from the provided message
+// name and epoch. Messages with a newer schema version use it from the
+// Altair fork epoch onwards.
+func TopicFromMessage(msg string, epoch types.Epoch) (string, error) {
+	if !messageMapping[msg] {
+		return "", errors.Errorf("%s is not a valid rpc message", msg)
+	}
+	version := SchemaVersionV1
+	if epoch >= params.BeaconConfig().AltairForkEpoch && altairMapping[msg] {
+		version = SchemaVersionV2
+	}
+	return protocolPrefix + msg + version, nil
+}
+
+// BaseTopicFromMessage constructs the v1 rpc topic for the provided message
+// name. Every rpc method is served under v1, so this is the topic to fall
+// back to when a peer does not support a newer schema version.
+func BaseTopicFromMessage(msg string) (string, error) {
+	if !messageMapping[msg] {
+		return "", errors.Errorf("%s is not a valid rpc message", msg)
+	}
+	return protocolPrefix + msg + SchemaVersionV1, nil
+}
+
 // TopicDeconstructor splits the provided topic to its logical sub-sections.
 // It is assumed all input topics will follow the specific schema:
 // /protocol-prefix/message-name/schema-version/...
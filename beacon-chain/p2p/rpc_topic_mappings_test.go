@@ -5,6 +5,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -34,9 +35,9 @@ func TestTopicDeconstructor(t *testing.T) {
 		},
 		{
 			name:          "valid status topic",
-			topic:         protocolPrefix + statusMessageName + SchemaVersionV1,
+			topic:         protocolPrefix + StatusMessageName + SchemaVersionV1,
 			expectedError: "",
-			output:        []string{protocolPrefix, statusMessageName, SchemaVersionV1},
+			output:        []string{protocolPrefix, StatusMessageName, SchemaVersionV1},
 		},
 		{
 			name:          "malformed status topic",
@@ -46,13 +47,19 @@ func TestTopicDeconstructor(t *testing.T) {
 		},
 		{
 			name:          "valid beacon block by range topic",
-			topic:         protocolPrefix + beaconBlocksByRangeMessageName + SchemaVersionV1 + "/ssz_snappy",
+			topic:         protocolPrefix + BeaconBlocksByRangeMessageName + SchemaVersionV1 + "/ssz_snappy",
 			expectedError: "",
-			output:        []string{protocolPrefix, beaconBlocksByRangeMessageName, SchemaVersionV1},
+			output:        []string{protocolPrefix, BeaconBlocksByRangeMessageName, SchemaVersionV1},
+		},
+		{
+			name:          "valid v2 beacon block by range topic",
+			topic:         RPCBlocksByRangeTopicV2 + "/ssz_snappy",
+			expectedError: "",
+			output:        []string{protocolPrefix, BeaconBlocksByRangeMessageName, SchemaVersionV2},
 		},
 		{
 			name:          "beacon block by range topic with malformed version",
-			topic:         protocolPrefix + beaconBlocksByRangeMessageName + "/v" + "/ssz_snappy",
+			topic:         protocolPrefix + BeaconBlocksByRangeMessageName + "/v" + "/ssz_snappy",
 			expectedError: "unable to find a valid schema version for /eth2/beacon_chain/req/beacon_blocks_by_range/v/ssz_snappy",
 			output:        []string{""},
 		},
@@ -73,3 +80,43 @@ func TestTopicDeconstructor(t *testing.T) {
 		})
 	}
 }
+
+func TestTopicFromMessage(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	bCfg := params.BeaconConfig()
+	bCfg.AltairForkEpoch = 5
+	params.OverrideBeaconConfig(bCfg)
+
+	_, err := TopicFromMessage("/foo", 0)
+	assert.ErrorContains(t, "/foo is not a valid rpc message", err)
+
+	for msg := range messageMapping {
+		topic, err := TopicFromMessage(msg, 4)
+		require.NoError(t, err)
+		assert.Equal(t, protocolPrefix+msg+SchemaVersionV1, topic)
+
+		topic, err = TopicFromMessage(msg, 5)
+		require.NoError(t, err)
+		if altairMapping[msg] {
+			assert.Equal(t, protocolPrefix+msg+SchemaVersionV2, topic)
+		} else {
+			assert.Equal(t, protocolPrefix+msg+SchemaVersionV1, topic)
+		}
+	}
+}
+
+func TestBaseTopicFromMessage(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	bCfg := params.BeaconConfig()
+	bCfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(bCfg)
+
+	_, err := BaseTopicFromMessage("/foo")
+	assert.ErrorContains(t, "/foo is not a valid rpc message", err)
+
+	for msg := range messageMapping {
+		topic, err := BaseTopicFromMessage(msg)
+		require.NoError(t, err)
+		assert.Equal(t, protocolPrefix+msg+SchemaVersionV1, topic)
+	}
+}
@@ -65,6 +65,7 @@ type Service struct {
 	ipLimiter             *leakybucket.Collector
 	privKey               *ecdsa.PrivateKey
 	metaData              interfaces.Metadata
+	trustedPeers          []peer.AddrInfo
 	pubsub                *pubsub.PubSub
 	joinedTopics          map[string]*pubsub.Topic
 	joinedTopicsLock      sync.Mutex
@@ -165,6 +166,24 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		},
 	})
 
+	if len(s.cfg.TrustedPeers) > 0 {
+		addrs, err := peersFromStringAddrs(s.cfg.TrustedPeers)
+		if err != nil {
+			log.WithError(err).Error("Failed to parse trusted peers")
+			return nil, err
+		}
+		s.trustedPeers, err = peer.AddrInfosFromP2pAddrs(addrs...)
+		if err != nil {
+			log.WithError(err).Error("Failed to parse trusted peers")
+			return nil, err
+		}
+		pids := make([]peer.ID, 0, len(s.trustedPeers))
+		for _, info := range s.trustedPeers {
+			pids = append(pids, info.ID)
+		}
+		s.peers.SetTrustedPeers(pids)
+	}
+
 	return s, nil
 }
 
@@ -181,6 +200,17 @@ func (s *Service) Start() {
 	s.isPreGenesis = false
 
 	var peersToWatch []string
+	// Trusted peers are redialed whenever they disconnect.
+	for i := range s.trustedPeers {
+		addrs, err := peer.AddrInfoToP2pAddrs(&s.trustedPeers[i])
+		if err != nil {
+			log.WithError(err).Error("Could not watch trusted peer")
+			continue
+		}
+		for _, addr := range addrs {
+			peersToWatch = append(peersToWatch, addr.String())
+		}
+	}
 	if s.cfg.RelayNodeAddr != "" {
 		peersToWatch = append(peersToWatch, s.cfg.RelayNodeAddr)
 		if err := dialRelayNode(s.ctx, s.host, s.cfg.RelayNodeAddr); err != nil {
@@ -211,6 +241,10 @@ func (s *Service) Start() {
 
 	s.started = true
 
+	if err := s.restorePeerStore(); err != nil {
+		log.WithError(err).Error("Could not restore persisted peers")
+	}
+
 	if len(s.cfg.StaticPeers) > 0 {
 		addrs, err := peersFromStringAddrs(s.cfg.StaticPeers)
 		if err != nil {
@@ -224,6 +258,12 @@ func (s *Service) Start() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
 	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	runutil.RunEvery(s.ctx, peerStoreInterval, func() {
+		if err := s.persistPeerStore(); err != nil {
+			log.WithError(err).Error("Could not persist peers")
+		}
+	})
+	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateSubscribedSubnets)
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	runutil.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
@@ -256,6 +296,11 @@ func (s *Service) Start() {
 // Stop the p2p service and terminate all peer connections.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if s.started {
+		if err := s.persistPeerStore(); err != nil {
+			log.WithError(err).Error("Could not persist peers")
+		}
+	}
 	s.started = false
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
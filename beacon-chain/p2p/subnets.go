@@ -2,11 +2,14 @@ package p2p
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
 	"go.opencensus.io/trace"
 
@@ -16,11 +19,19 @@ import (
 
 var attestationSubnetCount = params.BeaconNetworkConfig().AttestationSubnetCount
 
+var syncCommsSubnetCount = params.BeaconConfig().SyncCommitteeSubnetCount
+
 var attSubnetEnrKey = params.BeaconNetworkConfig().AttSubnetKey
+var syncCommsSubnetEnrKey = params.BeaconNetworkConfig().SyncCommsSubnetKey
+
+// attestationSubnetTopicPrefix is the prefix of the last element of attestation subnet topics.
+const attestationSubnetTopicPrefix = "beacon_attestation_"
 
 // FindPeersWithSubnet performs a network search for peers
 // subscribed to a particular subnet. Then we try to connect
-// with those peers. This method will block until the required amount of
+// with those peers. The subnet is interpreted as a sync committee
+// subnet if the provided topic is a sync committee topic, otherwise
+// as an attestation subnet. This method will block until the required amount of
 // peers are found, the method only exits in the event of context timeouts.
 func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 	index, threshold uint64) (bool, error) {
@@ -33,10 +44,14 @@ func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 		// return if discovery isn't set
 		return false, nil
 	}
+	filter := s.filterPeerForSubnet(index)
+	if strings.Contains(topic, GossipSyncCommitteeMessage) {
+		filter = s.filterPeerForSyncSubnet(index)
+	}
 
 	topic += s.Encoding().ProtocolSuffix()
 	iterator := s.dv5Listener.RandomNodes()
-	iterator = filterNodes(ctx, iterator, s.filterPeerForSubnet(index))
+	iterator = filterNodes(ctx, iterator, filter)
 
 	currNum := uint64(len(s.pubsub.ListPeers(topic)))
 	wg := new(sync.WaitGroup)
@@ -89,6 +104,27 @@ func (s *Service) filterPeerForSubnet(index uint64) func(node *enode.Node) bool
 	}
 }
 
+// returns a method with filters peers specifically for a particular sync committee subnet.
+func (s *Service) filterPeerForSyncSubnet(index uint64) func(node *enode.Node) bool {
+	return func(node *enode.Node) bool {
+		if !s.filterPeer(node) {
+			return false
+		}
+		subnets, err := syncSubnets(node.Record())
+		if err != nil {
+			return false
+		}
+		indExists := false
+		for _, comIdx := range subnets {
+			if comIdx == index {
+				indExists = true
+				break
+			}
+		}
+		return indExists
+	}
+}
+
 // lower threshold to broadcast object compared to searching
 // for a subnet. So that even in the event of poor peer
 // connectivity, we can still broadcast an attestation.
@@ -96,6 +132,51 @@ func (s *Service) hasPeerWithSubnet(topic string) bool {
 	return len(s.pubsub.ListPeers(topic+s.Encoding().ProtocolSuffix())) >= 1
 }
 
+// updateSubscribedSubnets sets the attestation and sync committee subnets whose
+// topics we are subscribed to in the peer status, so that pruning keeps the
+// minimum amount of peers on each of them.
+func (s *Service) updateSubscribedSubnets() {
+	if s.pubsub == nil {
+		return
+	}
+	var attSubnets, syncSubnets []uint64
+	for _, topic := range s.pubsub.GetTopics() {
+		subnetType, index, ok := subnetFromTopic(strings.TrimSuffix(topic, s.Encoding().ProtocolSuffix()))
+		if !ok {
+			continue
+		}
+		switch subnetType {
+		case peers.AttestationSubnet:
+			attSubnets = append(attSubnets, index)
+		case peers.SyncCommitteeSubnet:
+			syncSubnets = append(syncSubnets, index)
+		}
+	}
+	s.peers.SetSubscribedSubnets(peers.AttestationSubnet, attSubnets)
+	s.peers.SetSubscribedSubnets(peers.SyncCommitteeSubnet, syncSubnets)
+}
+
+// Parses the subnet type and index of an attestation or sync committee
+// subnet topic without its encoding suffix.
+func subnetFromTopic(topic string) (peers.SubnetType, uint64, bool) {
+	name := topic[strings.LastIndex(topic, "/")+1:]
+	subnetType := peers.AttestationSubnet
+	switch {
+	case strings.HasPrefix(name, attestationSubnetTopicPrefix):
+		name = strings.TrimPrefix(name, attestationSubnetTopicPrefix)
+	case strings.HasPrefix(name, GossipSyncCommitteeMessage+"_"):
+		subnetType = peers.SyncCommitteeSubnet
+		name = strings.TrimPrefix(name, GossipSyncCommitteeMessage+"_")
+	default:
+		return 0, 0, false
+	}
+	index, err := strconv.ParseUint(name, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return subnetType, index, true
+}
+
 // Updates the service's discv5 listener record's attestation subnet
 // with a new value for a bitfield of subnets tracked. It also updates
 // the node's metadata by increasing the sequence number and the
@@ -109,6 +190,13 @@ func (s *Service) updateSubnetRecordWithMetadata(bitV bitfield.Bitvector64) {
 	})
 }
 
+// Updates the service's discv5 listener record's sync committee subnet
+// with a new value for a bitfield of subnets tracked.
+func (s *Service) updateSyncSubnetRecord(bitV bitfield.Bitvector4) {
+	entry := enr.WithEntry(syncCommsSubnetEnrKey, &bitV)
+	s.dv5Listener.LocalNode().Set(entry)
+}
+
 // Initializes a bitvector of attestation subnets beacon nodes is subscribed to
 // and creates a new ENR entry with its default value.
 func intializeAttSubnets(node *enode.LocalNode) *enode.LocalNode {
@@ -118,6 +206,15 @@ func intializeAttSubnets(node *enode.LocalNode) *enode.LocalNode {
 	return node
 }
 
+// Initializes a bitvector of sync committee subnets beacon nodes is subscribed to
+// and creates a new ENR entry with its default value.
+func initializeSyncCommSubnets(node *enode.LocalNode) *enode.LocalNode {
+	bitV := bitfield.NewBitvector4()
+	entry := enr.WithEntry(syncCommsSubnetEnrKey, bitV.Bytes())
+	node.Set(entry)
+	return node
+}
+
 // Reads the attestation subnets entry from a node's ENR and determines
 // the committee indices of the attestation subnets the node is subscribed to.
 func attSubnets(record *enr.Record) ([]uint64, error) {
@@ -146,6 +243,34 @@ func bitvector(record *enr.Record) (bitfield.Bitvector64, error) {
 	return bitV, nil
 }
 
+// Reads the sync committee subnets entry from a node's ENR and determines
+// the indices of the sync committee subnets the node is subscribed to.
+func syncSubnets(record *enr.Record) ([]uint64, error) {
+	bitV, err := syncBitvector(record)
+	if err != nil {
+		return nil, err
+	}
+	var committeeIdxs []uint64
+	for i := uint64(0); i < syncCommsSubnetCount; i++ {
+		if bitV.BitAt(i) {
+			committeeIdxs = append(committeeIdxs, i)
+		}
+	}
+	return committeeIdxs, nil
+}
+
+// Parses the sync committee subnets ENR entry in a node and extracts its value
+// as a bitvector for further manipulation.
+func syncBitvector(record *enr.Record) (bitfield.Bitvector4, error) {
+	bitV := bitfield.NewBitvector4()
+	entry := enr.WithEntry(syncCommsSubnetEnrKey, &bitV)
+	err := record.Load(entry)
+	if err != nil {
+		return nil, err
+	}
+	return bitV, nil
+}
+
 func (s *Service) subnetLocker(i uint64) *sync.RWMutex {
 	s.subnetsLockLock.Lock()
 	defer s.subnetsLockLock.Unlock()
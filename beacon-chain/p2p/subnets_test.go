@@ -2,16 +2,19 @@ package p2p
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/discover"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -135,3 +138,102 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 	assert.NoError(t, s.Stop())
 	exitRoutine <- true
 }
+
+func TestSyncSubnets_RecordRoundTrip(t *testing.T) {
+	port := 2100
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		cfg:                   &Config{UDPPort: uint(port)},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+	}
+	listener, err := s.createListener(ipAddr, pkey)
+	require.NoError(t, err)
+	defer listener.Close()
+	s.dv5Listener = listener
+
+	subnets, err := syncSubnets(listener.Self().Record())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(subnets), "Expected no sync subnets in a fresh record")
+
+	bitV := bitfield.NewBitvector4()
+	bitV.SetBitAt(1, true)
+	bitV.SetBitAt(3, true)
+	s.updateSyncSubnetRecord(bitV)
+
+	subnets, err = syncSubnets(listener.Self().Record())
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1, 3}, subnets)
+}
+
+func TestService_UpdateSubscribedSubnets(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	s, err := NewService(ctx, &Config{StateNotifier: &mock.MockStateNotifier{}, DataDir: t.TempDir()})
+	require.NoError(t, err)
+
+	go s.awaitStateInitialized()
+	fd := initializeStateWithForkDigest(ctx, t, s.stateNotifier.StateFeed())
+
+	suffix := s.Encoding().ProtocolSuffix()
+	for _, topic := range []string{
+		fmt.Sprintf(AttestationSubnetTopicFormat, fd, 42),
+		fmt.Sprintf(AttestationSubnetTopicFormat, fd, 7),
+		fmt.Sprintf(SyncCommitteeSubnetTopicFormat, fd, 2),
+		fmt.Sprintf(SyncContributionAndProofSubnetTopicFormat, fd),
+		fmt.Sprintf(BlockSubnetTopicFormat, fd),
+	} {
+		topicHandle, err := s.JoinTopic(topic + suffix)
+		require.NoError(t, err)
+		_, err = topicHandle.Subscribe()
+		require.NoError(t, err)
+	}
+
+	s.updateSubscribedSubnets()
+	assert.DeepEqual(t, []uint64{7, 42}, s.peers.SubscribedSubnets(peers.AttestationSubnet))
+	assert.DeepEqual(t, []uint64{2}, s.peers.SubscribedSubnets(peers.SyncCommitteeSubnet))
+}
+
+func TestService_PeersToPrune_KeepsSubscribedSubnetPeers(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	s, err := NewService(ctx, &Config{StateNotifier: &mock.MockStateNotifier{}, DataDir: t.TempDir()})
+	require.NoError(t, err)
+
+	go s.awaitStateInitialized()
+	fd := initializeStateWithForkDigest(ctx, t, s.stateNotifier.StateFeed())
+
+	subnetPeers := make(map[peer.ID]bool)
+	for i := uint64(0); i < params.BeaconNetworkConfig().MinimumPeersInSubnet; i++ {
+		pid := addPeer(t, s.peers, peers.PeerConnected)
+		s.peers.SetMetadata(pid, wrapper.WrappedMetadataV0(&pb.MetaDataV0{Attnets: attnetsWith(42)}))
+		subnetPeers[pid] = true
+	}
+	for i := 0; i < 2; i++ {
+		addPeer(t, s.peers, peers.PeerConnected)
+	}
+
+	// Scoring parameters are derived from the cached count of active validators.
+	s.activeValidatorCount = 64
+
+	// Subscribing to the subnet is enough to keep its peers, no discovery is needed.
+	sub, err := s.SubscribeToTopic(fmt.Sprintf(AttestationSubnetTopicFormat, fd, 42) + s.Encoding().ProtocolSuffix())
+	require.NoError(t, err)
+	peersToPrune := s.peers.PeersToPrune()
+	require.Equal(t, 2, len(peersToPrune))
+	for _, pid := range peersToPrune {
+		assert.Equal(t, false, subnetPeers[pid], "Pruned a peer needed on a subscribed subnet")
+	}
+
+	// Once unsubscribed, the peers of the subnet can be pruned as well.
+	s.CancelSubscription(sub)
+	assert.Equal(t, len(subnetPeers)+2, len(s.peers.PeersToPrune()))
+}
+
+func attnetsWith(indices ...uint64) bitfield.Bitvector64 {
+	bitV := bitfield.NewBitvector64()
+	for _, idx := range indices {
+		bitV.SetBitAt(idx, true)
+	}
+	return bitV
+}
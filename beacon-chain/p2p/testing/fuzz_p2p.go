@@ -2,6 +2,8 @@ package testing
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/control"
@@ -12,8 +14,10 @@ import (
 	"github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -68,6 +72,40 @@ func (p *FakeP2P) FindPeersWithSubnet(_ context.Context, _ string, _, _ uint64)
 // RefreshENR mocks the p2p func.
 func (p *FakeP2P) RefreshENR() {}
 
+// BanPeer -- fake.
+func (p *FakeP2P) BanPeer(_ context.Context, _ peer.ID, _ time.Duration) error {
+	return nil
+}
+
+// UnbanPeer -- fake.
+func (p *FakeP2P) UnbanPeer(_ peer.ID) bool {
+	return false
+}
+
+// BanIPRange -- fake.
+func (p *FakeP2P) BanIPRange(_ context.Context, _ *net.IPNet, _ time.Duration) error {
+	return nil
+}
+
+// UnbanIPRange -- fake.
+func (p *FakeP2P) UnbanIPRange(_ *net.IPNet) bool {
+	return false
+}
+
+// DialPeer -- fake.
+func (p *FakeP2P) DialPeer(_ context.Context, _ string) (peer.ID, error) {
+	return "", nil
+}
+
+// DisconnectPeer -- fake.
+func (p *FakeP2P) DisconnectPeer(_ context.Context, _ peer.ID, _ p2ptypes.RPCGoodbyeCode) error {
+	return nil
+}
+
+// CancelSubscription -- fake.
+func (p *FakeP2P) CancelSubscription(_ *pubsub.Subscription) {
+}
+
 // LeaveTopic -- fake.
 func (p *FakeP2P) LeaveTopic(_ string) error {
 	return nil
@@ -138,6 +176,11 @@ func (p *FakeP2P) BroadcastAttestation(_ context.Context, _ uint64, _ *ethpb.Att
 	return nil
 }
 
+// BroadcastSyncCommitteeMessage -- fake.
+func (p *FakeP2P) BroadcastSyncCommitteeMessage(_ context.Context, _ uint64, _ *prysmv2.SyncCommitteeMessage) error {
+	return nil
+}
+
 // InterceptPeerDial -- fake.
 func (p *FakeP2P) InterceptPeerDial(peer.ID) (allow bool) {
 	return true
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -25,3 +26,10 @@ func (m *MockBroadcaster) BroadcastAttestation(_ context.Context, _ uint64, _ *e
 	m.BroadcastCalled = true
 	return nil
 }
+
+// BroadcastSyncCommitteeMessage records a broadcast occurred.
+func (m *MockBroadcaster) BroadcastSyncCommitteeMessage(_ context.Context, _ uint64, sMsg *prysmv2.SyncCommitteeMessage) error {
+	m.BroadcastCalled = true
+	m.BroadcastMessages = append(m.BroadcastMessages, sMsg)
+	return nil
+}
@@ -0,0 +1,56 @@
+package testing
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+)
+
+// MockPeerAdmin is a mock of the PeerAdmin interface, which applies bans to the given peer status.
+type MockPeerAdmin struct {
+	Status       *peers.Status
+	DialedAddrs  []string
+	DialedPeer   peer.ID
+	Disconnected map[peer.ID]p2ptypes.RPCGoodbyeCode
+}
+
+// BanPeer .
+func (m *MockPeerAdmin) BanPeer(_ context.Context, pid peer.ID, duration time.Duration) error {
+	m.Status.BanPeer(pid, duration)
+	return nil
+}
+
+// UnbanPeer .
+func (m *MockPeerAdmin) UnbanPeer(pid peer.ID) bool {
+	return m.Status.UnbanPeer(pid)
+}
+
+// BanIPRange .
+func (m *MockPeerAdmin) BanIPRange(_ context.Context, ipNet *net.IPNet, duration time.Duration) error {
+	m.Status.BanIPRange(ipNet, duration)
+	return nil
+}
+
+// UnbanIPRange .
+func (m *MockPeerAdmin) UnbanIPRange(ipNet *net.IPNet) bool {
+	return m.Status.UnbanIPRange(ipNet)
+}
+
+// DialPeer .
+func (m *MockPeerAdmin) DialPeer(_ context.Context, addr string) (peer.ID, error) {
+	m.DialedAddrs = append(m.DialedAddrs, addr)
+	return m.DialedPeer, nil
+}
+
+// DisconnectPeer .
+func (m *MockPeerAdmin) DisconnectPeer(_ context.Context, pid peer.ID, code p2ptypes.RPCGoodbyeCode) error {
+	if m.Disconnected == nil {
+		m.Disconnected = make(map[peer.ID]p2ptypes.RPCGoodbyeCode)
+	}
+	m.Disconnected[pid] = code
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
 	"testing"
 	"time"
 
@@ -23,8 +24,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 )
@@ -156,6 +159,12 @@ func (p *TestP2P) BroadcastAttestation(_ context.Context, _ uint64, _ *ethpb.Att
 	return nil
 }
 
+// BroadcastSyncCommitteeMessage broadcasts a sync committee message.
+func (p *TestP2P) BroadcastSyncCommitteeMessage(_ context.Context, _ uint64, _ *prysmv2.SyncCommitteeMessage) error {
+	p.BroadcastCalled = true
+	return nil
+}
+
 // SetStreamHandler for RPC.
 func (p *TestP2P) SetStreamHandler(topic string, handler network.StreamHandler) {
 	p.BHost.SetStreamHandler(protocol.ID(topic), handler)
@@ -192,6 +201,11 @@ func (p *TestP2P) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 	return joinedTopic.Subscribe(opts...)
 }
 
+// CancelSubscription cancels a PubSub subscription.
+func (p *TestP2P) CancelSubscription(sub *pubsub.Subscription) {
+	sub.Cancel()
+}
+
 // LeaveTopic closes topic and removes corresponding handler from list of joined topics.
 // This method will return error if there are outstanding event handlers or subscriptions.
 func (p *TestP2P) LeaveTopic(topic string) error {
@@ -292,7 +306,7 @@ func (p *TestP2P) Send(ctx context.Context, msg interface{}, topic string, pid p
 		return nil, err
 	}
 
-	if topic != "/eth2/beacon_chain/req/metadata/1" {
+	if topic != "/eth2/beacon_chain/req/metadata/1" && topic != "/eth2/beacon_chain/req/metadata/2" {
 		if _, err := p.Encoding().EncodeWithMaxLength(stream, msg); err != nil {
 			_err := stream.Reset()
 			_ = _err
@@ -324,6 +338,42 @@ func (p *TestP2P) Peers() *peers.Status {
 	return p.peers
 }
 
+// BanPeer bans the peer and disconnects from it.
+func (p *TestP2P) BanPeer(_ context.Context, pid peer.ID, duration time.Duration) error {
+	p.peers.BanPeer(pid, duration)
+	return p.Disconnect(pid)
+}
+
+// UnbanPeer lifts the ban of the peer.
+func (p *TestP2P) UnbanPeer(pid peer.ID) bool {
+	return p.peers.UnbanPeer(pid)
+}
+
+// BanIPRange bans the IP range.
+func (p *TestP2P) BanIPRange(_ context.Context, ipNet *net.IPNet, duration time.Duration) error {
+	p.peers.BanIPRange(ipNet, duration)
+	return nil
+}
+
+// UnbanIPRange lifts the ban of the IP range.
+func (p *TestP2P) UnbanIPRange(ipNet *net.IPNet) bool {
+	return p.peers.UnbanIPRange(ipNet)
+}
+
+// DialPeer connects to the peer with the given multiaddr.
+func (p *TestP2P) DialPeer(ctx context.Context, addr string) (peer.ID, error) {
+	info, err := peer.AddrInfoFromString(addr)
+	if err != nil {
+		return "", err
+	}
+	return info.ID, p.BHost.Connect(ctx, *info)
+}
+
+// DisconnectPeer disconnects from the peer, without sending a goodbye message.
+func (p *TestP2P) DisconnectPeer(_ context.Context, pid peer.ID, _ p2ptypes.RPCGoodbyeCode) error {
+	return p.Disconnect(pid)
+}
+
 // FindPeersWithSubnet mocks the p2p func.
 func (p *TestP2P) FindPeersWithSubnet(_ context.Context, _ string, _, _ uint64) (bool, error) {
 	return false, nil
@@ -1,6 +1,10 @@
 package p2p
 
 const (
+	// GossipSyncCommitteeMessage is the name for the sync committee message type. It is
+	// specially extracted so as to determine the correct subnet for the topic.
+	GossipSyncCommitteeMessage = "sync_committee"
+
 	// AttestationSubnetTopicFormat is the topic format for the attestation subnet.
 	AttestationSubnetTopicFormat = "/eth2/%x/beacon_attestation_%d"
 	// BlockSubnetTopicFormat is the topic format for the block subnet.
@@ -13,4 +17,8 @@ const (
 	AttesterSlashingSubnetTopicFormat = "/eth2/%x/attester_slashing"
 	// AggregateAndProofSubnetTopicFormat is the topic format for the aggregate and proof subnet.
 	AggregateAndProofSubnetTopicFormat = "/eth2/%x/beacon_aggregate_and_proof"
+	// SyncCommitteeSubnetTopicFormat is the topic format for the sync committee subnet.
+	SyncCommitteeSubnetTopicFormat = "/eth2/%x/" + GossipSyncCommitteeMessage + "_%d"
+	// SyncContributionAndProofSubnetTopicFormat is the topic format for the sync aggregate and proof subnet.
+	SyncContributionAndProofSubnetTopicFormat = "/eth2/%x/sync_committee_contribution_and_proof"
 )
@@ -0,0 +1,59 @@
+package powchain
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// jwtSecretLength is the length in bytes of the secret shared with the execution engine.
+const jwtSecretLength = 32
+
+// LoadJWTSecret reads the hex encoded secret shared with the execution engine to
+// authenticate engine API requests.
+func LoadJWTSecret(path string) ([]byte, error) {
+	enc, err := fileutil.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read JWT secret file")
+	}
+	hexSecret := strings.TrimSpace(string(enc))
+	if !strings.HasPrefix(hexSecret, "0x") {
+		hexSecret = "0x" + hexSecret
+	}
+	secret, err := hexutil.Decode(hexSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode JWT secret")
+	}
+	if len(secret) != jwtSecretLength {
+		return nil, errors.Errorf("wanted a JWT secret of %d bytes, received %d bytes", jwtSecretLength, len(secret))
+	}
+	return secret, nil
+}
+
+// jwtTransport authenticates each request to the execution engine with a bearer token
+// signed with the shared secret. A new token is issued for every request, as the engine
+// rejects tokens whose issued-at time is not recent.
+type jwtTransport struct {
+	underlyingTransport http.RoundTripper
+	jwtSecret           []byte
+}
+
+// RoundTrip sets the authorization header of the request before sending it.
+func (t *jwtTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
+		IssuedAt: time.Now().Unix(),
+	})
+	tokenString, err := token.SignedString(t.jwtSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign JWT token")
+	}
+	// Requests must not be modified by round trippers.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+	return t.underlyingTransport.RoundTrip(req)
+}
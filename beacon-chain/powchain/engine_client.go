@@ -0,0 +1,313 @@
+package powchain
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/types"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// NewPayloadMethod is the engine method sending an execution payload for validation.
+	NewPayloadMethod = "engine_newPayloadV1"
+	// ForkchoiceUpdatedMethod is the engine method updating the fork choice of the execution engine.
+	ForkchoiceUpdatedMethod = "engine_forkchoiceUpdatedV1"
+	// GetPayloadMethod is the engine method retrieving a payload built by the execution engine.
+	GetPayloadMethod = "engine_getPayloadV1"
+	// ExchangeTransitionConfigurationMethod is the engine method comparing the merge transition
+	// configuration of both layers.
+	ExchangeTransitionConfigurationMethod = "engine_exchangeTransitionConfigurationV1"
+	// chainIDMethod is served by the engine API endpoint and used to check the connection.
+	chainIDMethod = "eth_chainId"
+)
+
+// Timeouts of the engine methods, as recommended by the engine API specification.
+var engineMethodTimeouts = map[string]time.Duration{
+	NewPayloadMethod:                      8 * time.Second,
+	ForkchoiceUpdatedMethod:               8 * time.Second,
+	GetPayloadMethod:                      time.Second,
+	ExchangeTransitionConfigurationMethod: time.Second,
+	chainIDMethod:                         time.Second,
+}
+
+// Error codes specific to the engine API.
+const (
+	unknownPayloadErrorCode           = -38001
+	invalidForkchoiceStateErrorCode   = -38002
+	invalidPayloadAttributesErrorCode = -38003
+)
+
+var (
+	// ErrEngineNotConnected is returned when no connection to the execution engine was established.
+	ErrEngineNotConnected = errors.New("not connected to the execution engine")
+	// ErrUnknownPayload is returned when the execution engine has no payload for the given identifier.
+	ErrUnknownPayload = errors.New("payload does not exist or is not available")
+	// ErrInvalidForkchoiceState is returned when the execution engine rejects the given fork choice state.
+	ErrInvalidForkchoiceState = errors.New("invalid forkchoice state")
+	// ErrInvalidPayloadAttributes is returned when the execution engine rejects the given payload attributes.
+	ErrInvalidPayloadAttributes = errors.New("payload attributes are invalid or inconsistent")
+	// ErrTransitionConfigurationMismatch is returned when the transition configuration of the
+	// execution engine differs from the one of the beacon node.
+	ErrTransitionConfigurationMismatch = errors.New("transition configuration of the execution engine does not match")
+)
+
+var (
+	engineRequestLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "engine_api_request_latency_milliseconds",
+		Help:    "Latency of the requests to the execution engine, by method",
+		Buckets: []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 8000},
+	}, []string{"method"})
+	engineRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "engine_api_request_errors_total",
+		Help: "Number of failed requests to the execution engine, by method",
+	}, []string{"method"})
+	engineConnectedGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "engine_api_connected",
+		Help: "Boolean indicating whether the beacon node is connected to the execution engine",
+	})
+)
+
+// EngineCaller defines the engine API methods which the beacon node calls on the execution engine.
+type EngineCaller interface {
+	NewPayload(ctx context.Context, payload *types.ExecutionPayload) (*types.PayloadStatus, error)
+	ForkchoiceUpdated(
+		ctx context.Context, state *types.ForkchoiceState, attrs *types.PayloadAttributes,
+	) (*types.ForkchoiceUpdatedResponse, error)
+	GetPayload(ctx context.Context, payloadID types.PayloadID) (*types.ExecutionPayload, error)
+	ExchangeTransitionConfiguration(
+		ctx context.Context, cfg *types.TransitionConfiguration,
+	) (*types.TransitionConfiguration, error)
+}
+
+// EngineRPCClient defines the rpc methods required to interact with the execution engine.
+type EngineRPCClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	Close()
+}
+
+var _ = EngineCaller(&Service{})
+
+// NewPayload sends an execution payload to the execution engine for validation.
+func (s *Service) NewPayload(ctx context.Context, payload *types.ExecutionPayload) (*types.PayloadStatus, error) {
+	result := &types.PayloadStatus{}
+	if err := s.engineCall(ctx, result, NewPayloadMethod, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ForkchoiceUpdated updates the head, safe and finalized blocks of the execution engine and,
+// when payload attributes are given, starts building a payload on top of the head.
+func (s *Service) ForkchoiceUpdated(
+	ctx context.Context, state *types.ForkchoiceState, attrs *types.PayloadAttributes,
+) (*types.ForkchoiceUpdatedResponse, error) {
+	result := &types.ForkchoiceUpdatedResponse{}
+	if err := s.engineCall(ctx, result, ForkchoiceUpdatedMethod, state, attrs); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPayload retrieves the payload built by the execution engine for the given identifier.
+func (s *Service) GetPayload(ctx context.Context, payloadID types.PayloadID) (*types.ExecutionPayload, error) {
+	result := &types.ExecutionPayload{}
+	if err := s.engineCall(ctx, result, GetPayloadMethod, payloadID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExchangeTransitionConfiguration sends the merge transition configuration of the beacon node
+// to the execution engine and returns the one of the engine, along with an error if they differ.
+func (s *Service) ExchangeTransitionConfiguration(
+	ctx context.Context, cfg *types.TransitionConfiguration,
+) (*types.TransitionConfiguration, error) {
+	result := &types.TransitionConfiguration{}
+	if err := s.engineCall(ctx, result, ExchangeTransitionConfigurationMethod, cfg); err != nil {
+		return nil, err
+	}
+	if result.TerminalTotalDifficulty == nil || cfg.TerminalTotalDifficulty == nil ||
+		result.TerminalTotalDifficulty.ToInt().Cmp(cfg.TerminalTotalDifficulty.ToInt()) != 0 {
+		return result, errors.Wrapf(
+			ErrTransitionConfigurationMismatch,
+			"terminal total difficulty %v != %v", result.TerminalTotalDifficulty, cfg.TerminalTotalDifficulty,
+		)
+	}
+	if result.TerminalBlockHash != cfg.TerminalBlockHash {
+		return result, errors.Wrapf(
+			ErrTransitionConfigurationMismatch,
+			"terminal block hash %#x != %#x", result.TerminalBlockHash, cfg.TerminalBlockHash,
+		)
+	}
+	return result, nil
+}
+
+// IsConnectedToEngine checks if the beacon node is connected to the execution engine.
+func (s *Service) IsConnectedToEngine() bool {
+	s.engineLock.RLock()
+	defer s.engineLock.RUnlock()
+	return s.engineConnected
+}
+
+// engineCall calls a method of the execution engine, records its metrics and triggers a
+// reconnection when the engine cannot be reached.
+func (s *Service) engineCall(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	s.engineLock.RLock()
+	client := s.engineRPCClient
+	s.engineLock.RUnlock()
+	if client == nil {
+		return ErrEngineNotConnected
+	}
+
+	if timeout, ok := engineMethodTimeouts[method]; ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	start := time.Now()
+	err := client.CallContext(ctx, result, method, args...)
+	engineRequestLatency.WithLabelValues(method).Observe(float64(time.Since(start).Milliseconds()))
+	if err == nil {
+		return nil
+	}
+	engineRequestErrors.WithLabelValues(method).Inc()
+
+	var rpcErr gethRPC.Error
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.ErrorCode() {
+		case unknownPayloadErrorCode:
+			return errors.Wrap(ErrUnknownPayload, err.Error())
+		case invalidForkchoiceStateErrorCode:
+			return errors.Wrap(ErrInvalidForkchoiceState, err.Error())
+		case invalidPayloadAttributesErrorCode:
+			return errors.Wrap(ErrInvalidPayloadAttributes, err.Error())
+		}
+		return errors.Wrapf(err, "could not call %s", method)
+	}
+	// The engine could not be reached, unless the request was cancelled by the caller.
+	if ctx.Err() == nil || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		s.engineDisconnected(err)
+	}
+	return errors.Wrapf(err, "could not call %s", method)
+}
+
+// connectToEngine dials the execution engine and checks that it follows the expected chain.
+func (s *Service) connectToEngine(ctx context.Context) error {
+	client, err := gethRPC.DialHTTPWithClient(s.cfg.ExecutionEndpoint, &http.Client{
+		Transport: &jwtTransport{
+			underlyingTransport: http.DefaultTransport,
+			jwtSecret:           s.cfg.JWTSecret,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, engineMethodTimeouts[chainIDMethod])
+	defer cancel()
+	var chainID hexutil.Big
+	if err := client.CallContext(ctx, &chainID, chainIDMethod); err != nil {
+		client.Close()
+		return err
+	}
+	if chainID.ToInt().Uint64() != params.BeaconConfig().DepositChainID {
+		client.Close()
+		return errors.Errorf("execution engine using incorrect chain id, %d != %d", chainID.ToInt().Uint64(), params.BeaconConfig().DepositChainID)
+	}
+
+	s.engineLock.Lock()
+	previous := s.engineRPCClient
+	s.engineRPCClient = client
+	s.engineConnected = true
+	s.engineLock.Unlock()
+	if previous != nil {
+		previous.Close()
+	}
+	engineConnectedGauge.Set(1)
+	log.WithField("endpoint", logutil.MaskCredentialsLogging(s.cfg.ExecutionEndpoint)).Info("Connected to execution engine")
+	return nil
+}
+
+// waitForEngineConnection dials the execution engine until it succeeds or the service stops.
+func (s *Service) waitForEngineConnection() {
+	defer func() {
+		s.engineLock.Lock()
+		s.engineReconnecting = false
+		s.engineLock.Unlock()
+	}()
+	err := s.connectToEngine(s.ctx)
+	if err == nil {
+		return
+	}
+	log.WithError(err).Error("Could not connect to execution engine")
+	ticker := time.NewTicker(backOffPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.connectToEngine(s.ctx); err != nil {
+				log.WithError(err).Debug("Could not connect to execution engine")
+				continue
+			}
+			return
+		case <-s.ctx.Done():
+			log.Debug("Received cancelled context, closing existing execution engine client")
+			return
+		}
+	}
+}
+
+// engineDisconnected marks the execution engine as unreachable and starts reconnecting to it,
+// unless a reconnection is already in progress.
+func (s *Service) engineDisconnected(err error) {
+	s.engineLock.Lock()
+	wasConnected := s.engineConnected
+	s.engineConnected = false
+	reconnecting := s.engineReconnecting
+	s.engineReconnecting = true
+	s.engineLock.Unlock()
+	engineConnectedGauge.Set(0)
+	if wasConnected {
+		log.WithError(err).Error("Lost connection to execution engine")
+	}
+	if !reconnecting {
+		go s.waitForEngineConnection()
+	}
+}
+
+// startEngineConnection connects to the execution engine in the background, if an endpoint
+// was configured.
+func (s *Service) startEngineConnection() {
+	if s.cfg.ExecutionEndpoint == "" {
+		return
+	}
+	s.engineLock.Lock()
+	if s.engineReconnecting {
+		s.engineLock.Unlock()
+		return
+	}
+	s.engineReconnecting = true
+	s.engineLock.Unlock()
+	go s.waitForEngineConnection()
+}
+
+// closeEngineClient closes the connection to the execution engine.
+func (s *Service) closeEngineClient() {
+	s.engineLock.Lock()
+	defer s.engineLock.Unlock()
+	if s.engineRPCClient != nil {
+		s.engineRPCClient.Close()
+		s.engineRPCClient = nil
+	}
+	if s.engineConnected {
+		s.engineConnected = false
+		engineConnectedGauge.Set(0)
+	}
+}
@@ -0,0 +1,311 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var engineTestSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// engineError is returned by the stub engine to respond with an engine API error code.
+type engineError struct {
+	code int
+}
+
+func (e *engineError) Error() string  { return fmt.Sprintf("engine error %d", e.code) }
+func (e *engineError) ErrorCode() int { return e.code }
+
+// stubEngine serves the engine API methods used by the beacon node.
+type stubEngine struct {
+	lock       sync.Mutex
+	payloads   map[types.PayloadID]*types.ExecutionPayload
+	lastState  *types.ForkchoiceState
+	ttd        *hexutil.Big
+	terminal   common.Hash
+	newPayload *types.ExecutionPayload
+}
+
+func (e *stubEngine) NewPayloadV1(payload *types.ExecutionPayload) (*types.PayloadStatus, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.newPayload = payload
+	hash := payload.BlockHash
+	return &types.PayloadStatus{Status: types.PayloadStatusValid, LatestValidHash: &hash}, nil
+}
+
+func (e *stubEngine) ForkchoiceUpdatedV1(
+	state *types.ForkchoiceState, attrs *types.PayloadAttributes,
+) (*types.ForkchoiceUpdatedResponse, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if state.HeadBlockHash == (common.Hash{}) {
+		return nil, &engineError{code: invalidForkchoiceStateErrorCode}
+	}
+	e.lastState = state
+	resp := &types.ForkchoiceUpdatedResponse{
+		PayloadStatus: types.PayloadStatus{Status: types.PayloadStatusValid, LatestValidHash: &state.HeadBlockHash},
+	}
+	if attrs != nil {
+		id := types.PayloadID{1, 2, 3, 4, 5, 6, 7, 8}
+		e.payloads[id] = &types.ExecutionPayload{
+			ParentHash:    state.HeadBlockHash,
+			FeeRecipient:  attrs.SuggestedFeeRecipient,
+			PrevRandao:    attrs.PrevRandao,
+			Timestamp:     attrs.Timestamp,
+			LogsBloom:     make([]byte, 256),
+			ExtraData:     []byte{},
+			BaseFeePerGas: (*hexutil.Big)(big.NewInt(7)),
+			Transactions:  []hexutil.Bytes{{0x01, 0x02}},
+		}
+		resp.PayloadID = &id
+	}
+	return resp, nil
+}
+
+func (e *stubEngine) GetPayloadV1(id types.PayloadID) (*types.ExecutionPayload, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	payload, ok := e.payloads[id]
+	if !ok {
+		return nil, &engineError{code: unknownPayloadErrorCode}
+	}
+	return payload, nil
+}
+
+func (e *stubEngine) ExchangeTransitionConfigurationV1(*types.TransitionConfiguration) (*types.TransitionConfiguration, error) {
+	return &types.TransitionConfiguration{
+		TerminalTotalDifficulty: e.ttd,
+		TerminalBlockHash:       e.terminal,
+	}, nil
+}
+
+type stubEth struct{}
+
+func (stubEth) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).SetUint64(params.BeaconConfig().DepositChainID))
+}
+
+// engineServer serves the stub engine over HTTP, requiring requests to be authenticated
+// with a JWT token signed with the given secret. It can be made unavailable to simulate a
+// lost connection.
+type engineServer struct {
+	*httptest.Server
+	engine      *stubEngine
+	lock        sync.Mutex
+	unavailable bool
+}
+
+func newEngineServer(t *testing.T, secret []byte) *engineServer {
+	rpcServer := gethRPC.NewServer()
+	engine := &stubEngine{
+		payloads: make(map[types.PayloadID]*types.ExecutionPayload),
+		ttd:      (*hexutil.Big)(big.NewInt(1000)),
+		terminal: common.HexToHash("0xabcd"),
+	}
+	require.NoError(t, rpcServer.RegisterName("engine", engine))
+	require.NoError(t, rpcServer.RegisterName("eth", stubEth{}))
+	s := &engineServer{engine: engine}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		unavailable := s.unavailable
+		s.lock.Unlock()
+		if unavailable {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		tokenString := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+			}
+			return secret, nil
+		})
+		if err != nil || !token.Valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok || !claims.VerifyIssuedAt(time.Now().Unix()+5, true) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		rpcServer.ServeHTTP(w, r)
+	}))
+	t.Cleanup(func() {
+		s.Close()
+		rpcServer.Stop()
+	})
+	return s
+}
+
+func (s *engineServer) setUnavailable(unavailable bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.unavailable = unavailable
+}
+
+func setupEngineService(t *testing.T, endpoint string, secret []byte) *Service {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg: &Web3ServiceConfig{
+			ExecutionEndpoint: endpoint,
+			JWTSecret:         secret,
+		},
+	}
+}
+
+func TestEngineClient_JWTAuthentication(t *testing.T) {
+	server := newEngineServer(t, engineTestSecret)
+
+	s := setupEngineService(t, server.URL, []byte("another secret of thirty two by"))
+	assert.ErrorContains(t, "401 Unauthorized", s.connectToEngine(context.Background()))
+	assert.Equal(t, false, s.IsConnectedToEngine())
+
+	s = setupEngineService(t, server.URL, engineTestSecret)
+	require.NoError(t, s.connectToEngine(context.Background()))
+	assert.Equal(t, true, s.IsConnectedToEngine())
+	s.closeEngineClient()
+	assert.Equal(t, false, s.IsConnectedToEngine())
+	_, err := s.GetPayload(context.Background(), types.PayloadID{})
+	assert.ErrorContains(t, ErrEngineNotConnected.Error(), err)
+}
+
+func TestEngineClient_Methods(t *testing.T) {
+	ctx := context.Background()
+	server := newEngineServer(t, engineTestSecret)
+	s := setupEngineService(t, server.URL, engineTestSecret)
+	require.NoError(t, s.connectToEngine(ctx))
+	defer s.closeEngineClient()
+
+	state := &types.ForkchoiceState{
+		HeadBlockHash:      common.HexToHash("0x01"),
+		SafeBlockHash:      common.HexToHash("0x02"),
+		FinalizedBlockHash: common.HexToHash("0x03"),
+	}
+	resp, err := s.ForkchoiceUpdated(ctx, state, nil)
+	require.NoError(t, err)
+	assert.Equal(t, types.PayloadStatusValid, resp.PayloadStatus.Status)
+	assert.Equal(t, true, resp.PayloadID == nil, "Unexpected payload id")
+	assert.DeepEqual(t, state, server.engine.lastState)
+
+	attrs := &types.PayloadAttributes{
+		Timestamp:             100,
+		PrevRandao:            common.HexToHash("0x04"),
+		SuggestedFeeRecipient: common.HexToAddress("0x05"),
+	}
+	resp, err = s.ForkchoiceUpdated(ctx, state, attrs)
+	require.NoError(t, err)
+	require.NotNil(t, resp.PayloadID)
+
+	payload, err := s.GetPayload(ctx, *resp.PayloadID)
+	require.NoError(t, err)
+	assert.Equal(t, state.HeadBlockHash, payload.ParentHash)
+	assert.Equal(t, attrs.SuggestedFeeRecipient, payload.FeeRecipient)
+	assert.Equal(t, uint64(100), uint64(payload.Timestamp))
+	assert.Equal(t, int64(7), payload.BaseFeePerGas.ToInt().Int64())
+
+	status, err := s.NewPayload(ctx, payload)
+	require.NoError(t, err)
+	assert.Equal(t, types.PayloadStatusValid, status.Status)
+	assert.Equal(t, payload.BlockHash, *status.LatestValidHash)
+	assert.DeepEqual(t, payload, server.engine.newPayload)
+
+	_, err = s.GetPayload(ctx, types.PayloadID{9})
+	assert.Equal(t, true, errors.Is(err, ErrUnknownPayload))
+	_, err = s.ForkchoiceUpdated(ctx, &types.ForkchoiceState{}, nil)
+	assert.Equal(t, true, errors.Is(err, ErrInvalidForkchoiceState))
+	// Errors returned by the engine do not affect the connection.
+	assert.Equal(t, true, s.IsConnectedToEngine())
+
+	cfg := &types.TransitionConfiguration{
+		TerminalTotalDifficulty: (*hexutil.Big)(big.NewInt(1000)),
+		TerminalBlockHash:       common.HexToHash("0xabcd"),
+	}
+	_, err = s.ExchangeTransitionConfiguration(ctx, cfg)
+	require.NoError(t, err)
+	cfg.TerminalTotalDifficulty = (*hexutil.Big)(big.NewInt(1001))
+	_, err = s.ExchangeTransitionConfiguration(ctx, cfg)
+	assert.Equal(t, true, errors.Is(err, ErrTransitionConfigurationMismatch))
+}
+
+func TestEngineClient_Reconnects(t *testing.T) {
+	defaultBackOffPeriod := backOffPeriod
+	backOffPeriod = 10 * time.Millisecond
+	defer func() {
+		backOffPeriod = defaultBackOffPeriod
+	}()
+	ctx := context.Background()
+	server := newEngineServer(t, engineTestSecret)
+	s := setupEngineService(t, server.URL, engineTestSecret)
+	s.startEngineConnection()
+	defer s.closeEngineClient()
+	require.NoError(t, waitFor(s.IsConnectedToEngine))
+
+	server.setUnavailable(true)
+	_, err := s.GetPayload(ctx, types.PayloadID{})
+	assert.ErrorContains(t, "could not call engine_getPayloadV1", err)
+	assert.Equal(t, false, s.IsConnectedToEngine())
+
+	server.setUnavailable(false)
+	require.NoError(t, waitFor(s.IsConnectedToEngine))
+	_, err = s.GetPayload(ctx, types.PayloadID{})
+	assert.Equal(t, true, errors.Is(err, ErrUnknownPayload))
+}
+
+func waitFor(condition func() bool) error {
+	for i := 0; i < 100; i++ {
+		if condition() {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Errorf("condition not met")
+}
+
+func TestLoadJWTSecret(t *testing.T) {
+	dir := t.TempDir()
+	secret := hexutil.Encode(engineTestSecret)
+
+	path := filepath.Join(dir, "jwt.hex")
+	require.NoError(t, ioutil.WriteFile(path, []byte(secret+"\n"), 0600))
+	loaded, err := LoadJWTSecret(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, engineTestSecret, loaded)
+
+	// The 0x prefix is optional.
+	require.NoError(t, ioutil.WriteFile(path, []byte(strings.TrimPrefix(secret, "0x")), 0600))
+	loaded, err = LoadJWTSecret(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, engineTestSecret, loaded)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("0x0102"), 0600))
+	_, err = LoadJWTSecret(path)
+	assert.ErrorContains(t, "wanted a JWT secret of 32 bytes, received 2 bytes", err)
+	require.NoError(t, ioutil.WriteFile(path, []byte("not hex"), 0600))
+	_, err = LoadJWTSecret(path)
+	assert.ErrorContains(t, "could not decode JWT secret", err)
+	_, err = LoadJWTSecret(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, "could not read JWT secret file", err)
+}
@@ -497,6 +497,7 @@ func (s *Service) savePowchainData(ctx context.Context) error {
 		BeaconState:       pbState, // I promise not to mutate it!
 		Trie:              s.depositTrie.ToProto(),
 		DepositContainers: s.cfg.DepositCache.AllDepositContainers(ctx),
+		DepositSnapshot:   s.cfg.DepositCache.DepositSnapshot(ctx),
 	}
 	return s.cfg.BeaconDB.SavePowchainData(ctx, eth1Data)
 }
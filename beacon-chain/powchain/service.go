@@ -148,6 +148,10 @@ type Service struct {
 	runError                error
 	preGenesisState         state.BeaconState
 	bsUpdater               BeaconNodeStatsUpdater
+	engineLock              sync.RWMutex
+	engineRPCClient         EngineRPCClient
+	engineConnected         bool
+	engineReconnecting      bool
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
@@ -160,6 +164,9 @@ type Web3ServiceConfig struct {
 	StateGen               *stategen.State
 	Eth1HeaderReqLimit     uint64
 	BeaconNodeStatsUpdater BeaconNodeStatsUpdater
+	DepositSnapshot        *protodb.DepositSnapshot
+	ExecutionEndpoint      string // ExecutionEndpoint is the engine API endpoint of the execution engine.
+	JWTSecret              []byte // JWTSecret authenticates the requests to the execution engine.
 }
 
 // NewService sets up a new instance with an ethclient when
@@ -232,6 +239,11 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 	if err := s.initializeEth1Data(ctx, eth1Data); err != nil {
 		return nil, err
 	}
+	if config.DepositSnapshot != nil {
+		if err := s.importDepositSnapshot(ctx, config.DepositSnapshot); err != nil {
+			return nil, errors.Wrap(err, "could not import deposit snapshot")
+		}
+	}
 
 	return s, nil
 }
@@ -252,6 +264,8 @@ func (s *Service) Start() {
 		}
 	}
 
+	s.startEngineConnection()
+
 	// Exit early if eth1 endpoint is not set.
 	if s.currHttpEndpoint.Url == "" {
 		return
@@ -273,6 +287,7 @@ func (s *Service) Stop() error {
 		defer s.cancel()
 	}
 	s.closeClients()
+	s.closeEngineClient()
 	return nil
 }
 
@@ -374,8 +389,7 @@ func (s *Service) AreAllDepositsProcessed() (bool, error) {
 		return false, errors.Wrap(err, "could not get deposit count")
 	}
 	count := bytesutil.FromBytes8(countByte)
-	deposits := s.cfg.DepositCache.AllDeposits(s.ctx, nil)
-	if count != uint64(len(deposits)) {
+	if count != s.cfg.DepositCache.DepositCount(s.ctx) {
 		return false, nil
 	}
 	return true, nil
@@ -600,7 +614,10 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 	}
 	// Default to all deposits post-genesis deposits in
 	// the event we cannot find a finalized state.
-	currIndex := genesisState.Eth1DepositIndex()
+	currIndex := uint64(0)
+	if genesisState != nil && !genesisState.IsNil() {
+		currIndex = genesisState.Eth1DepositIndex()
+	}
 	chkPt, err := s.cfg.BeaconDB.FinalizedCheckpoint(ctx)
 	if err != nil {
 		return err
@@ -618,10 +635,10 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 		currIndex = fState.Eth1DepositIndex()
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add the deposits which are not yet processed by the state as pending,
+	// the containers of finalized deposits may have been pruned.
+	for _, c := range ctrs {
+		if uint64(c.Index) >= currIndex {
 			s.cfg.DepositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -977,15 +994,49 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *protodb.
 	s.latestEth1Data = eth1DataInDB.CurrentEth1Data
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
+	if eth1DataInDB.DepositSnapshot != nil {
+		if err := s.cfg.DepositCache.InsertDepositSnapshot(ctx, eth1DataInDB.DepositSnapshot); err != nil {
+			return errors.Wrap(err, "could not restore deposit snapshot")
+		}
+	}
 	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
 	return nil
 }
 
+// importDepositSnapshot initializes the deposit trie from a snapshot exported by another node,
+// so that only the deposit logs following the snapshot have to be requested from the eth1 node.
+func (s *Service) importDepositSnapshot(ctx context.Context, snapshot *protodb.DepositSnapshot) error {
+	if !s.chainStartData.Chainstarted {
+		return errors.New("deposit snapshots can only be imported once the chain has started")
+	}
+	if s.depositTrie.NumOfItems() > 0 {
+		log.Warn("Ignoring deposit snapshot as deposits are already stored in the database")
+		return nil
+	}
+	depositTrie, err := depositcache.DepositTrieFromSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := s.cfg.DepositCache.InsertDepositSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	s.depositTrie = depositTrie
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1
+	if s.latestEth1Data.LastRequestedBlock < snapshot.ExecutionBlockHeight {
+		s.latestEth1Data.LastRequestedBlock = snapshot.ExecutionBlockHeight
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount": snapshot.DepositCount,
+		"eth1Block":    snapshot.ExecutionBlockHeight,
+	}).Info("Imported deposit snapshot")
+	return s.savePowchainData(ctx)
+}
+
 // validates that all deposit containers are valid and have their relevant indices
 // in order.
-func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer) bool {
+func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer, snapshot *protodb.DepositSnapshot) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -996,6 +1047,10 @@ func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer) bo
 		return ctrs[i].Index < ctrs[j].Index
 	})
 	startIndex := int64(0)
+	// The containers of the deposits covered by the snapshot may have been pruned.
+	if snapshot != nil && ctrs[0].Index > 0 && uint64(ctrs[0].Index) <= snapshot.DepositCount {
+		startIndex = ctrs[0].Index
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -1013,6 +1068,14 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// A node synced from a checkpoint may have no genesis state,
+	// the chain has then started before the checkpoint state.
+	if genState == nil || genState.IsNil() {
+		genState, err = s.originState(ctx)
+		if err != nil {
+			return err
+		}
+	}
 	// Exit early if no genesis state is saved.
 	if genState == nil || genState.IsNil() {
 		return nil
@@ -1021,7 +1084,7 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !s.validateDepositContainers(eth1Data.DepositContainers) {
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !s.validateDepositContainers(eth1Data.DepositContainers, eth1Data.DepositSnapshot) {
 		pbState, err := v1.ProtobufBeaconState(s.preGenesisState.InnerStateUnsafe())
 		if err != nil {
 			return err
@@ -1039,12 +1102,26 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 			BeaconState:       pbState,
 			Trie:              s.depositTrie.ToProto(),
 			DepositContainers: s.cfg.DepositCache.AllDepositContainers(ctx),
+			DepositSnapshot:   s.cfg.DepositCache.DepositSnapshot(ctx),
 		}
 		return s.cfg.BeaconDB.SavePowchainData(ctx, eth1Data)
 	}
 	return nil
 }
 
+// originState returns the checkpoint state the database was initialized from, or nil
+// if the database was initialized from genesis.
+func (s *Service) originState(ctx context.Context) (state.BeaconState, error) {
+	root, err := s.cfg.BeaconDB.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.cfg.BeaconDB.State(ctx, root)
+}
+
 func dedupEndpoints(endpoints []string) []string {
 	selectionMap := make(map[string]bool)
 	newEndpoints := make([]string, 0, len(endpoints))
@@ -21,6 +21,7 @@ import (
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/clientstats"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/httputils"
@@ -28,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -690,6 +692,76 @@ func TestService_EnsureValidPowchainData(t *testing.T) {
 	assert.Equal(t, 0, len(eth1Data.DepositContainers))
 }
 
+func TestNewService_ImportsDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	genState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, genState))
+
+	depositTrie, err := trieutil.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		depositTrie.Insert(bytesutil.PadTo([]byte{byte(i + 1)}, 32), i)
+	}
+	finalized, err := depositTrie.FinalizedHashes(5)
+	require.NoError(t, err)
+	root := depositTrie.Root()
+	snapshot := &protodb.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          root[:],
+		DepositCount:         5,
+		ExecutionBlockHeight: 50,
+	}
+
+	cache, err := depositcache.New()
+	require.NoError(t, err)
+	s, err := NewService(ctx, &Web3ServiceConfig{
+		BeaconDB:        beaconDB,
+		DepositCache:    cache,
+		DepositSnapshot: snapshot,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), s.lastReceivedMerkleIndex)
+	assert.Equal(t, uint64(50), s.latestEth1Data.LastRequestedBlock)
+	assert.Equal(t, root, s.depositTrie.Root())
+	assert.Equal(t, int64(4), cache.FinalizedDeposits(ctx).MerkleTrieIndex)
+
+	// The snapshot is persisted and restored on the next start.
+	cache, err = depositcache.New()
+	require.NoError(t, err)
+	s, err = NewService(ctx, &Web3ServiceConfig{
+		BeaconDB:     beaconDB,
+		DepositCache: cache,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), s.lastReceivedMerkleIndex)
+	assert.Equal(t, root, s.depositTrie.Root())
+	require.NotNil(t, cache.DepositSnapshot(ctx))
+	assert.Equal(t, uint64(5), cache.DepositSnapshot(ctx).DepositCount)
+
+	// A snapshot is only imported into an empty deposit trie.
+	snapshot.DepositCount = 3
+	_, err = NewService(ctx, &Web3ServiceConfig{
+		BeaconDB:        beaconDB,
+		DepositCache:    cache,
+		DepositSnapshot: snapshot,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), cache.DepositSnapshot(ctx).DepositCount)
+}
+
+func TestNewService_DepositSnapshotBeforeChainStart(t *testing.T) {
+	cache, err := depositcache.New()
+	require.NoError(t, err)
+	_, err = NewService(context.Background(), &Web3ServiceConfig{
+		BeaconDB:        dbutil.SetupDB(t),
+		DepositCache:    cache,
+		DepositSnapshot: &protodb.DepositSnapshot{},
+	})
+	assert.ErrorContains(t, "deposit snapshots can only be imported once the chain has started", err)
+}
+
 func TestService_ValidateDepositContainers(t *testing.T) {
 	beaconDB := dbutil.SetupDB(t)
 	cache, err := depositcache.New()
@@ -704,6 +776,7 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 	var tt = []struct {
 		name        string
 		ctrsFunc    func() []*protodb.DepositContainer
+		snapshot    *protodb.DepositSnapshot
 		expectedRes bool
 	}{
 		{
@@ -749,10 +822,34 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 			},
 			expectedRes: false,
 		},
+		{
+			name: "containers following the snapshot",
+			ctrsFunc: func() []*protodb.DepositContainer {
+				ctrs := make([]*protodb.DepositContainer, 0)
+				for i := 4; i < 10; i++ {
+					ctrs = append(ctrs, &protodb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &protodb.DepositSnapshot{DepositCount: 6},
+			expectedRes: true,
+		},
+		{
+			name: "containers missing after the snapshot",
+			ctrsFunc: func() []*protodb.DepositContainer {
+				ctrs := make([]*protodb.DepositContainer, 0)
+				for i := 7; i < 10; i++ {
+					ctrs = append(ctrs, &protodb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &protodb.DepositSnapshot{DepositCount: 6},
+			expectedRes: false,
+		},
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, s1.validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, s1.validateDepositContainers(test.ctrsFunc(), test.snapshot))
 	}
 }
 
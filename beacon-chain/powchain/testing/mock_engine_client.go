@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/types"
+)
+
+// EngineClient defines a mock for the engine API methods of the powchain service.
+type EngineClient struct {
+	PayloadStatus           *types.PayloadStatus
+	ForkchoiceUpdatedResp   *types.ForkchoiceUpdatedResponse
+	Payloads                map[types.PayloadID]*types.ExecutionPayload
+	TransitionConfiguration *types.TransitionConfiguration
+	ErrNewPayload           error
+	ErrForkchoiceUpdated    error
+	ErrGetPayload           error
+	ErrExchangeConfig       error
+}
+
+// NewPayload --
+func (e *EngineClient) NewPayload(_ context.Context, _ *types.ExecutionPayload) (*types.PayloadStatus, error) {
+	return e.PayloadStatus, e.ErrNewPayload
+}
+
+// ForkchoiceUpdated --
+func (e *EngineClient) ForkchoiceUpdated(
+	_ context.Context, _ *types.ForkchoiceState, _ *types.PayloadAttributes,
+) (*types.ForkchoiceUpdatedResponse, error) {
+	return e.ForkchoiceUpdatedResp, e.ErrForkchoiceUpdated
+}
+
+// GetPayload --
+func (e *EngineClient) GetPayload(_ context.Context, payloadID types.PayloadID) (*types.ExecutionPayload, error) {
+	if e.ErrGetPayload != nil {
+		return nil, e.ErrGetPayload
+	}
+	payload, ok := e.Payloads[payloadID]
+	if !ok {
+		return nil, errors.New("unknown payload")
+	}
+	return payload, nil
+}
+
+// ExchangeTransitionConfiguration --
+func (e *EngineClient) ExchangeTransitionConfiguration(
+	_ context.Context, _ *types.TransitionConfiguration,
+) (*types.TransitionConfiguration, error) {
+	return e.TransitionConfiguration, e.ErrExchangeConfig
+}
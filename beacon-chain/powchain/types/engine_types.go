@@ -0,0 +1,85 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// PayloadStatusV1 is the status of a payload as reported by the execution engine.
+type PayloadStatusV1 string
+
+// Payload statuses defined by the engine API.
+const (
+	PayloadStatusValid            PayloadStatusV1 = "VALID"
+	PayloadStatusInvalid          PayloadStatusV1 = "INVALID"
+	PayloadStatusSyncing          PayloadStatusV1 = "SYNCING"
+	PayloadStatusAccepted         PayloadStatusV1 = "ACCEPTED"
+	PayloadStatusInvalidBlockHash PayloadStatusV1 = "INVALID_BLOCK_HASH"
+)
+
+// PayloadID identifies a payload build process started by the execution engine.
+type PayloadID [8]byte
+
+// MarshalText encodes the payload identifier as a 0x-prefixed hex string.
+func (id PayloadID) MarshalText() ([]byte, error) {
+	return hexutil.Bytes(id[:]).MarshalText()
+}
+
+// UnmarshalText decodes a payload identifier from a 0x-prefixed hex string.
+func (id *PayloadID) UnmarshalText(input []byte) error {
+	return hexutil.UnmarshalFixedText("PayloadID", input, id[:])
+}
+
+// ExecutionPayload is the ExecutionPayloadV1 object of the engine API.
+type ExecutionPayload struct {
+	ParentHash    common.Hash     `json:"parentHash"`
+	FeeRecipient  common.Address  `json:"feeRecipient"`
+	StateRoot     common.Hash     `json:"stateRoot"`
+	ReceiptsRoot  common.Hash     `json:"receiptsRoot"`
+	LogsBloom     hexutil.Bytes   `json:"logsBloom"`
+	PrevRandao    common.Hash     `json:"prevRandao"`
+	BlockNumber   hexutil.Uint64  `json:"blockNumber"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	ExtraData     hexutil.Bytes   `json:"extraData"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	BlockHash     common.Hash     `json:"blockHash"`
+	Transactions  []hexutil.Bytes `json:"transactions"`
+}
+
+// PayloadAttributes is the PayloadAttributesV1 object of the engine API, given to the
+// execution engine to start building a payload.
+type PayloadAttributes struct {
+	Timestamp             hexutil.Uint64 `json:"timestamp"`
+	PrevRandao            common.Hash    `json:"prevRandao"`
+	SuggestedFeeRecipient common.Address `json:"suggestedFeeRecipient"`
+}
+
+// ForkchoiceState is the ForkchoiceStateV1 object of the engine API.
+type ForkchoiceState struct {
+	HeadBlockHash      common.Hash `json:"headBlockHash"`
+	SafeBlockHash      common.Hash `json:"safeBlockHash"`
+	FinalizedBlockHash common.Hash `json:"finalizedBlockHash"`
+}
+
+// PayloadStatus is the PayloadStatusV1 object of the engine API.
+type PayloadStatus struct {
+	Status          PayloadStatusV1 `json:"status"`
+	LatestValidHash *common.Hash    `json:"latestValidHash"`
+	ValidationError *string         `json:"validationError"`
+}
+
+// ForkchoiceUpdatedResponse is the response of the execution engine to a fork choice update.
+// The payload identifier is only set when payload attributes were given.
+type ForkchoiceUpdatedResponse struct {
+	PayloadStatus PayloadStatus `json:"payloadStatus"`
+	PayloadID     *PayloadID    `json:"payloadId"`
+}
+
+// TransitionConfiguration is the TransitionConfigurationV1 object of the engine API.
+type TransitionConfiguration struct {
+	TerminalTotalDifficulty *hexutil.Big   `json:"terminalTotalDifficulty"`
+	TerminalBlockHash       common.Hash    `json:"terminalBlockHash"`
+	TerminalBlockNumber     hexutil.Uint64 `json:"terminalBlockNumber"`
+}
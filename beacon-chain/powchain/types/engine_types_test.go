@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestPayloadID_JSON(t *testing.T) {
+	id := PayloadID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	enc, err := json.Marshal(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != `"0x0102030405060708"` {
+		t.Errorf("PayloadID encoded to %s", enc)
+	}
+	var decoded PayloadID
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != id {
+		t.Errorf("PayloadID decoded to %#x, wanted %#x", decoded, id)
+	}
+	if err := json.Unmarshal([]byte(`"0x0102"`), &decoded); err == nil {
+		t.Error("Expected an error when decoding a short payload id")
+	}
+}
+
+func TestExecutionPayload_JSON(t *testing.T) {
+	payload := &ExecutionPayload{
+		ParentHash:    common.HexToHash("0x01"),
+		FeeRecipient:  common.HexToAddress("0x02"),
+		LogsBloom:     make([]byte, 256),
+		PrevRandao:    common.HexToHash("0x03"),
+		BlockNumber:   10,
+		GasLimit:      30000000,
+		Timestamp:     1000,
+		ExtraData:     []byte{},
+		BaseFeePerGas: (*hexutil.Big)(big.NewInt(7)),
+		BlockHash:     common.HexToHash("0x04"),
+		Transactions:  []hexutil.Bytes{{0x01}},
+	}
+	enc, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{`"blockNumber":"0xa"`, `"gasLimit":"0x1c9c380"`, `"baseFeePerGas":"0x7"`, `"transactions":["0x01"]`} {
+		if !strings.Contains(string(enc), field) {
+			t.Errorf("Encoded payload %s does not contain %s", enc, field)
+		}
+	}
+	decoded := &ExecutionPayload{}
+	if err := json.Unmarshal(enc, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(payload, decoded) {
+		t.Errorf("ExecutionPayload decoded to %v, wanted %v", decoded, payload)
+	}
+}
@@ -3,18 +3,14 @@ package apimiddleware
 import (
 	"bytes"
 	"encoding/base64"
-	"encoding/json"
-	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
 
-	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/v1/events"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
-	"github.com/r3labs/sse"
 )
 
 type sszConfig struct {
@@ -158,119 +154,13 @@ func writeSSZResponseHeaderAndBody(grpcResp *http.Response, w http.ResponseWrite
 	return nil
 }
 
-func handleEvents(m *gateway.ApiProxyMiddleware, _ gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
-	sseClient := sse.NewClient("http://" + m.GatewayAddress + req.URL.RequestURI())
-	eventChan := make(chan *sse.Event)
-
-	// We use grpc-gateway as the server side of events, not the sse library.
-	// Because of this subscribing to streams doesn't work as intended, resulting in each event being handled by all subscriptions.
-	// To handle events properly, we subscribe just once using a placeholder value ('events') and handle all topics inside this subscription.
-	if err := sseClient.SubscribeChan("events", eventChan); err != nil {
-		gateway.WriteError(w, gateway.InternalServerError(err), nil)
-		sseClient.Unsubscribe(eventChan)
-		return
-	}
-
-	errJson := receiveEvents(eventChan, w, req)
-	if errJson != nil {
-		gateway.WriteError(w, errJson, nil)
+// handleEvents serves the event stream natively through the event broker. Without an event broker,
+// the event stream of the gateway is relayed instead.
+func (f *BeaconEndpointFactory) handleEvents(m *gateway.ApiProxyMiddleware, _ gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
+	if f.EventBroker == nil {
+		proxyEvents(m, w, req)
+		return true
 	}
-
-	sseClient.Unsubscribe(eventChan)
+	f.EventBroker.ServeHTTP(w, req)
 	return true
 }
-
-func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http.Request) gateway.ErrorJson {
-	for {
-		select {
-		case msg := <-eventChan:
-			var data interface{}
-
-			switch strings.TrimSpace(string(msg.Event)) {
-			case events.HeadTopic:
-				data = &eventHeadJson{}
-			case events.BlockTopic:
-				data = &receivedBlockDataJson{}
-			case events.AttestationTopic:
-				data = &attestationJson{}
-
-				// Data received in the event does not fit the expected event stream output.
-				// We extract the underlying attestation from event data
-				// and assign the attestation back to event data for further processing.
-				eventData := &aggregatedAttReceivedDataJson{}
-				if err := json.Unmarshal(msg.Data, eventData); err != nil {
-					return gateway.InternalServerError(err)
-				}
-				attData, err := json.Marshal(eventData.Aggregate)
-				if err != nil {
-					return gateway.InternalServerError(err)
-				}
-				msg.Data = attData
-			case events.VoluntaryExitTopic:
-				data = &signedVoluntaryExitJson{}
-			case events.FinalizedCheckpointTopic:
-				data = &eventFinalizedCheckpointJson{}
-			case events.ChainReorgTopic:
-				data = &eventChainReorgJson{}
-			case "error":
-				data = &eventErrorJson{}
-			default:
-				return &gateway.DefaultErrorJson{
-					Message: fmt.Sprintf("Event type '%s' not supported", strings.TrimSpace(string(msg.Event))),
-					Code:    http.StatusInternalServerError,
-				}
-			}
-
-			if errJson := writeEvent(msg, w, data); errJson != nil {
-				return errJson
-			}
-			if errJson := flushEvent(w); errJson != nil {
-				return errJson
-			}
-		case <-req.Context().Done():
-			return nil
-		}
-	}
-}
-
-func writeEvent(msg *sse.Event, w http.ResponseWriter, data interface{}) gateway.ErrorJson {
-	if err := json.Unmarshal(msg.Data, data); err != nil {
-		return gateway.InternalServerError(err)
-	}
-	if errJson := gateway.ProcessMiddlewareResponseFields(data); errJson != nil {
-		return errJson
-	}
-	dataJson, errJson := gateway.SerializeMiddlewareResponseIntoJson(data)
-	if errJson != nil {
-		return errJson
-	}
-
-	w.Header().Set("Content-Type", "text/event-stream")
-
-	if _, err := w.Write([]byte("event: ")); err != nil {
-		return gateway.InternalServerError(err)
-	}
-	if _, err := w.Write(msg.Event); err != nil {
-		return gateway.InternalServerError(err)
-	}
-	if _, err := w.Write([]byte("\ndata: ")); err != nil {
-		return gateway.InternalServerError(err)
-	}
-	if _, err := w.Write(dataJson); err != nil {
-		return gateway.InternalServerError(err)
-	}
-	if _, err := w.Write([]byte("\n\n")); err != nil {
-		return gateway.InternalServerError(err)
-	}
-
-	return nil
-}
-
-func flushEvent(w http.ResponseWriter) gateway.ErrorJson {
-	flusher, ok := w.(http.Flusher)
-	if !ok {
-		return &gateway.DefaultErrorJson{Message: fmt.Sprintf("Flush not supported in %T", w), Code: http.StatusInternalServerError}
-	}
-	flusher.Flush()
-	return nil
-}
@@ -2,20 +2,15 @@ package apimiddleware
 
 import (
 	"bytes"
-	"context"
-	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
-	"time"
 
-	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/v1/events"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
-	"github.com/r3labs/sse"
 )
 
 func TestSSZRequested(t *testing.T) {
@@ -141,74 +136,3 @@ func TestWriteSSZResponseHeaderAndBody(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
 	})
 }
-
-func TestReceiveEvents(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	ch := make(chan *sse.Event)
-	w := httptest.NewRecorder()
-	w.Body = &bytes.Buffer{}
-	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
-	req = req.WithContext(ctx)
-
-	go func() {
-		base64Val := "Zm9v"
-		data := &eventFinalizedCheckpointJson{
-			Block: base64Val,
-			State: base64Val,
-			Epoch: "1",
-		}
-		bData, err := json.Marshal(data)
-		require.NoError(t, err)
-		msg := &sse.Event{
-			Data:  bData,
-			Event: []byte(events.FinalizedCheckpointTopic),
-		}
-		ch <- msg
-		time.Sleep(time.Second)
-		cancel()
-	}()
-
-	errJson := receiveEvents(ch, w, req)
-	assert.Equal(t, true, errJson == nil)
-}
-
-func TestReceiveEvents_EventNotSupported(t *testing.T) {
-	ch := make(chan *sse.Event)
-	w := httptest.NewRecorder()
-	w.Body = &bytes.Buffer{}
-	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
-
-	go func() {
-		msg := &sse.Event{
-			Data:  []byte("foo"),
-			Event: []byte("not_supported"),
-		}
-		ch <- msg
-	}()
-
-	errJson := receiveEvents(ch, w, req)
-	require.NotNil(t, errJson)
-	assert.Equal(t, "Event type 'not_supported' not supported", errJson.Msg())
-}
-
-func TestWriteEvent(t *testing.T) {
-	base64Val := "Zm9v"
-	data := &eventFinalizedCheckpointJson{
-		Block: base64Val,
-		State: base64Val,
-		Epoch: "1",
-	}
-	bData, err := json.Marshal(data)
-	require.NoError(t, err)
-	msg := &sse.Event{
-		Data:  bData,
-		Event: []byte("test_event"),
-	}
-	w := httptest.NewRecorder()
-	w.Body = &bytes.Buffer{}
-
-	errJson := writeEvent(msg, w, &eventFinalizedCheckpointJson{})
-	require.Equal(t, true, errJson == nil)
-	written := w.Body.String()
-	assert.Equal(t, "event: test_event\ndata: {\"block\":\"0x666f6f\",\"state\":\"0x666f6f\",\"epoch\":\"1\"}\n\n", written)
-}
@@ -76,6 +76,89 @@ func TestWrapValidatorIndicesArray(t *testing.T) {
 	})
 }
 
+func TestWrapSignedAggregateAndProofArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := gateway.Endpoint{
+			PostRequest: &submitAggregateAndProofsRequestJson{},
+		}
+		unwrappedAggs := []*signedAggregateAttestationAndProofJson{{Signature: "sig"}}
+		unwrappedAggsJson, err := json.Marshal(unwrappedAggs)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedAggsJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		errJson := wrapSignedAggregateAndProofArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		wrappedAggs := &submitAggregateAndProofsRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedAggs))
+		require.Equal(t, 1, len(wrappedAggs.Data), "wrong number of wrapped items")
+		assert.Equal(t, "sig", wrappedAggs.Data[0].Signature)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := gateway.Endpoint{
+			PostRequest: &submitAggregateAndProofsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		errJson := wrapSignedAggregateAndProofArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode signed aggregate and proof array"))
+		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	})
+}
+
+func TestWrapBeaconCommitteeSubscriptionsArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := gateway.Endpoint{
+			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+		}
+		unwrappedSubs := []*beaconCommitteeSubscribeJson{{
+			ValidatorIndex:   "1",
+			CommitteeIndex:   "1",
+			CommitteesAtSlot: "1",
+			Slot:             "1",
+			IsAggregator:     true,
+		}}
+		unwrappedSubsJson, err := json.Marshal(unwrappedSubs)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedSubsJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		errJson := wrapBeaconCommitteeSubscriptionsArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		wrappedSubs := &submitBeaconCommitteeSubscriptionsRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedSubs))
+		require.Equal(t, 1, len(wrappedSubs.Data), "wrong number of wrapped items")
+		assert.Equal(t, "1", wrappedSubs.Data[0].ValidatorIndex)
+		assert.Equal(t, true, wrappedSubs.Data[0].IsAggregator)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := gateway.Endpoint{
+			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		errJson := wrapBeaconCommitteeSubscriptionsArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode beacon committee subscriptions array"))
+		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	})
+}
+
 func TestPrepareGraffiti(t *testing.T) {
 	endpoint := gateway.Endpoint{
 		PostRequest: &beaconBlockContainerJson{
@@ -7,6 +7,8 @@ import (
 
 // BeaconEndpointFactory creates endpoints used for running beacon chain API calls through the API Middleware.
 type BeaconEndpointFactory struct {
+	// EventBroker serves the event stream. When it is nil, the event stream of the gateway is relayed instead.
+	EventBroker *EventBroker
 }
 
 func (f *BeaconEndpointFactory) IsNil() bool {
@@ -50,7 +52,10 @@ func (f *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/validator/duties/attester/{epoch}",
 		"/eth/v1/validator/duties/proposer/{epoch}",
 		"/eth/v1/validator/blocks/{slot}",
+		"/eth/v1/validator/attestation_data",
 		"/eth/v1/validator/aggregate_attestation",
+		"/eth/v1/validator/aggregate_and_proofs",
+		"/eth/v1/validator/beacon_committee_subscriptions",
 	}
 }
 
@@ -234,7 +239,7 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 		endpoint = gateway.Endpoint{
 			Err: &gateway.DefaultErrorJson{},
 			Hooks: gateway.HookCollection{
-				CustomHandlers: []gateway.CustomHandler{handleEvents},
+				CustomHandlers: []gateway.CustomHandler{f.handleEvents},
 			},
 		}
 	case "/eth/v1/validator/duties/attester/{epoch}":
@@ -259,12 +264,34 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 			RequestURLLiterals: []string{"slot"},
 			RequestQueryParams: []gateway.QueryParam{{Name: "randao_reveal", Hex: true}, {Name: "graffiti", Hex: true}},
 		}
+	case "/eth/v1/validator/attestation_data":
+		endpoint = gateway.Endpoint{
+			GetResponse:        &produceAttestationDataResponseJson{},
+			RequestQueryParams: []gateway.QueryParam{{Name: "slot"}, {Name: "committee_index"}},
+			Err:                &gateway.DefaultErrorJson{},
+		}
 	case "/eth/v1/validator/aggregate_attestation":
 		endpoint = gateway.Endpoint{
 			GetResponse:        &aggregateAttestationResponseJson{},
 			RequestQueryParams: []gateway.QueryParam{{Name: "attestation_data_root", Hex: true}, {Name: "slot"}},
 			Err:                &gateway.DefaultErrorJson{},
 		}
+	case "/eth/v1/validator/aggregate_and_proofs":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitAggregateAndProofsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapSignedAggregateAndProofArray},
+			},
+		}
+	case "/eth/v1/validator/beacon_committee_subscriptions":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapBeaconCommitteeSubscriptionsArray},
+			},
+		}
 	default:
 		return nil, errors.New("invalid path")
 	}
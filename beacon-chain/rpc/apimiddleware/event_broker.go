@@ -0,0 +1,450 @@
+package apimiddleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/v1/events"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/gateway"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ shared.Service = (*EventBroker)(nil)
+
+const (
+	defaultKeepAliveInterval = 15 * time.Second
+	defaultReplayBufferSize  = 1024
+	defaultResumeWindow      = time.Minute
+	defaultClientBufferSize  = 256
+	errorEventName           = "error"
+)
+
+// EventBrokerConfig defines the feeds the event broker reads from and the parameters of the event stream.
+type EventBrokerConfig struct {
+	StateNotifier     statefeed.Notifier
+	BlockNotifier     blockfeed.Notifier
+	OperationNotifier operation.Notifier
+	// ProposalPreparationSubscriber is notified of clients subscribing to proposal preparation events.
+	ProposalPreparationSubscriber blockchain.ProposalPreparationSubscriber
+	// KeepAliveInterval is the interval at which a comment is sent to idle clients.
+	KeepAliveInterval time.Duration
+	// ReplayBufferSize is the number of most recent events of each topic kept for clients resuming with Last-Event-ID.
+	ReplayBufferSize int
+	// ResumeWindow is how long events of a topic keep being buffered after its last client disconnected.
+	ResumeWindow time.Duration
+	// ClientBufferSize is the number of events buffered for a client before it is disconnected as too slow.
+	ClientBufferSize int
+}
+
+// EventBroker serves the /eth/v1/events stream natively, reading events directly from the
+// beacon node's feeds and writing them to clients as server-sent events. Every event is assigned
+// an increasing ID prefixed with the ID of the broker's run, and the most recent events of each topic
+// are kept in a bounded buffer, so that clients reconnecting with a Last-Event-ID header are sent the
+// events they missed. Events of topics nobody subscribed to recently are not converted nor buffered.
+type EventBroker struct {
+	cfg         *EventBrokerConfig
+	ctx         context.Context
+	cancel      context.CancelFunc
+	runID       string
+	lock        sync.RWMutex
+	lastID      uint64
+	replay      map[string][]*streamEvent
+	lastActive  map[string]time.Time
+	subscribers map[*eventSubscriber]bool
+	stateChan   chan *feed.Event
+	blockChan   chan *feed.Event
+	opsChan     chan *feed.Event
+	stateSub    event.Subscription
+	blockSub    event.Subscription
+	opsSub      event.Subscription
+}
+
+// streamEvent is an event which has already been converted into the format of the event stream.
+type streamEvent struct {
+	id    uint64
+	topic string
+	data  []byte
+}
+
+// eventSubscriber is a single client connected to the event stream.
+type eventSubscriber struct {
+	topics map[string]bool
+	events chan *streamEvent
+	// lagging is closed when the client falls too far behind and must be disconnected.
+	lagging chan struct{}
+	// release removes the client's registration for proposal preparation events, if any.
+	release func()
+}
+
+// NewEventBroker creates a new event broker from the given configuration.
+func NewEventBroker(ctx context.Context, cfg *EventBrokerConfig) *EventBroker {
+	if cfg.KeepAliveInterval == 0 {
+		cfg.KeepAliveInterval = defaultKeepAliveInterval
+	}
+	if cfg.ReplayBufferSize == 0 {
+		cfg.ReplayBufferSize = defaultReplayBufferSize
+	}
+	if cfg.ResumeWindow == 0 {
+		cfg.ResumeWindow = defaultResumeWindow
+	}
+	if cfg.ClientBufferSize == 0 {
+		cfg.ClientBufferSize = defaultClientBufferSize
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &EventBroker{
+		cfg:         cfg,
+		ctx:         ctx,
+		cancel:      cancel,
+		runID:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		replay:      make(map[string][]*streamEvent),
+		lastActive:  make(map[string]time.Time),
+		subscribers: make(map[*eventSubscriber]bool),
+		stateChan:   make(chan *feed.Event, 1),
+		blockChan:   make(chan *feed.Event, 1),
+		opsChan:     make(chan *feed.Event, 1),
+	}
+}
+
+// Start subscribes to the beacon node's feeds and begins relaying their events to clients.
+func (b *EventBroker) Start() {
+	b.stateSub = b.cfg.StateNotifier.StateFeed().Subscribe(b.stateChan)
+	b.blockSub = b.cfg.BlockNotifier.BlockFeed().Subscribe(b.blockChan)
+	b.opsSub = b.cfg.OperationNotifier.OperationFeed().Subscribe(b.opsChan)
+	go b.run()
+}
+
+// Stop the event broker.
+func (b *EventBroker) Stop() error {
+	b.cancel()
+	return nil
+}
+
+// Status of the event broker.
+func (b *EventBroker) Status() error {
+	return nil
+}
+
+func (b *EventBroker) run() {
+	defer b.stateSub.Unsubscribe()
+	defer b.blockSub.Unsubscribe()
+	defer b.opsSub.Unsubscribe()
+	for {
+		var topic string
+		var data proto.Message
+		var err error
+		select {
+		case e := <-b.stateChan:
+			topic, data, err = events.StateEventData(e)
+		case e := <-b.blockChan:
+			topic, data, err = events.BlockEventData(e)
+		case e := <-b.opsChan:
+			topic, data, err = events.OperationEventData(e)
+		case <-b.ctx.Done():
+			return
+		}
+		if err != nil {
+			log.WithError(err).Error("Could not convert event for the event stream")
+			continue
+		}
+		if data == nil || !b.hasInterest(topic) {
+			continue
+		}
+		if err := b.publish(topic, data); err != nil {
+			log.WithError(err).WithField("topic", topic).Error("Could not publish event")
+		}
+	}
+}
+
+// hasInterest returns true if a client is subscribed to the topic, or was until recently
+// and may still resume its stream.
+func (b *EventBroker) hasInterest(topic string) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	for sub := range b.subscribers {
+		if sub.topics[topic] {
+			return true
+		}
+	}
+	lastActive, ok := b.lastActive[topic]
+	return ok && time.Since(lastActive) < b.cfg.ResumeWindow
+}
+
+// publish converts the event data into the JSON representation defined in the Ethereum consensus API,
+// stores it in the replay buffer and sends it to every client subscribed to the topic.
+func (b *EventBroker) publish(topic string, data proto.Message) error {
+	dataJson, err := eventDataToJson(topic, data)
+	if err != nil {
+		return err
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.lastID++
+	e := &streamEvent{
+		id:    b.lastID,
+		topic: topic,
+		data:  dataJson,
+	}
+	replay := b.replay[topic]
+	if len(replay) == b.cfg.ReplayBufferSize {
+		replay = replay[1:]
+	}
+	b.replay[topic] = append(replay, e)
+	for sub := range b.subscribers {
+		if !sub.topics[topic] {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+			// The client is not reading events fast enough. We disconnect it
+			// rather than block every other client, and it can resume with Last-Event-ID.
+			delete(b.subscribers, sub)
+			close(sub.lagging)
+		}
+	}
+	return nil
+}
+
+// subscribe registers a new client for the given topics. It returns the buffered events
+// following lastEventID which the client has not received yet, ordered by ID.
+func (b *EventBroker) subscribe(topics map[string]bool, lastEventID uint64, resume bool) (*eventSubscriber, []*streamEvent) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	sub := &eventSubscriber{
+		topics:  topics,
+		events:  make(chan *streamEvent, b.cfg.ClientBufferSize),
+		lagging: make(chan struct{}),
+	}
+	if topics[events.ProposalPreparationTopic] && b.cfg.ProposalPreparationSubscriber != nil {
+		sub.release = b.cfg.ProposalPreparationSubscriber.SubscribeProposalPreparation()
+	}
+	b.subscribers[sub] = true
+	if !resume {
+		return sub, nil
+	}
+	missed := make([]*streamEvent, 0)
+	for topic := range topics {
+		for _, e := range b.replay[topic] {
+			if e.id > lastEventID {
+				missed = append(missed, e)
+			}
+		}
+	}
+	sort.Slice(missed, func(i, j int) bool {
+		return missed[i].id < missed[j].id
+	})
+	return sub, missed
+}
+
+func (b *EventBroker) unsubscribe(sub *eventSubscriber) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.subscribers, sub)
+	if sub.release != nil {
+		sub.release()
+	}
+	now := time.Now()
+	for topic := range sub.topics {
+		b.lastActive[topic] = now
+	}
+}
+
+// parseEventID parses an event ID sent back by a client in the Last-Event-ID header.
+// The returned boolean is false for IDs which were issued by a previous run of the broker,
+// as the events they refer to are gone.
+func (b *EventBroker) parseEventID(id string) (uint64, bool, error) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 2 {
+		return 0, false, fmt.Errorf("event ID %s is not in the <run>-<sequence> format", id)
+	}
+	seq, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return seq, parts[0] == b.runID, nil
+}
+
+// ServeHTTP streams the events of the topics requested in the "topics" query parameter to the client.
+// Topics which are not supported are reported to the client through error events.
+func (b *EventBroker) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		gateway.WriteError(w, &gateway.DefaultErrorJson{
+			Message: fmt.Sprintf("Flush not supported in %T", w),
+			Code:    http.StatusInternalServerError,
+		}, nil)
+		return
+	}
+
+	requestedTopics := make([]string, 0)
+	for _, t := range req.URL.Query()["topics"] {
+		for _, topic := range strings.Split(t, ",") {
+			if topic = strings.TrimSpace(topic); topic != "" {
+				requestedTopics = append(requestedTopics, topic)
+			}
+		}
+	}
+	if len(requestedTopics) == 0 {
+		gateway.WriteError(w, &gateway.DefaultErrorJson{
+			Message: "No topics specified to subscribe to",
+			Code:    http.StatusBadRequest,
+		}, nil)
+		return
+	}
+
+	var lastEventID uint64
+	resume := false
+	if header := req.Header.Get("Last-Event-ID"); header != "" {
+		var err error
+		lastEventID, resume, err = b.parseEventID(header)
+		if err != nil {
+			gateway.WriteError(w, &gateway.DefaultErrorJson{
+				Message: "Invalid Last-Event-ID header: " + err.Error(),
+				Code:    http.StatusBadRequest,
+			}, nil)
+			return
+		}
+		if !resume {
+			log.WithField("lastEventID", header).Debug("Not replaying events of a previous run to event stream client")
+		}
+	}
+
+	topics := make(map[string]bool)
+	unsupportedTopics := make([]string, 0)
+	for _, topic := range requestedTopics {
+		if events.IsTopicSupported(topic) {
+			topics[topic] = true
+		} else {
+			unsupportedTopics = append(unsupportedTopics, topic)
+		}
+	}
+
+	sub, missed := b.subscribe(topics, lastEventID, resume)
+	defer b.unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	for _, topic := range unsupportedTopics {
+		if err := writeErrorEvent(w, http.StatusBadRequest, fmt.Sprintf("Topic %s not supported", topic)); err != nil {
+			return
+		}
+	}
+	for _, e := range missed {
+		if err := b.writeStreamEvent(w, e); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	keepAliveTicker := time.NewTicker(b.cfg.KeepAliveInterval)
+	defer keepAliveTicker.Stop()
+	for {
+		select {
+		case e := <-sub.events:
+			if err := b.writeStreamEvent(w, e); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-keepAliveTicker.C:
+			if _, err := w.Write([]byte(": keep-alive\n\n")); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-sub.lagging:
+			log.Debug("Disconnecting event stream client which fell behind")
+			return
+		case <-req.Context().Done():
+			return
+		case <-b.ctx.Done():
+			return
+		}
+	}
+}
+
+// eventDataToJson converts event data into the JSON representation used by the event stream,
+// going through the same data structures as the rest of the API middleware.
+func eventDataToJson(topic string, data proto.Message) ([]byte, error) {
+	// Aggregated attestations are sent as the underlying attestation.
+	if aggregate, ok := data.(*ethpb.AggregateAttestationAndProof); ok {
+		data = aggregate.Aggregate
+	}
+	var container interface{}
+	switch topic {
+	case events.HeadTopic:
+		container = &eventHeadJson{}
+	case events.BlockTopic:
+		container = &receivedBlockDataJson{}
+	case events.AttestationTopic:
+		container = &attestationJson{}
+	case events.VoluntaryExitTopic:
+		container = &signedVoluntaryExitJson{}
+	case events.FinalizedCheckpointTopic:
+		container = &eventFinalizedCheckpointJson{}
+	case events.ChainReorgTopic:
+		container = &eventChainReorgJson{}
+	case events.ContributionAndProofTopic:
+		container = &signedContributionAndProofJson{}
+	case events.BlockGossipTopic:
+		container = &eventBlockGossipJson{}
+	case events.ProposalPreparationTopic:
+		container = &eventProposalPreparationJson{}
+	default:
+		return nil, fmt.Errorf("event topic %s not supported", topic)
+	}
+	protoJson, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal event data")
+	}
+	if err := json.Unmarshal(protoJson, container); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal event data")
+	}
+	if errJson := gateway.ProcessMiddlewareResponseFields(container); errJson != nil {
+		return nil, errors.New(errJson.Msg())
+	}
+	dataJson, errJson := gateway.SerializeMiddlewareResponseIntoJson(container)
+	if errJson != nil {
+		return nil, errors.New(errJson.Msg())
+	}
+	return dataJson, nil
+}
+
+func (b *EventBroker) writeStreamEvent(w http.ResponseWriter, e *streamEvent) error {
+	_, err := fmt.Fprintf(w, "id: %s-%d\nevent: %s\ndata: %s\n\n", b.runID, e.id, e.topic, e.data)
+	return err
+}
+
+func writeErrorEvent(w http.ResponseWriter, code int, message string) error {
+	data, err := json.Marshal(&eventErrorJson{
+		StatusCode: code,
+		Message:    message,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", errorEventName, data)
+	return err
+}
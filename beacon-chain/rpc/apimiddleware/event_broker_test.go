@@ -0,0 +1,376 @@
+package apimiddleware
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gwpb "github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
+	types "github.com/prysmaticlabs/eth2-types"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/v1/events"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/gateway"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestEventBroker_NoTopics(t *testing.T) {
+	_, _, srv := setupEventBroker(t, &EventBrokerConfig{})
+	resp, err := http.Get(srv.URL + "/eth/v1/events")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestEventBroker_StreamsEvents(t *testing.T) {
+	broker, stateNotifier, srv := setupEventBroker(t, &EventBrokerConfig{})
+	reader, cancel := connectToEventStream(t, srv, "head,finalized_checkpoint", "")
+	defer cancel()
+
+	stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &ethpb.EventHead{
+			Slot:                      8,
+			Block:                     []byte("foo"),
+			State:                     []byte("bar"),
+			EpochTransition:           true,
+			PreviousDutyDependentRoot: []byte("foo"),
+			CurrentDutyDependentRoot:  []byte("bar"),
+		},
+	})
+	stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &ethpb.EventFinalizedCheckpoint{
+			Block: []byte("foo"),
+			State: []byte("bar"),
+			Epoch: 2,
+		},
+	})
+
+	assert.DeepEqual(t, []string{
+		"id: " + broker.runID + "-1",
+		"event: head",
+		`data: {"slot":"8","block":"0x666f6f","state":"0x626172","epoch_transition":true,` +
+			`"previous_duty_dependent_root":"0x666f6f","current_duty_dependent_root":"0x626172"}`,
+	}, readEvent(t, reader))
+	assert.DeepEqual(t, []string{
+		"id: " + broker.runID + "-2",
+		"event: finalized_checkpoint",
+		`data: {"block":"0x666f6f","state":"0x626172","epoch":"2"}`,
+	}, readEvent(t, reader))
+}
+
+func TestEventBroker_StreamsProposalPreparation(t *testing.T) {
+	broker, stateNotifier, srv := setupEventBroker(t, &EventBrokerConfig{})
+	reader, cancel := connectToEventStream(t, srv, "proposal_preparation", "")
+	defer cancel()
+
+	stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.ProposalPreparation,
+		Data: &statefeed.ProposalPreparationData{
+			ProposalSlot:    9,
+			ProposerIndex:   4,
+			ParentBlockRoot: [32]byte{'a'},
+			Timestamp:       1606824131,
+		},
+	})
+
+	assert.DeepEqual(t, []string{
+		"id: " + broker.runID + "-1",
+		"event: proposal_preparation",
+		`data: {"proposal_slot":"9","proposer_index":"4",` +
+			`"parent_block_root":"0x6100000000000000000000000000000000000000000000000000000000000000","timestamp":"1606824131"}`,
+	}, readEvent(t, reader))
+}
+
+func TestEventBroker_ProposalPreparationSubscription(t *testing.T) {
+	chainService := &mockChain.ChainService{}
+	_, _, srv := setupEventBroker(t, &EventBrokerConfig{ProposalPreparationSubscriber: chainService})
+
+	_, cancelHead := connectToEventStream(t, srv, events.HeadTopic, "")
+	defer cancelHead()
+	assert.Equal(t, int32(0), atomic.LoadInt32(&chainService.ProposalPreparationSubs))
+
+	_, cancel := connectToEventStream(t, srv, events.ProposalPreparationTopic, "")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&chainService.ProposalPreparationSubs))
+	cancel()
+	for i := 0; i < 100 && atomic.LoadInt32(&chainService.ProposalPreparationSubs) != 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&chainService.ProposalPreparationSubs))
+}
+
+func TestEventDataToJson_Contribution(t *testing.T) {
+	dataJson, err := eventDataToJson(events.ContributionAndProofTopic, &ethpb.SignedContributionAndProof{
+		Message: &ethpb.ContributionAndProof{
+			AggregatorIndex: 3,
+			Contribution: &ethpb.SyncCommitteeContribution{
+				Slot:              1,
+				BeaconBlockRoot:   []byte("foo"),
+				SubcommitteeIndex: 2,
+				AggregationBits:   []byte{0x01},
+				Signature:         []byte("bar"),
+			},
+			SelectionProof: []byte("foo"),
+		},
+		Signature: []byte("bar"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"message":{"aggregator_index":"3","contribution":{"slot":"1","beacon_block_root":"0x666f6f",`+
+		`"subcommittee_index":"2","aggregation_bits":"0x01","signature":"0x626172"},"selection_proof":"0x666f6f"},`+
+		`"signature":"0x626172"}`, string(dataJson))
+}
+
+func TestEventDataToJson_BlockGossip(t *testing.T) {
+	dataJson, err := eventDataToJson(events.BlockGossipTopic, &ethpb.EventBlock{
+		Slot:  5,
+		Block: []byte("foo"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"slot":"5","block":"0x666f6f"}`, string(dataJson))
+}
+
+func TestEventBroker_UnsupportedTopic(t *testing.T) {
+	broker, stateNotifier, srv := setupEventBroker(t, &EventBrokerConfig{})
+	reader, cancel := connectToEventStream(t, srv, "foo,finalized_checkpoint", "")
+	defer cancel()
+
+	assert.DeepEqual(t, []string{
+		"event: error",
+		`data: {"status_code":400,"message":"Topic foo not supported"}`,
+	}, readEvent(t, reader))
+
+	// The connection stays open for the supported topics.
+	sendFinalizedCheckpoint(stateNotifier, 1)
+	assert.DeepEqual(t, []string{
+		"id: " + broker.runID + "-1",
+		"event: finalized_checkpoint",
+		`data: {"block":"0x666f6f","state":"0x626172","epoch":"1"}`,
+	}, readEvent(t, reader))
+}
+
+func TestEventBroker_ResumesWithLastEventID(t *testing.T) {
+	broker, stateNotifier, srv := setupEventBroker(t, &EventBrokerConfig{})
+	reader, cancel := connectToEventStream(t, srv, events.FinalizedCheckpointTopic, "")
+	sendFinalizedCheckpoint(stateNotifier, 1)
+	require.Equal(t, "id: "+broker.runID+"-1", readEvent(t, reader)[0])
+	cancel()
+
+	// Events published while the client is disconnected are replayed on reconnection.
+	sendFinalizedCheckpoint(stateNotifier, 2)
+	sendFinalizedCheckpoint(stateNotifier, 3)
+	reader, cancel = connectToEventStream(t, srv, events.FinalizedCheckpointTopic, broker.runID+"-1")
+	defer cancel()
+	assert.Equal(t, "id: "+broker.runID+"-2", readEvent(t, reader)[0])
+	assert.Equal(t, "id: "+broker.runID+"-3", readEvent(t, reader)[0])
+
+	// The stream then continues with new events.
+	sendFinalizedCheckpoint(stateNotifier, 4)
+	assert.Equal(t, "id: "+broker.runID+"-4", readEvent(t, reader)[0])
+}
+
+func TestEventBroker_ReplayBufferIsBoundedPerTopic(t *testing.T) {
+	broker, stateNotifier, srv := setupEventBroker(t, &EventBrokerConfig{ReplayBufferSize: 2})
+	_, cancel := connectToEventStream(t, srv, "head,finalized_checkpoint", "")
+	cancel()
+	for i := types.Epoch(1); i <= 3; i++ {
+		sendFinalizedCheckpoint(stateNotifier, i)
+	}
+	stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &ethpb.EventHead{Slot: 8},
+	})
+	waitForEvents(t, broker, 4)
+
+	// The head event does not evict the finalized checkpoint events, as each topic has its own buffer.
+	reader, cancel := connectToEventStream(t, srv, "head,finalized_checkpoint", broker.runID+"-0")
+	defer cancel()
+	assert.Equal(t, "id: "+broker.runID+"-2", readEvent(t, reader)[0])
+	assert.Equal(t, "id: "+broker.runID+"-3", readEvent(t, reader)[0])
+	assert.Equal(t, "id: "+broker.runID+"-4", readEvent(t, reader)[0])
+}
+
+func TestEventBroker_HasInterest(t *testing.T) {
+	broker, _, srv := setupEventBroker(t, &EventBrokerConfig{ResumeWindow: 50 * time.Millisecond})
+	// Events are neither converted nor buffered before anyone subscribes.
+	assert.Equal(t, false, broker.hasInterest(events.HeadTopic))
+
+	_, cancel := connectToEventStream(t, srv, events.HeadTopic, "")
+	assert.Equal(t, true, broker.hasInterest(events.HeadTopic))
+	assert.Equal(t, false, broker.hasInterest(events.FinalizedCheckpointTopic))
+	cancel()
+
+	// Events keep being buffered for a while so that the client can resume.
+	sub := &eventSubscriber{topics: map[string]bool{events.HeadTopic: true}}
+	broker.unsubscribe(sub)
+	assert.Equal(t, true, broker.hasInterest(events.HeadTopic))
+	time.Sleep(100 * time.Millisecond)
+	broker.lock.RLock()
+	subscribers := len(broker.subscribers)
+	broker.lock.RUnlock()
+	require.Equal(t, 0, subscribers)
+	assert.Equal(t, false, broker.hasInterest(events.HeadTopic))
+}
+
+func TestEventBroker_IgnoresEventIDOfPreviousRun(t *testing.T) {
+	broker, stateNotifier, srv := setupEventBroker(t, &EventBrokerConfig{})
+	_, cancel := connectToEventStream(t, srv, events.FinalizedCheckpointTopic, "")
+	cancel()
+	sendFinalizedCheckpoint(stateNotifier, 1)
+	waitForEvents(t, broker, 1)
+
+	reader, cancel := connectToEventStream(t, srv, events.FinalizedCheckpointTopic, "previousrun-0")
+	defer cancel()
+	// Nothing is replayed, only new events are streamed.
+	sendFinalizedCheckpoint(stateNotifier, 2)
+	assert.Equal(t, "id: "+broker.runID+"-2", readEvent(t, reader)[0])
+}
+
+func TestEventBroker_InvalidEventID(t *testing.T) {
+	_, _, srv := setupEventBroker(t, &EventBrokerConfig{})
+	req, err := http.NewRequest("GET", srv.URL+"/eth/v1/events?topics=head", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestEventBroker_KeepAlive(t *testing.T) {
+	_, _, srv := setupEventBroker(t, &EventBrokerConfig{KeepAliveInterval: 10 * time.Millisecond})
+	reader, cancel := connectToEventStream(t, srv, events.HeadTopic, "")
+	defer cancel()
+
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, ": keep-alive\n", line)
+}
+
+func TestHandleEvents_NoEventBroker(t *testing.T) {
+	checkpoint, err := anypb.New(&ethpb.EventFinalizedCheckpoint{
+		Block: []byte("foo"),
+		State: []byte("bar"),
+		Epoch: 2,
+	})
+	require.NoError(t, err)
+	checkpointJson, err := protojson.Marshal(checkpoint)
+	require.NoError(t, err)
+	streamErr, err := anypb.New(&gwpb.EventStreamError{StatusCode: 500, Message: "foo"})
+	require.NoError(t, err)
+	streamErrJson, err := protojson.Marshal(streamErr)
+	require.NoError(t, err)
+	gatewaySrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/events?topics=finalized_checkpoint", r.URL.RequestURI())
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, err := fmt.Fprintf(w, "event: finalized_checkpoint \ndata: %s \n\nevent: error \ndata: %s \n\n", checkpointJson, streamErrJson)
+		require.NoError(t, err)
+	}))
+	defer gatewaySrv.Close()
+
+	f := &BeaconEndpointFactory{}
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "http://foo.example/eth/v1/events?topics=finalized_checkpoint", nil)
+	m := &gateway.ApiProxyMiddleware{GatewayAddress: strings.TrimPrefix(gatewaySrv.URL, "http://")}
+	handled := f.handleEvents(m, gateway.Endpoint{}, w, req)
+	assert.Equal(t, true, handled)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "event: finalized_checkpoint\n"+
+		`data: {"block":"0x666f6f","state":"0x626172","epoch":"2"}`+"\n\n"+
+		"event: error\n"+
+		`data: {"status_code":500,"message":"foo"}`+"\n\n", w.Body.String())
+}
+
+func setupEventBroker(t *testing.T, cfg *EventBrokerConfig) (*EventBroker, *mockChain.MockStateNotifier, *httptest.Server) {
+	stateNotifier := &mockChain.MockStateNotifier{}
+	cfg.StateNotifier = stateNotifier
+	cfg.BlockNotifier = &mockChain.MockBlockNotifier{}
+	cfg.OperationNotifier = &mockChain.MockOperationNotifier{}
+	broker := NewEventBroker(context.Background(), cfg)
+	broker.Start()
+	srv := httptest.NewServer(broker)
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() {
+		require.NoError(t, broker.Stop())
+	})
+	return broker, stateNotifier, srv
+}
+
+func connectToEventStream(t *testing.T, srv *httptest.Server, topics, lastEventID string) (*bufio.Reader, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, "GET", srv.URL+"/eth/v1/events?topics="+topics, nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	return bufio.NewReader(resp.Body), func() {
+		cancel()
+		require.NoError(t, resp.Body.Close())
+	}
+}
+
+// readEvent reads the lines of the next event in the stream, skipping keep-alive comments.
+func readEvent(t *testing.T, reader *bufio.Reader) []string {
+	lines := make([]string, 0)
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		if line == "" {
+			if len(lines) == 0 {
+				continue
+			}
+			return lines
+		}
+		lines = append(lines, line)
+	}
+}
+
+// waitForEvents waits until the broker published the given number of events.
+func waitForEvents(t *testing.T, broker *EventBroker, count uint64) {
+	for i := 0; i < 100; i++ {
+		broker.lock.RLock()
+		lastID := broker.lastID
+		broker.lock.RUnlock()
+		if lastID >= count {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Broker did not publish %d events", count)
+}
+
+func sendFinalizedCheckpoint(stateNotifier *mockChain.MockStateNotifier, epoch types.Epoch) {
+	stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &ethpb.EventFinalizedCheckpoint{
+			Block: []byte("foo"),
+			State: []byte("bar"),
+			Epoch: epoch,
+		},
+	})
+}
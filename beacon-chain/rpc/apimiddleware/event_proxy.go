@@ -0,0 +1,100 @@
+package apimiddleware
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gwpb "github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/gateway"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// proxyEvents relays the event stream served by the gRPC gateway, for API middlewares which run
+// without access to the beacon node's feeds, such as the standalone gateway. The event data is converted
+// the same way as in the event broker, but the relayed events carry no IDs and cannot be resumed.
+func proxyEvents(m *gateway.ApiProxyMiddleware, w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		gateway.WriteError(w, &gateway.DefaultErrorJson{
+			Message: fmt.Sprintf("Flush not supported in %T", w),
+			Code:    http.StatusInternalServerError,
+		}, nil)
+		return
+	}
+	gatewayReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, "http://"+m.GatewayAddress+req.URL.RequestURI(), nil)
+	if err != nil {
+		gateway.WriteError(w, gateway.InternalServerErrorWithMessage(err, "could not create request to the gateway"), nil)
+		return
+	}
+	gatewayReq.Header.Set("Accept", "text/event-stream")
+	gatewayResp, err := http.DefaultClient.Do(gatewayReq)
+	if err != nil {
+		gateway.WriteError(w, gateway.InternalServerErrorWithMessage(err, "could not proxy request to the gateway"), nil)
+		return
+	}
+	defer func() {
+		if err := gatewayResp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close event stream response body")
+		}
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	scanner := bufio.NewScanner(gatewayResp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	var topic, data string
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			topic = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		case line == "" && data != "":
+			if err := relayEvent(w, topic, []byte(data)); err != nil {
+				log.WithError(err).WithField("topic", topic).Error("Could not relay event")
+				return
+			}
+			flusher.Flush()
+			topic, data = "", ""
+		}
+	}
+	if err := scanner.Err(); err != nil && req.Context().Err() == nil {
+		log.WithError(err).Debug("Could not read event stream from the gateway")
+	}
+}
+
+// relayEvent decodes the event data sent by the gateway, which is a protobuf Any message, and writes
+// it to the client in the JSON representation defined in the Ethereum consensus API.
+func relayEvent(w http.ResponseWriter, topic string, data []byte) error {
+	msg := &anypb.Any{}
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return errors.Wrap(err, "could not unmarshal event data")
+	}
+	eventData, err := anypb.UnmarshalNew(msg, proto.UnmarshalOptions{})
+	if err != nil {
+		return errors.Wrap(err, "could not decode event data")
+	}
+	if topic == errorEventName {
+		streamErr, ok := eventData.(*gwpb.EventStreamError)
+		if !ok {
+			return fmt.Errorf("wrong error event data type %T", eventData)
+		}
+		return writeErrorEvent(w, int(streamErr.StatusCode), streamErr.Message)
+	}
+	dataJson, err := eventDataToJson(topic, eventData)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", topic, dataJson)
+	return err
+}
@@ -196,11 +196,26 @@ type produceBlockResponseJson struct {
 	Data *beaconBlockJson `json:"data"`
 }
 
+// produceAttestationDataResponseJson is used in /validator/attestation_data API endpoint.
+type produceAttestationDataResponseJson struct {
+	Data *attestationDataJson `json:"data"`
+}
+
 // aggregateAttestationResponseJson is used in /validator/aggregate_attestation API endpoint.
 type aggregateAttestationResponseJson struct {
 	Data *attestationJson `json:"data"`
 }
 
+// submitAggregateAndProofsRequestJson is used in /validator/aggregate_and_proofs API endpoint.
+type submitAggregateAndProofsRequestJson struct {
+	Data []*signedAggregateAttestationAndProofJson `json:"data"`
+}
+
+// submitBeaconCommitteeSubscriptionsRequestJson is used in /validator/beacon_committee_subscriptions API endpoint.
+type submitBeaconCommitteeSubscriptionsRequestJson struct {
+	Data []*beaconCommitteeSubscribeJson `json:"data"`
+}
+
 //----------------
 // Reusable types.
 //----------------
@@ -478,6 +493,28 @@ type proposerDutyJson struct {
 	Slot           string `json:"slot"`
 }
 
+// signedAggregateAttestationAndProofJson is a JSON representation of a signed aggregate attestation and proof.
+type signedAggregateAttestationAndProofJson struct {
+	Message   *aggregateAttestationAndProofJson `json:"message"`
+	Signature string                            `json:"signature" hex:"true"`
+}
+
+// aggregateAttestationAndProofJson is a JSON representation of an aggregate attestation and proof.
+type aggregateAttestationAndProofJson struct {
+	AggregatorIndex string           `json:"aggregator_index"`
+	Aggregate       *attestationJson `json:"aggregate"`
+	SelectionProof  string           `json:"selection_proof" hex:"true"`
+}
+
+// beaconCommitteeSubscribeJson is a JSON representation of a beacon committee subscription.
+type beaconCommitteeSubscribeJson struct {
+	ValidatorIndex   string `json:"validator_index"`
+	CommitteeIndex   string `json:"committee_index"`
+	CommitteesAtSlot string `json:"committees_at_slot"`
+	Slot             string `json:"slot"`
+	IsAggregator     bool   `json:"is_aggregator"`
+}
+
 //----------------
 // SSZ
 // ---------------
@@ -538,12 +575,43 @@ type eventChainReorgJson struct {
 	Slot         string `json:"slot"`
 	Depth        string `json:"depth"`
 	OldHeadBlock string `json:"old_head_block" hex:"true"`
-	NewHeadBlock string `json:"old_head_state" hex:"true"`
-	OldHeadState string `json:"new_head_block" hex:"true"`
+	NewHeadBlock string `json:"new_head_block" hex:"true"`
+	OldHeadState string `json:"old_head_state" hex:"true"`
 	NewHeadState string `json:"new_head_state" hex:"true"`
 	Epoch        string `json:"epoch"`
 }
 
+type signedContributionAndProofJson struct {
+	Message   *contributionAndProofJson `json:"message"`
+	Signature string                    `json:"signature" hex:"true"`
+}
+
+type contributionAndProofJson struct {
+	AggregatorIndex string                         `json:"aggregator_index"`
+	Contribution    *syncCommitteeContributionJson `json:"contribution"`
+	SelectionProof  string                         `json:"selection_proof" hex:"true"`
+}
+
+type syncCommitteeContributionJson struct {
+	Slot              string `json:"slot"`
+	BeaconBlockRoot   string `json:"beacon_block_root" hex:"true"`
+	SubcommitteeIndex string `json:"subcommittee_index"`
+	AggregationBits   string `json:"aggregation_bits" hex:"true"`
+	Signature         string `json:"signature" hex:"true"`
+}
+
+type eventBlockGossipJson struct {
+	Slot  string `json:"slot"`
+	Block string `json:"block" hex:"true"`
+}
+
+type eventProposalPreparationJson struct {
+	ProposalSlot    string `json:"proposal_slot"`
+	ProposerIndex   string `json:"proposer_index"`
+	ParentBlockRoot string `json:"parent_block_root" hex:"true"`
+	Timestamp       string `json:"timestamp"`
+}
+
 // ---------------
 // Error handling.
 // ---------------
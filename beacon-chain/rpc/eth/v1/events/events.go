@@ -28,15 +28,29 @@ const (
 	FinalizedCheckpointTopic = "finalized_checkpoint"
 	// ChainReorgTopic represents a chain reorganization event topic.
 	ChainReorgTopic = "chain_reorg"
+	// ContributionAndProofTopic represents a new sync committee contribution event topic.
+	ContributionAndProofTopic = "contribution_and_proof"
+	// BlockGossipTopic represents a new block which passed gossip validation event topic.
+	BlockGossipTopic = "block_gossip"
+	// ProposalPreparationTopic represents an upcoming block proposal event topic.
+	ProposalPreparationTopic = "proposal_preparation"
 )
 
+// IsTopicSupported returns true if the given topic can be subscribed to in the event stream.
+func IsTopicSupported(topic string) bool {
+	return casesHandled[topic]
+}
+
 var casesHandled = map[string]bool{
-	HeadTopic:                true,
-	BlockTopic:               true,
-	AttestationTopic:         true,
-	VoluntaryExitTopic:       true,
-	FinalizedCheckpointTopic: true,
-	ChainReorgTopic:          true,
+	HeadTopic:                 true,
+	BlockTopic:                true,
+	AttestationTopic:          true,
+	VoluntaryExitTopic:        true,
+	FinalizedCheckpointTopic:  true,
+	ChainReorgTopic:           true,
+	ContributionAndProofTopic: true,
+	BlockGossipTopic:          true,
+	ProposalPreparationTopic:  true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -56,6 +70,10 @@ func (s *Server) StreamEvents(
 		}
 		requestedTopics[topic] = true
 	}
+	if requestedTopics[ProposalPreparationTopic] && s.ProposalPreparationSubscriber != nil {
+		unsubscribe := s.ProposalPreparationSubscriber.SubscribeProposalPreparation()
+		defer unsubscribe()
+	}
 
 	// Subscribe to event feeds from information received in the beacon node runtime.
 	blockChan := make(chan *feed.Event, 1)
@@ -97,105 +115,148 @@ func (s *Server) StreamEvents(
 func (s *Server) handleBlockEvents(
 	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
 ) error {
+	topic, data, err := BlockEventData(event)
+	if err != nil {
+		return err
+	}
+	return s.streamRequestedData(stream, requestedTopics, topic, data)
+}
+
+func (s *Server) handleBlockOperationEvents(
+	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
+) error {
+	topic, data, err := OperationEventData(event)
+	if err != nil {
+		return err
+	}
+	return s.streamRequestedData(stream, requestedTopics, topic, data)
+}
+
+func (s *Server) handleStateEvents(
+	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
+) error {
+	topic, data, err := StateEventData(event)
+	if err != nil {
+		return err
+	}
+	return s.streamRequestedData(stream, requestedTopics, topic, data)
+}
+
+func (s *Server) streamRequestedData(
+	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, topic string, data proto.Message,
+) error {
+	if data == nil {
+		return nil
+	}
+	if _, ok := requestedTopics[topic]; !ok {
+		return nil
+	}
+	return s.streamData(stream, topic, data)
+}
+
+// BlockEventData converts an event received from the block feed into its event stream topic and
+// the data sent for it. A nil data value is returned for events which are not part of the event stream.
+func BlockEventData(event *feed.Event) (string, proto.Message, error) {
 	switch event.Type {
 	case blockfeed.ReceivedBlock:
-		if _, ok := requestedTopics[BlockTopic]; !ok {
-			return nil
-		}
 		blkData, ok := event.Data.(*blockfeed.ReceivedBlockData)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
 		v1Data, err := migration.BlockIfaceToV1BlockHeader(blkData.SignedBlock)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 		item, err := v1Data.HashTreeRoot()
 		if err != nil {
-			return errors.Wrap(err, "could not hash tree root block")
+			return "", nil, errors.Wrap(err, "could not hash tree root block")
 		}
-		eventBlock := &ethpb.EventBlock{
+		return BlockTopic, &ethpb.EventBlock{
 			Slot:  v1Data.Message.Slot,
 			Block: item[:],
+		}, nil
+	case blockfeed.GossipValidatedBlock:
+		blkData, ok := event.Data.(*blockfeed.GossipValidatedBlockData)
+		if !ok || blkData.SignedBlock == nil || blkData.SignedBlock.IsNil() {
+			return "", nil, nil
 		}
-		return s.streamData(stream, BlockTopic, eventBlock)
+		return BlockGossipTopic, &ethpb.EventBlock{
+			Slot:  blkData.SignedBlock.Block().Slot(),
+			Block: blkData.BlockRoot[:],
+		}, nil
 	default:
-		return nil
+		return "", nil, nil
 	}
 }
 
-func (s *Server) handleBlockOperationEvents(
-	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
-) error {
+// OperationEventData converts an event received from the operation feed into its event stream topic and
+// the data sent for it. A nil data value is returned for events which are not part of the event stream.
+func OperationEventData(event *feed.Event) (string, proto.Message, error) {
 	switch event.Type {
 	case operation.AggregatedAttReceived:
-		if _, ok := requestedTopics[AttestationTopic]; !ok {
-			return nil
-		}
 		attData, ok := event.Data.(*operation.AggregatedAttReceivedData)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		v1Data := migration.V1Alpha1AggregateAttAndProofToV1(attData.Attestation)
-		return s.streamData(stream, AttestationTopic, v1Data)
+		return AttestationTopic, migration.V1Alpha1AggregateAttAndProofToV1(attData.Attestation), nil
 	case operation.UnaggregatedAttReceived:
-		if _, ok := requestedTopics[AttestationTopic]; !ok {
-			return nil
-		}
 		attData, ok := event.Data.(*operation.UnAggregatedAttReceivedData)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		v1Data := migration.V1Alpha1AttestationToV1(attData.Attestation)
-		return s.streamData(stream, AttestationTopic, v1Data)
+		return AttestationTopic, migration.V1Alpha1AttestationToV1(attData.Attestation), nil
 	case operation.ExitReceived:
-		if _, ok := requestedTopics[VoluntaryExitTopic]; !ok {
-			return nil
-		}
 		exitData, ok := event.Data.(*operation.ExitReceivedData)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		v1Data := migration.V1Alpha1ExitToV1(exitData.Exit)
-		return s.streamData(stream, VoluntaryExitTopic, v1Data)
+		return VoluntaryExitTopic, migration.V1Alpha1ExitToV1(exitData.Exit), nil
+	case operation.SyncCommitteeContributionReceived:
+		contributionData, ok := event.Data.(*operation.SyncCommitteeContributionReceivedData)
+		if !ok {
+			return "", nil, nil
+		}
+		return ContributionAndProofTopic, migration.V2SignedContributionAndProofToV1(contributionData.Contribution), nil
 	default:
-		return nil
+		return "", nil, nil
 	}
 }
 
-func (s *Server) handleStateEvents(
-	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
-) error {
+// StateEventData converts an event received from the state feed into its event stream topic and
+// the data sent for it. A nil data value is returned for events which are not part of the event stream.
+func StateEventData(event *feed.Event) (string, proto.Message, error) {
 	switch event.Type {
 	case statefeed.NewHead:
-		if _, ok := requestedTopics[HeadTopic]; !ok {
-			return nil
-		}
 		head, ok := event.Data.(*ethpb.EventHead)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		return s.streamData(stream, HeadTopic, head)
+		return HeadTopic, head, nil
 	case statefeed.FinalizedCheckpoint:
-		if _, ok := requestedTopics[FinalizedCheckpointTopic]; !ok {
-			return nil
-		}
 		finalizedCheckpoint, ok := event.Data.(*ethpb.EventFinalizedCheckpoint)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		return s.streamData(stream, FinalizedCheckpointTopic, finalizedCheckpoint)
+		return FinalizedCheckpointTopic, finalizedCheckpoint, nil
 	case statefeed.Reorg:
-		if _, ok := requestedTopics[ChainReorgTopic]; !ok {
-			return nil
-		}
 		reorg, ok := event.Data.(*ethpb.EventChainReorg)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		return s.streamData(stream, ChainReorgTopic, reorg)
+		return ChainReorgTopic, reorg, nil
+	case statefeed.ProposalPreparation:
+		preparation, ok := event.Data.(*statefeed.ProposalPreparationData)
+		if !ok {
+			return "", nil, nil
+		}
+		return ProposalPreparationTopic, &ethpb.EventProposalPreparation{
+			ProposalSlot:    preparation.ProposalSlot,
+			ProposerIndex:   preparation.ProposerIndex,
+			ParentBlockRoot: preparation.ParentBlockRoot[:],
+			Timestamp:       preparation.Timestamp,
+		}, nil
 	default:
-		return nil
+		return "", nil, nil
 	}
 }
 
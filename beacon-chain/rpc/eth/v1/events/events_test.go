@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	ethpb_v1alpha1 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -80,6 +81,44 @@ func TestStreamEvents_BlockEvents(t *testing.T) {
 			feed: srv.BlockNotifier.BlockFeed(),
 		})
 	})
+	t.Run(BlockGossipTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedBlock := testutil.HydrateSignedBeaconBlock(&ethpb_v1alpha1.SignedBeaconBlock{
+			Block: &ethpb_v1alpha1.BeaconBlock{
+				Slot: 9,
+			},
+		})
+		wantedBlockRoot, err := wantedBlock.Block.HashTreeRoot()
+		require.NoError(t, err)
+		genericResponse, err := anypb.New(&ethpb.EventBlock{
+			Slot:  9,
+			Block: wantedBlockRoot[:],
+		})
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: BlockGossipTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{BlockGossipTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: blockfeed.GossipValidatedBlock,
+				Data: &blockfeed.GossipValidatedBlockData{
+					BlockRoot:   wantedBlockRoot,
+					SignedBlock: wrapper.WrappedPhase0SignedBeaconBlock(wantedBlock),
+				},
+			},
+			feed: srv.BlockNotifier.BlockFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_OperationsEvents(t *testing.T) {
@@ -185,6 +224,48 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(ContributionAndProofTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedContribution := &prysmv2.SignedContributionAndProof{
+			Message: &prysmv2.ContributionAndProof{
+				AggregatorIndex: 1,
+				Contribution: &prysmv2.SyncCommitteeContribution{
+					Slot:              1,
+					BlockRoot:         make([]byte, 32),
+					SubcommitteeIndex: 1,
+					AggregationBits:   make([]byte, 16),
+					Signature:         make([]byte, 96),
+				},
+				SelectionProof: make([]byte, 96),
+			},
+			Signature: make([]byte, 96),
+		}
+		genericResponse, err := anypb.New(migration.V2SignedContributionAndProofToV1(wantedContribution))
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: ContributionAndProofTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{ContributionAndProofTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.SyncCommitteeContributionReceived,
+				Data: &operation.SyncCommitteeContributionReceivedData{
+					Contribution: wantedContribution,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_StateEvents(t *testing.T) {
@@ -287,6 +368,27 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 	})
 }
 
+func TestStateEventData_ProposalPreparation(t *testing.T) {
+	topic, data, err := StateEventData(&feed.Event{
+		Type: statefeed.ProposalPreparation,
+		Data: &statefeed.ProposalPreparationData{
+			ProposalSlot:    9,
+			ProposerIndex:   4,
+			ParentBlockRoot: [32]byte{'a'},
+			Timestamp:       1606824131,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ProposalPreparationTopic, topic)
+	root := [32]byte{'a'}
+	assert.DeepEqual(t, &ethpb.EventProposalPreparation{
+		ProposalSlot:    9,
+		ProposerIndex:   4,
+		ParentBlockRoot: root[:],
+		Timestamp:       1606824131,
+	}, data)
+}
+
 func setupServer(ctx context.Context, t testing.TB) (*Server, *gomock.Controller, *mock.MockEvents_StreamEventsServer) {
 	srv := &Server{
 		BlockNotifier:     &mockChain.MockBlockNotifier{},
@@ -6,6 +6,7 @@ package events
 import (
 	"context"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
@@ -18,4 +19,6 @@ type Server struct {
 	StateNotifier     statefeed.Notifier
 	BlockNotifier     blockfeed.Notifier
 	OperationNotifier opfeed.Notifier
+	// ProposalPreparationSubscriber is notified of clients subscribing to proposal preparation events.
+	ProposalPreparationSubscriber blockchain.ProposalPreparationSubscriber
 }
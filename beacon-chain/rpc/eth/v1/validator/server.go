@@ -3,6 +3,7 @@ package validator
 import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	v1alpha1validator "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 )
@@ -14,5 +15,6 @@ type Server struct {
 	TimeFetcher      blockchain.TimeFetcher
 	SyncChecker      sync.Checker
 	AttestationsPool attestations.Pool
+	Broadcaster      p2p.Broadcaster
 	V1Alpha1Server   *v1alpha1validator.Server
 }
@@ -8,13 +8,17 @@ import (
 	emptypb "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	core "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	v1alpha1validator "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	v1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	v1alpha1 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -184,7 +188,21 @@ func (vs *Server) ProduceBlock(ctx context.Context, req *v1.ProduceBlockRequest)
 // ProduceAttestationData requests that the beacon node produces attestation data for
 // the requested committee index and slot based on the nodes current head.
 func (vs *Server) ProduceAttestationData(ctx context.Context, req *v1.ProduceAttestationDataRequest) (*v1.ProduceAttestationDataResponse, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.ProduceAttestationData")
+	defer span.End()
+
+	v1alpha1req := &v1alpha1.AttestationDataRequest{
+		Slot:           req.Slot,
+		CommitteeIndex: req.CommitteeIndex,
+	}
+	v1alpha1resp, err := vs.V1Alpha1Server.GetAttestationData(ctx, v1alpha1req)
+	if err != nil {
+		// We simply return err because it's already of a gRPC error type.
+		return nil, err
+	}
+	attData := migration.V1Alpha1AttDataToV1(v1alpha1resp)
+
+	return &v1.ProduceAttestationDataResponse{Data: attData}, nil
 }
 
 // GetAggregateAttestation aggregates all attestations matching the given attestation data root and slot, returning the aggregated result.
@@ -218,13 +236,132 @@ func (vs *Server) GetAggregateAttestation(ctx context.Context, req *v1.Aggregate
 
 // SubmitAggregateAndProofs verifies given aggregate and proofs and publishes them on appropriate gossipsub topic.
 func (vs *Server) SubmitAggregateAndProofs(ctx context.Context, req *v1.SubmitAggregateAndProofsRequest) (*emptypb.Empty, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitAggregateAndProofs")
+	defer span.End()
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No aggregates provided")
+	}
+	emptySig := make([]byte, params.BeaconConfig().BLSSignatureLength)
+	for _, agg := range req.Data {
+		if agg == nil || agg.Message == nil || agg.Message.Aggregate == nil || agg.Message.Aggregate.Data == nil {
+			return nil, status.Error(codes.InvalidArgument, "Signed aggregates can't be nil")
+		}
+		if len(agg.Signature) != params.BeaconConfig().BLSSignatureLength {
+			return nil, status.Errorf(codes.InvalidArgument, "Incorrect signature length. Expected %d bytes", params.BeaconConfig().BLSSignatureLength)
+		}
+		if len(agg.Message.SelectionProof) != params.BeaconConfig().BLSSignatureLength {
+			return nil, status.Errorf(codes.InvalidArgument, "Incorrect selection proof length. Expected %d bytes", params.BeaconConfig().BLSSignatureLength)
+		}
+		if bytes.Equal(agg.Signature, emptySig) || bytes.Equal(agg.Message.SelectionProof, emptySig) {
+			return nil, status.Error(codes.InvalidArgument, "Signed signatures can't be zero hashes")
+		}
+
+		// As a preventive measure, a beacon node shouldn't broadcast an attestation whose slot is out of range.
+		if err := helpers.ValidateAttestationTime(agg.Message.Aggregate.Data.Slot,
+			vs.TimeFetcher.GenesisTime(), params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Attestation slot is no longer valid from current time")
+		}
+	}
+
+	broadcastFailed := false
+	for _, agg := range req.Data {
+		v1alpha1Agg := migration.V1SignedAggregateAttAndProofToV1Alpha1(agg)
+		if err := vs.Broadcaster.Broadcast(ctx, v1alpha1Agg); err != nil {
+			broadcastFailed = true
+		} else {
+			log.WithFields(logrus.Fields{
+				"slot":            agg.Message.Aggregate.Data.Slot,
+				"committeeIndex":  agg.Message.Aggregate.Data.Index,
+				"validatorIndex":  agg.Message.AggregatorIndex,
+				"aggregatedCount": agg.Message.Aggregate.AggregationBits.Count(),
+			}).Debug("Broadcasting aggregated attestation and proof")
+		}
+	}
+	if broadcastFailed {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Could not broadcast one or more signed aggregated attestations. Some aggregates could be broadcast successfully.")
+	}
+
+	return &emptypb.Empty{}, nil
 }
 
 // SubmitBeaconCommitteeSubscription searches using discv5 for peers related to the provided subnet information
 // and replaces current peers with those ones if necessary.
 func (vs *Server) SubmitBeaconCommitteeSubscription(ctx context.Context, req *v1.SubmitBeaconCommitteeSubscriptionsRequest) (*emptypb.Empty, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitBeaconCommitteeSubscription")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No subscriptions provided")
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+
+	// Verify validator indices before registering any subscriptions.
+	validators := make([]state.ReadOnlyValidator, len(req.Data))
+	for i, sub := range req.Data {
+		val, err := s.ValidatorAtIndexReadOnly(sub.ValidatorIndex)
+		if _, ok := err.(*statev1.ValidatorIndexOutOfRangeError); ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index: %v", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+		validators[i] = val
+	}
+
+	fetchValsLen := func(slot types.Slot) (uint64, error) {
+		wantedEpoch := helpers.SlotToEpoch(slot)
+		vals, err := vs.HeadFetcher.HeadValidatorsIndices(ctx, wantedEpoch)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(len(vals)), nil
+	}
+
+	// Request the head validator indices of epoch represented by the first requested
+	// slot.
+	currValsLen, err := fetchValsLen(req.Data[0].Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head validator length: %v", err)
+	}
+	currEpoch := helpers.SlotToEpoch(req.Data[0].Slot)
+
+	for _, sub := range req.Data {
+		// If epoch has changed, re-request active validators length
+		if currEpoch != helpers.SlotToEpoch(sub.Slot) {
+			currValsLen, err = fetchValsLen(sub.Slot)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve head validator length: %v", err)
+			}
+			currEpoch = helpers.SlotToEpoch(sub.Slot)
+		}
+		subnet := helpers.ComputeSubnetFromCommitteeAndSlot(currValsLen, sub.CommitteeIndex, sub.Slot)
+		cache.SubnetIDs.AddAttesterSubnetID(sub.Slot, subnet)
+		if sub.IsAggregator {
+			cache.SubnetIDs.AddAggregatorSubnetID(sub.Slot, subnet)
+		}
+	}
+
+	// Active validators are also given their long-lived random subnets, the same way
+	// the duties endpoint of the v1alpha1 API does it.
+	for i, val := range validators {
+		if !helpers.IsActiveValidatorUsingTrie(val, helpers.SlotToEpoch(req.Data[i].Slot)) {
+			continue
+		}
+		pubkey := val.PublicKey()
+		v1alpha1validator.AssignValidatorToSubnet(pubkey[:], v1alpha1.ValidatorStatus_ACTIVE)
+	}
+
+	return &emptypb.Empty{}, nil
 }
 
 // attestationDependentRoot is get_block_root_at_slot(state, compute_start_slot_at_epoch(epoch - 1) - 1)
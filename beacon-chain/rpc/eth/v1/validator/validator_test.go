@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -15,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	p2pmock "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	v1alpha1validator "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -28,6 +31,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestGetAttesterDuties(t *testing.T) {
@@ -556,3 +560,439 @@ func TestGetAggregateAttestation_SameSlotAndRoot_ReturnMostAggregationBits(t *te
 	require.NotNil(t, att.Data)
 	assert.DeepEqual(t, bitfield.Bitlist{0, 1, 1}, att.Data.AggregationBits)
 }
+
+func TestProduceAttestationData(t *testing.T) {
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 3*params.BeaconConfig().SlotsPerEpoch + 1
+	targetBlock := testutil.NewBeaconBlock()
+	targetBlock.Block.Slot = 1 * params.BeaconConfig().SlotsPerEpoch
+	justifiedBlock := testutil.NewBeaconBlock()
+	justifiedBlock.Block.Slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	blockRoot, err := block.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not hash beacon block")
+	justifiedRoot, err := justifiedBlock.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root for justified block")
+	targetRoot, err := targetBlock.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root for target block")
+	slot := 3*params.BeaconConfig().SlotsPerEpoch + 1
+	beaconState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconState.SetSlot(slot))
+	err = beaconState.SetCurrentJustifiedCheckpoint(&ethpb.Checkpoint{
+		Epoch: 2,
+		Root:  justifiedRoot[:],
+	})
+	require.NoError(t, err)
+
+	blockRoots := beaconState.BlockRoots()
+	blockRoots[1] = blockRoot[:]
+	blockRoots[1*params.BeaconConfig().SlotsPerEpoch] = targetRoot[:]
+	blockRoots[2*params.BeaconConfig().SlotsPerEpoch] = justifiedRoot[:]
+	require.NoError(t, beaconState.SetBlockRoots(blockRoots))
+	chainService := &mockChain.ChainService{
+		Genesis: time.Now(),
+	}
+	offset := int64(slot.Mul(params.BeaconConfig().SecondsPerSlot))
+	v1Alpha1Server := &v1alpha1validator.Server{
+		P2P:              &p2pmock.MockBroadcaster{},
+		SyncChecker:      &mockSync.Sync{IsSyncing: false},
+		AttestationCache: cache.NewAttestationCache(),
+		HeadFetcher: &mockChain.ChainService{
+			State: beaconState, Root: blockRoot[:],
+		},
+		FinalizationFetcher: &mockChain.ChainService{
+			CurrentJustifiedCheckPoint: beaconState.CurrentJustifiedCheckpoint(),
+		},
+		TimeFetcher: &mockChain.ChainService{
+			Genesis: time.Now().Add(time.Duration(-1*offset) * time.Second),
+		},
+		StateNotifier: chainService.StateNotifier(),
+	}
+	v1Server := &Server{
+		V1Alpha1Server: v1Alpha1Server,
+	}
+
+	req := &v1.ProduceAttestationDataRequest{
+		CommitteeIndex: 0,
+		Slot:           3*params.BeaconConfig().SlotsPerEpoch + 1,
+	}
+	res, err := v1Server.ProduceAttestationData(context.Background(), req)
+	require.NoError(t, err, "Could not get attestation info at slot")
+
+	expectedInfo := &v1.AttestationData{
+		Slot:            3*params.BeaconConfig().SlotsPerEpoch + 1,
+		BeaconBlockRoot: blockRoot[:],
+		Source: &v1.Checkpoint{
+			Epoch: 2,
+			Root:  justifiedRoot[:],
+		},
+		Target: &v1.Checkpoint{
+			Epoch: 3,
+			Root:  blockRoot[:],
+		},
+	}
+
+	if !proto.Equal(res.Data, expectedInfo) {
+		t.Errorf("Expected attestation info to match, received %v, wanted %v", res, expectedInfo)
+	}
+}
+
+func TestSubmitBeaconCommitteeSubscription(t *testing.T) {
+	ctx := context.Background()
+	genesis := testutil.NewBeaconBlock()
+	depChainStart := uint64(64)
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(depChainStart)
+	require.NoError(t, err)
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	require.NoError(t, err)
+	bs, err := state.GenesisBeaconState(context.Background(), deposits, 0, eth1Data)
+	require.NoError(t, err, "Could not set up genesis state")
+	// Set state to non-epoch start slot.
+	require.NoError(t, bs.SetSlot(5))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+	roots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	roots[0] = genesisRoot[:]
+	require.NoError(t, bs.SetBlockRoots(roots))
+
+	pubKeys := make([][]byte, len(deposits))
+	for i := 0; i < len(deposits); i++ {
+		pubKeys[i] = deposits[i].Data.PublicKey
+	}
+
+	chainSlot := types.Slot(0)
+	chain := &mockChain.ChainService{
+		State: bs, Root: genesisRoot[:], Slot: &chainSlot,
+	}
+	vs := &Server{
+		HeadFetcher:    chain,
+		TimeFetcher:    chain,
+		SyncChecker:    &mockSync.Sync{IsSyncing: false},
+		V1Alpha1Server: &v1alpha1validator.Server{},
+	}
+
+	t.Run("Single subscription", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*v1.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 1,
+					CommitteeIndex: 1,
+					Slot:           1,
+					IsAggregator:   false,
+				},
+			},
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		subnets := cache.SubnetIDs.GetAttesterSubnetIDs(1)
+		require.Equal(t, 1, len(subnets))
+		assert.Equal(t, uint64(2), subnets[0])
+	})
+
+	t.Run("Multiple subscriptions", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		var data []*v1.BeaconCommitteeSubscribe
+		for i := 0; i < 100; i++ {
+			data = append(data, &v1.BeaconCommitteeSubscribe{
+				ValidatorIndex: types.ValidatorIndex(i % 64),
+				CommitteeIndex: types.CommitteeIndex(i),
+				Slot:           types.Slot(i),
+				IsAggregator:   false,
+			})
+		}
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{Data: data}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		for i := types.Slot(0); i < 100; i++ {
+			subnets := cache.SubnetIDs.GetAttesterSubnetIDs(i)
+			assert.Equal(t, 1, len(subnets))
+		}
+	})
+
+	t.Run("Is aggregator", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*v1.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 1,
+					CommitteeIndex: 1,
+					Slot:           1,
+					IsAggregator:   true,
+				},
+			},
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		ids := cache.SubnetIDs.GetAggregatorSubnetIDs(types.Slot(1))
+		assert.Equal(t, 1, len(ids))
+	})
+
+	t.Run("Active validator is assigned a persistent subnet", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*v1.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 1,
+					CommitteeIndex: 1,
+					Slot:           1,
+				},
+			},
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		subnets, ok, _ := cache.SubnetIDs.GetPersistentSubnets(pubKeys[1])
+		require.Equal(t, true, ok)
+		assert.Equal(t, int(params.BeaconConfig().RandomSubnetsPerValidator), len(subnets))
+	})
+
+	t.Run("Activity is checked at each subscription's epoch", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		st := bs.Copy()
+		val, err := st.ValidatorAtIndex(2)
+		require.NoError(t, err)
+		val.ActivationEpoch = 1
+		require.NoError(t, st.UpdateValidatorAtIndex(2, val))
+		chain := &mockChain.ChainService{
+			State: st, Root: genesisRoot[:], Slot: &chainSlot,
+		}
+		vs := &Server{
+			HeadFetcher:    chain,
+			TimeFetcher:    chain,
+			SyncChecker:    &mockSync.Sync{IsSyncing: false},
+			V1Alpha1Server: &v1alpha1validator.Server{},
+		}
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*v1.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 2,
+					CommitteeIndex: 1,
+					Slot:           params.BeaconConfig().SlotsPerEpoch,
+				},
+				{
+					ValidatorIndex: 3,
+					CommitteeIndex: 1,
+					Slot:           1,
+				},
+			},
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		_, ok, _ := cache.SubnetIDs.GetPersistentSubnets(pubKeys[2])
+		assert.Equal(t, true, ok, "Validator active at its subscription epoch was not assigned a subnet")
+	})
+
+	t.Run("No subscriptions", func(t *testing.T) {
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: make([]*v1.BeaconCommitteeSubscribe, 0),
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NotNil(t, err)
+		assert.ErrorContains(t, "No subscriptions provided", err)
+	})
+
+	t.Run("Invalid validator index", func(t *testing.T) {
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*v1.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 99999,
+					CommitteeIndex: 1,
+					Slot:           1,
+				},
+			},
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NotNil(t, err)
+		assert.ErrorContains(t, "Invalid validator index", err)
+	})
+}
+
+func TestSubmitBeaconCommitteeSubscription_SyncNotReady(t *testing.T) {
+	vs := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+	_, err := vs.SubmitBeaconCommitteeSubscription(context.Background(), &v1.SubmitBeaconCommitteeSubscriptionsRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
+
+func TestSubmitAggregateAndProofs(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconNetworkConfig()
+	c.MaximumGossipClockDisparity = time.Hour
+	params.OverrideBeaconNetworkConfig(c)
+	root := bytesutil.PadTo([]byte("root"), 32)
+	sig := bytesutil.PadTo([]byte("sig"), params.BeaconConfig().BLSSignatureLength)
+	proof := bytesutil.PadTo([]byte("proof"), params.BeaconConfig().BLSSignatureLength)
+	att := &v1.Attestation{
+		AggregationBits: []byte{0, 1},
+		Data: &v1.AttestationData{
+			Slot:            1,
+			Index:           1,
+			BeaconBlockRoot: root,
+			Source: &v1.Checkpoint{
+				Epoch: 1,
+				Root:  root,
+			},
+			Target: &v1.Checkpoint{
+				Epoch: 1,
+				Root:  root,
+			},
+		},
+		Signature: sig,
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		chainSlot := types.Slot(0)
+		chain := &mockChain.ChainService{
+			Genesis: time.Now(), Slot: &chainSlot,
+		}
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			TimeFetcher: chain,
+			Broadcaster: broadcaster,
+		}
+
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message: &v1.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: sig,
+				},
+			},
+		}
+
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		require.Equal(t, 1, len(broadcaster.BroadcastMessages))
+		broadcasted, ok := broadcaster.BroadcastMessages[0].(*ethpb.SignedAggregateAttestationAndProof)
+		require.Equal(t, true, ok)
+		assert.Equal(t, types.ValidatorIndex(1), broadcasted.Message.AggregatorIndex)
+		assert.DeepEqual(t, sig, broadcasted.Signature)
+	})
+
+	t.Run("nil aggregate", func(t *testing.T) {
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			Broadcaster: broadcaster,
+		}
+
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				nil,
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Signed aggregates can't be nil", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+
+		req = &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Signature: sig,
+				},
+			},
+		}
+		_, err = vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Signed aggregates can't be nil", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("zero signature", func(t *testing.T) {
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			Broadcaster: broadcaster,
+		}
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message: &v1.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: make([]byte, params.BeaconConfig().BLSSignatureLength),
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Signed signatures can't be zero hashes", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("wrong signature length", func(t *testing.T) {
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			Broadcaster: broadcaster,
+		}
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message: &v1.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: []byte{'a'},
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Incorrect signature length", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("wrong selection proof length", func(t *testing.T) {
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			Broadcaster: broadcaster,
+		}
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message: &v1.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  []byte{'a'},
+					},
+					Signature: sig,
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Incorrect selection proof length", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("invalid attestation time", func(t *testing.T) {
+		chainSlot := types.Slot(0)
+		chain := &mockChain.ChainService{
+			Genesis: time.Now().Add(time.Hour * 2), Slot: &chainSlot,
+		}
+		broadcaster := &p2pmock.MockBroadcaster{}
+		vs := Server{
+			TimeFetcher: chain,
+			Broadcaster: broadcaster,
+		}
+
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message: &v1.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: sig,
+				},
+			},
+		}
+
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.ErrorContains(t, "Attestation slot is no longer valid from current time", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+}
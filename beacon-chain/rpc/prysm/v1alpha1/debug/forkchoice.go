@@ -1,11 +1,18 @@
 package debug
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"fmt"
 
+	"github.com/emicklei/dot"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetProtoArrayForkChoice returns proto array fork choice store.
@@ -42,3 +49,93 @@ func (ds *Server) GetProtoArrayForkChoice(_ context.Context, _ *empty.Empty) (*p
 		Indices:         indices,
 	}, nil
 }
+
+// GetForkChoiceTree returns the live proto array fork choice tree. Every node carries its weight,
+// best child and descendant, checkpoints and viability for head, and the whole tree is also
+// rendered as a Graphviz DOT graph to help diagnose reorgs on a running node.
+func (ds *Server) GetForkChoiceTree(ctx context.Context, _ *empty.Empty) (*pbrpc.ForkChoiceTreeResponse, error) {
+	store := ds.HeadFetcher.ProtoArrayStore()
+	headRoot, err := ds.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+
+	nodes := store.Nodes()
+	treeNodes := make([]*pbrpc.ForkChoiceTreeNode, len(nodes))
+	for i, n := range nodes {
+		r := n.Root()
+		leadsToViableHead, err := store.LeadsToViableHead(n)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine viability of node %#x: %v", r, err)
+		}
+		g := n.Graffiti()
+		treeNodes[i] = &pbrpc.ForkChoiceTreeNode{
+			Slot:              n.Slot(),
+			Root:              r[:],
+			ParentRoot:        nodeRoot(nodes, n.Parent()),
+			JustifiedEpoch:    n.JustifiedEpoch(),
+			FinalizedEpoch:    n.FinalizedEpoch(),
+			Weight:            n.Weight(),
+			BestChild:         nodeRoot(nodes, n.BestChild()),
+			BestDescendant:    nodeRoot(nodes, n.BestDescendant()),
+			ViableForHead:     store.IsViableForHead(n),
+			LeadsToViableHead: leadsToViableHead,
+			Graffiti:          g[:],
+		}
+	}
+
+	return &pbrpc.ForkChoiceTreeResponse{
+		JustifiedEpoch: store.JustifiedEpoch(),
+		FinalizedEpoch: store.FinalizedEpoch(),
+		HeadRoot:       headRoot,
+		Nodes:          treeNodes,
+		Dot:            forkChoiceDot(nodes, treeNodes, headRoot),
+	}, nil
+}
+
+// nodeRoot returns the root of the node at the given proto array index, or nil
+// if the index does not point to a node.
+func nodeRoot(nodes []*protoarray.Node, index uint64) []byte {
+	if index == protoarray.NonExistentNode || index >= uint64(len(nodes)) {
+		return nil
+	}
+	r := nodes[index].Root()
+	return r[:]
+}
+
+// forkChoiceDot renders the fork choice tree in the Graphviz DOT format. Edges point from
+// child to parent and the edge to the best child of a node is drawn in bold. Nodes which
+// are not viable for head are dashed, and the head is green.
+func forkChoiceDot(nodes []*protoarray.Node, treeNodes []*pbrpc.ForkChoiceTreeNode, headRoot []byte) string {
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	dotNodes := make([]dot.Node, len(treeNodes))
+	for i, n := range treeNodes {
+		label := fmt.Sprintf(
+			"slot: %d\n root: %#x\n weight: %d\n justified: %d\n finalized: %d",
+			n.Slot, bytesutil.Trunc(n.Root), n.Weight, n.JustifiedEpoch, n.FinalizedEpoch,
+		)
+		dotNodes[i] = graph.Node(hex.EncodeToString(n.Root)).Box().Attr("label", label)
+		if !n.ViableForHead {
+			dotNodes[i].Attr("style", "dashed")
+		}
+		if bytes.Equal(n.Root, headRoot) {
+			dotNodes[i].Attr("color", "green")
+		}
+	}
+
+	for i, n := range nodes {
+		parent := n.Parent()
+		if parent == protoarray.NonExistentNode || parent >= uint64(len(dotNodes)) {
+			continue
+		}
+		edge := graph.Edge(dotNodes[i], dotNodes[parent])
+		if nodes[parent].BestChild() == uint64(i) {
+			edge.Attr("style", "bold")
+		}
+	}
+
+	return graph.String()
+}
@@ -2,9 +2,11 @@ package debug
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -20,3 +22,52 @@ func TestServer_GetForkChoice(t *testing.T) {
 	assert.Equal(t, store.JustifiedEpoch(), res.JustifiedEpoch, "Did not get wanted justified epoch")
 	assert.Equal(t, store.FinalizedEpoch(), res.FinalizedEpoch, "Did not get wanted finalized epoch")
 }
+
+func TestServer_GetForkChoiceTree(t *testing.T) {
+	ctx := context.Background()
+	genesis := [32]byte{'g'}
+	a := [32]byte{'a'}
+	b := [32]byte{'b'}
+	c := [32]byte{'c'}
+	f := protoarray.New(1, 0, genesis)
+	require.NoError(t, f.ProcessBlock(ctx, 0, genesis, [32]byte{}, [32]byte{}, 1, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, a, genesis, [32]byte{}, 1, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, b, genesis, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, c, a, [32]byte{}, 1, 0))
+	headRoot, err := f.Head(ctx, 1, genesis, []uint64{}, 0)
+	require.NoError(t, err)
+	require.Equal(t, c, headRoot)
+
+	bs := &Server{HeadFetcher: &mock.ChainService{ForkChoiceStore: f.Store(), Root: headRoot[:]}}
+	res, err := bs.GetForkChoiceTree(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), res.JustifiedEpoch)
+	assert.Equal(t, types.Epoch(0), res.FinalizedEpoch)
+	assert.DeepEqual(t, c[:], res.HeadRoot)
+	require.Equal(t, 4, len(res.Nodes))
+
+	root := res.Nodes[0]
+	assert.DeepEqual(t, genesis[:], root.Root)
+	assert.DeepEqual(t, []byte(nil), root.ParentRoot)
+	assert.DeepEqual(t, a[:], root.BestChild)
+	assert.DeepEqual(t, c[:], root.BestDescendant)
+	assert.Equal(t, true, root.ViableForHead)
+
+	nonViable := res.Nodes[2]
+	assert.DeepEqual(t, b[:], nonViable.Root)
+	assert.DeepEqual(t, genesis[:], nonViable.ParentRoot)
+	assert.Equal(t, false, nonViable.ViableForHead)
+	assert.Equal(t, false, nonViable.LeadsToViableHead)
+
+	head := res.Nodes[3]
+	assert.Equal(t, types.Slot(2), head.Slot)
+	assert.DeepEqual(t, a[:], head.ParentRoot)
+	assert.DeepEqual(t, []byte(nil), head.BestChild)
+	assert.Equal(t, true, head.LeadsToViableHead)
+
+	assert.Equal(t, true, strings.HasPrefix(res.Dot, "digraph"))
+	assert.Equal(t, 3, strings.Count(res.Dot, "->"), "Wanted an edge from every node to its parent")
+	assert.Equal(t, 1, strings.Count(res.Dot, "dashed"), "Wanted only the non viable node dashed")
+	assert.Equal(t, 1, strings.Count(res.Dot, "green"), "Wanted only the head node green")
+	assert.Equal(t, 2, strings.Count(res.Dot, "bold"), "Wanted the edges to best children in bold")
+}
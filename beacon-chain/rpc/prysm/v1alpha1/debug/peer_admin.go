@@ -0,0 +1,129 @@
+package debug
+
+import (
+	"context"
+	"math"
+	"net"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BanPeer bans the requested peer or IP range for the requested duration, disconnecting from
+// the banned peers which are connected. A zero duration bans until the ban is lifted.
+func (ds *Server) BanPeer(ctx context.Context, req *pbrpc.BanPeerRequest) (*empty.Empty, error) {
+	if req.DurationSeconds > uint64(math.MaxInt64/int64(time.Second)) {
+		return nil, status.Errorf(codes.InvalidArgument, "Ban duration of %d seconds is too long", req.DurationSeconds)
+	}
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	pid, ipNet, err := banTarget(req.PeerId, req.IpRange)
+	if err != nil {
+		return nil, err
+	}
+	if ipNet != nil {
+		if err := ds.PeerAdmin.BanIPRange(ctx, ipNet, duration); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not ban IP range: %v", err)
+		}
+		return &empty.Empty{}, nil
+	}
+	if err := ds.PeerAdmin.BanPeer(ctx, pid, duration); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not ban peer: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// UnbanPeer lifts the ban of the requested peer or IP range.
+func (ds *Server) UnbanPeer(_ context.Context, req *pbrpc.UnbanPeerRequest) (*empty.Empty, error) {
+	pid, ipNet, err := banTarget(req.PeerId, req.IpRange)
+	if err != nil {
+		return nil, err
+	}
+	if ipNet != nil {
+		if !ds.PeerAdmin.UnbanIPRange(ipNet) {
+			return nil, status.Errorf(codes.NotFound, "IP range %s is not banned", ipNet)
+		}
+		return &empty.Empty{}, nil
+	}
+	if !ds.PeerAdmin.UnbanPeer(pid) {
+		return nil, status.Errorf(codes.NotFound, "Peer %s is not banned", pid)
+	}
+	return &empty.Empty{}, nil
+}
+
+// ListPeerBans returns the peer and IP range bans in effect.
+func (ds *Server) ListPeerBans(_ context.Context, _ *empty.Empty) (*pbrpc.PeerBansResponse, error) {
+	bans := ds.PeersFetcher.Peers().Bans()
+	resp := &pbrpc.PeerBansResponse{Bans: make([]*pbrpc.PeerBan, 0, len(bans))}
+	for _, ban := range bans {
+		pbBan := &pbrpc.PeerBan{}
+		if ban.PeerID != "" {
+			pbBan.PeerId = ban.PeerID.String()
+		}
+		if ban.IPRange != nil {
+			pbBan.IpRange = ban.IPRange.String()
+		}
+		if !ban.Expiry.IsZero() {
+			pbBan.Expiry = uint64(ban.Expiry.Unix())
+		}
+		resp.Bans = append(resp.Bans, pbBan)
+	}
+	return resp, nil
+}
+
+// DialPeer connects to the peer with the requested multiaddr or ENR.
+func (ds *Server) DialPeer(ctx context.Context, req *pbrpc.DialPeerRequest) (*pbrpc.DialPeerResponse, error) {
+	if req.Addr == "" {
+		return nil, status.Error(codes.InvalidArgument, "Expected a multiaddr or ENR to dial")
+	}
+	pid, err := ds.PeerAdmin.DialPeer(ctx, req.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not dial peer: %v", err)
+	}
+	return &pbrpc.DialPeerResponse{PeerId: pid.String()}, nil
+}
+
+// DisconnectPeer sends a goodbye message with the requested reason code to the requested
+// peer and disconnects from it.
+func (ds *Server) DisconnectPeer(ctx context.Context, req *pbrpc.DisconnectPeerRequest) (*empty.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	err = ds.PeerAdmin.DisconnectPeer(ctx, pid, p2ptypes.RPCGoodbyeCode(req.GoodbyeCode))
+	if errors.Is(err, p2p.ErrPeerNotConnected) {
+		return nil, status.Errorf(codes.NotFound, "Peer %s is not connected", pid)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not disconnect from peer: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// banTarget parses the peer id or the IP range of a ban request, exactly one of which must be given.
+func banTarget(peerID, ipRange string) (peer.ID, *net.IPNet, error) {
+	switch {
+	case peerID != "" && ipRange != "":
+		return "", nil, status.Error(codes.InvalidArgument, "Expected either a peer id or an IP range, not both")
+	case peerID != "":
+		pid, err := peer.Decode(peerID)
+		if err != nil {
+			return "", nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+		}
+		return pid, nil, nil
+	case ipRange != "":
+		_, ipNet, err := net.ParseCIDR(ipRange)
+		if err != nil {
+			return "", nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided IP range: %v", err)
+		}
+		return "", ipNet, nil
+	default:
+		return "", nil, status.Error(codes.InvalidArgument, "Expected a peer id or an IP range")
+	}
+}
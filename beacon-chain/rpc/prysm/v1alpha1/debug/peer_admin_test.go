@@ -0,0 +1,126 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p-core/peer"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDebugServer_BanPeer(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ds := &Server{
+		PeersFetcher: peersProvider,
+		PeerAdmin:    &mockP2p.MockPeerAdmin{Status: peersProvider.Peers()},
+	}
+	firstPeer := peersProvider.Peers().All()[0]
+
+	_, err := ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: firstPeer.String(), DurationSeconds: 60})
+	require.NoError(t, err)
+	_, err = ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{IpRange: "10.0.0.0/8"})
+	require.NoError(t, err)
+	assert.Equal(t, true, peersProvider.Peers().IsBanned(firstPeer))
+
+	res, err := ds.ListPeerBans(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Bans))
+	assert.Equal(t, firstPeer.String(), res.Bans[0].PeerId)
+	assert.NotEqual(t, uint64(0), res.Bans[0].Expiry)
+	assert.Equal(t, "10.0.0.0/8", res.Bans[1].IpRange)
+	assert.Equal(t, uint64(0), res.Bans[1].Expiry)
+
+	_, err = ds.UnbanPeer(context.Background(), &pbrpc.UnbanPeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	_, err = ds.UnbanPeer(context.Background(), &pbrpc.UnbanPeerRequest{PeerId: firstPeer.String()})
+	assert.ErrorContains(t, "is not banned", err)
+	_, err = ds.UnbanPeer(context.Background(), &pbrpc.UnbanPeerRequest{IpRange: "10.0.0.0/8"})
+	require.NoError(t, err)
+	res, err = ds.ListPeerBans(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Bans))
+}
+
+func TestDebugServer_BanPeer_InvalidRequest(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ds := &Server{
+		PeersFetcher: peersProvider,
+		PeerAdmin:    &mockP2p.MockPeerAdmin{Status: peersProvider.Peers()},
+	}
+	firstPeer := peersProvider.Peers().All()[0]
+
+	tests := []struct {
+		name    string
+		req     *pbrpc.BanPeerRequest
+		wantErr string
+	}{
+		{
+			name:    "no target",
+			req:     &pbrpc.BanPeerRequest{},
+			wantErr: "Expected a peer id or an IP range",
+		},
+		{
+			name:    "both targets",
+			req:     &pbrpc.BanPeerRequest{PeerId: firstPeer.String(), IpRange: "10.0.0.0/8"},
+			wantErr: "not both",
+		},
+		{
+			name:    "invalid peer id",
+			req:     &pbrpc.BanPeerRequest{PeerId: "foo"},
+			wantErr: "Unable to parse provided peer id",
+		},
+		{
+			name:    "invalid ip range",
+			req:     &pbrpc.BanPeerRequest{IpRange: "10.0.0.0"},
+			wantErr: "Unable to parse provided IP range",
+		},
+		{
+			name:    "duration overflow",
+			req:     &pbrpc.BanPeerRequest{PeerId: firstPeer.String(), DurationSeconds: 1 << 62},
+			wantErr: "too long",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ds.BanPeer(context.Background(), tt.req)
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestDebugServer_DialPeer(t *testing.T) {
+	pid := peer.ID("dialed")
+	peerAdmin := &mockP2p.MockPeerAdmin{DialedPeer: pid}
+	ds := &Server{PeerAdmin: peerAdmin}
+
+	addr := "/ip4/127.0.0.1/tcp/13000/p2p/16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+	res, err := ds.DialPeer(context.Background(), &pbrpc.DialPeerRequest{Addr: addr})
+	require.NoError(t, err)
+	assert.Equal(t, pid.String(), res.PeerId)
+	assert.DeepEqual(t, []string{addr}, peerAdmin.DialedAddrs)
+
+	_, err = ds.DialPeer(context.Background(), &pbrpc.DialPeerRequest{})
+	assert.ErrorContains(t, "Expected a multiaddr or ENR", err)
+}
+
+func TestDebugServer_DisconnectPeer(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	peerAdmin := &mockP2p.MockPeerAdmin{}
+	ds := &Server{PeerAdmin: peerAdmin}
+	firstPeer := peersProvider.Peers().All()[0]
+
+	_, err := ds.DisconnectPeer(context.Background(), &pbrpc.DisconnectPeerRequest{
+		PeerId:      firstPeer.String(),
+		GoodbyeCode: uint64(p2ptypes.GoodbyeCodeTooManyPeers),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, p2ptypes.GoodbyeCodeTooManyPeers, peerAdmin.Disconnected[firstPeer])
+
+	_, err = ds.DisconnectPeer(context.Background(), &pbrpc.DisconnectPeerRequest{PeerId: "foo"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -45,10 +46,28 @@ type Server struct {
 }
 
 // GetSyncStatus checks the current network sync status of the node.
-func (ns *Server) GetSyncStatus(_ context.Context, _ *empty.Empty) (*ethpb.SyncStatus, error) {
-	return &ethpb.SyncStatus{
+func (ns *Server) GetSyncStatus(ctx context.Context, _ *empty.Empty) (*ethpb.SyncStatus, error) {
+	res := &ethpb.SyncStatus{
 		Syncing: ns.SyncChecker.Syncing(),
-	}, nil
+	}
+	// Nodes synced from a checkpoint backfill the blocks before it down to genesis.
+	backfillRoot, err := ns.BeaconDB.BackfillBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundBackfillBlockRoot) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve backfill block root: %v", err)
+	}
+	blk, err := ns.BeaconDB.Block(ctx, backfillRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve backfill block: %v", err)
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, status.Errorf(codes.Internal, "Could not find backfill block with root %#x", backfillRoot)
+	}
+	res.BackfillSlot = blk.Block().Slot()
+	res.Backfilling = res.BackfillSlot > 0
+	return res, nil
 }
 
 // GetGenesis fetches genesis chain information of Ethereum. Returns unix timestamp 0
@@ -8,12 +8,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -29,16 +31,46 @@ func TestNodeServer_GetSyncStatus(t *testing.T) {
 	mSync := &mockSync.Sync{IsSyncing: false}
 	ns := &Server{
 		SyncChecker: mSync,
+		BeaconDB:    dbutil.SetupDB(t),
 	}
 	res, err := ns.GetSyncStatus(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, false, res.Syncing)
+	assert.Equal(t, false, res.Backfilling)
 	ns.SyncChecker = &mockSync.Sync{IsSyncing: true}
 	res, err = ns.GetSyncStatus(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, true, res.Syncing)
 }
 
+func TestNodeServer_GetSyncStatus_Backfilling(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	genesis, err := beaconDB.GenesisState(ctx)
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(100))
+	if genesis != nil && !genesis.IsNil() {
+		require.NoError(t, st.SetGenesisValidatorRoot(genesis.GenesisValidatorRoot()))
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 100
+	blk.Block.StateRoot = stateRoot[:]
+	require.NoError(t, beaconDB.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+
+	ns := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		BeaconDB:    beaconDB,
+	}
+	res, err := ns.GetSyncStatus(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Backfilling)
+	assert.Equal(t, types.Slot(100), res.BackfillSlot)
+}
+
 func TestNodeServer_GetGenesis(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
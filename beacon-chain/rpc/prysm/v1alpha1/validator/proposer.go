@@ -12,6 +12,7 @@ import (
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
@@ -512,24 +513,34 @@ func (vs *Server) depositTrie(ctx context.Context, canonicalEth1Data *ethpb.Eth1
 }
 
 // rebuilds our deposit trie by recreating it from all processed deposits till
-// specified eth1 block height.
+// specified eth1 block height. The deposits covered by the deposit snapshot may have
+// been pruned, so they are restored from the snapshot's finalized hashes instead.
 func (vs *Server) rebuildDepositTrie(ctx context.Context, canonicalEth1Data *ethpb.Eth1Data, canonicalEth1DataHeight *big.Int) (*trieutil.SparseMerkleTrie, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.rebuildDepositTrie")
 	defer span.End()
 
-	deposits := vs.DepositFetcher.AllDeposits(ctx, canonicalEth1DataHeight)
-	trieItems := make([][]byte, 0, len(deposits))
-	for _, dep := range deposits {
-		depHash, err := dep.Data.HashTreeRoot()
-		if err != nil {
-			return nil, errors.Wrap(err, "could not hash deposit data")
-		}
-		trieItems = append(trieItems, depHash[:])
+	var depositTrie *trieutil.SparseMerkleTrie
+	var err error
+	snapshotCount := int64(0)
+	if snapshot := vs.DepositFetcher.DepositSnapshot(ctx); snapshot != nil {
+		depositTrie, err = depositcache.DepositTrieFromSnapshot(snapshot)
+		snapshotCount = int64(snapshot.DepositCount)
+	} else {
+		depositTrie, err = trieutil.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
 	}
-	depositTrie, err := trieutil.GenerateTrieFromItems(trieItems, params.BeaconConfig().DepositContractTreeDepth)
 	if err != nil {
 		return nil, err
 	}
+	for _, ctr := range vs.DepositFetcher.AllDepositContainers(ctx) {
+		if ctr.Index < snapshotCount || (canonicalEth1DataHeight != nil && ctr.Eth1BlockHeight > canonicalEth1DataHeight.Uint64()) {
+			continue
+		}
+		depHash, err := ctr.Deposit.Data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash deposit data")
+		}
+		depositTrie.Insert(depHash[:], int(ctr.Index))
+	}
 
 	valid, err := vs.validateDepositTrie(depositTrie, canonicalEth1Data)
 	// Log an error here, as even with rebuilding the trie, it is still invalid.
@@ -1122,6 +1122,55 @@ func TestProposer_DepositTrie_RebuildTrie(t *testing.T) {
 
 }
 
+func TestProposer_DepositTrie_RebuildTrieAfterPruning(t *testing.T) {
+	ctx := context.Background()
+
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	depositTrie, err := trieutil.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err, "Could not setup deposit trie")
+	for i := 0; i < 6; i++ {
+		dp := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				Signature:             make([]byte, 96),
+				WithdrawalCredentials: make([]byte, 32),
+			}}
+		depositHash, err := dp.Data.HashTreeRoot()
+		require.NoError(t, err, "Unable to determine hashed value of deposit")
+		depositTrie.Insert(depositHash[:], i)
+		// Using the deposit index as the block number for this test.
+		require.NoError(t, depositCache.InsertDeposit(ctx, dp, uint64(i), int64(i), depositTrie.Root()))
+	}
+	depositCache.InsertFinalizedDeposits(ctx, 3)
+	require.NoError(t, depositCache.SnapshotFinalizedDeposits(ctx, 4))
+	depositCache.PruneFinalizedDeposits(ctx)
+	require.Equal(t, 2, len(depositCache.AllDeposits(ctx, nil)))
+
+	bs := &Server{DepositFetcher: depositCache}
+	root := depositTrie.Root()
+	trie, err := bs.rebuildDepositTrie(ctx, &ethpb.Eth1Data{
+		DepositRoot:  root[:],
+		DepositCount: 6,
+	}, big.NewInt(5))
+	require.NoError(t, err)
+	valid, err := bs.validateDepositTrie(trie, &ethpb.Eth1Data{DepositRoot: root[:], DepositCount: 6})
+	require.NoError(t, err)
+	assert.Equal(t, true, valid)
+
+	// The proofs of the deposits after the snapshot match the ones of the full trie.
+	wantProof, err := depositTrie.MerkleProof(5)
+	require.NoError(t, err)
+	proof, err := trie.MerkleProof(5)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantProof, proof)
+
+	// Deposits after the eth1 block height are left out.
+	trie, err = bs.rebuildDepositTrie(ctx, &ethpb.Eth1Data{}, big.NewInt(4))
+	require.NoError(t, err)
+	assert.Equal(t, 5, trie.NumOfItems())
+}
+
 func TestProposer_ValidateDepositTrie(t *testing.T) {
 	tt := []struct {
 		name            string
@@ -0,0 +1,44 @@
+// Package validator defines a gRPC validator service implementation of the Altair
+// validator API, providing the endpoints validator clients use to perform their
+// sync committee duties.
+package validator
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC Validator Altair service,
+// providing RPC endpoints for the sync committee duties of validators. Altair
+// blocks are not produced by the beacon node yet, the block endpoints of the
+// service are unimplemented.
+type Server struct {
+	Ctx               context.Context
+	HeadFetcher       blockchain.HeadFetcher
+	SyncChecker       sync.Checker
+	P2P               p2p.Broadcaster
+	SyncCommitteePool synccommittee.Pool
+}
+
+// GetBlock is not supported yet.
+func (vs *Server) GetBlock(_ context.Context, _ *ethpb.BlockRequest) (*prysmv2.BeaconBlockAltair, error) {
+	return nil, status.Error(codes.Unimplemented, "Altair block production is not supported yet")
+}
+
+// ProposeBlock is not supported yet.
+func (vs *Server) ProposeBlock(_ context.Context, _ *prysmv2.SignedBeaconBlockAltair) (*ethpb.ProposeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "Altair block production is not supported yet")
+}
+
+// StreamBlocks is not supported yet.
+func (vs *Server) StreamBlocks(_ *ethpb.StreamBlocksRequest, _ prysmv2.BeaconNodeValidatorAltair_StreamBlocksServer) error {
+	return status.Error(codes.Unimplemented, "Altair block streaming is not supported yet")
+}
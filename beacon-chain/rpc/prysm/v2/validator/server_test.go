@@ -0,0 +1,7 @@
+package validator
+
+import (
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+)
+
+var _ prysmv2.BeaconNodeValidatorAltairServer = (*Server)(nil)
@@ -0,0 +1,174 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bls/common"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetSyncMessageBlockRoot returns the head block root, which sync committee members sign
+// in their sync committee messages.
+func (vs *Server) GetSyncMessageBlockRoot(ctx context.Context, _ *emptypb.Empty) (*prysmv2.SyncMessageBlockRootResponse, error) {
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	r, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
+	}
+	return &prysmv2.SyncMessageBlockRootResponse{Root: r}, nil
+}
+
+// SubmitSyncMessage broadcasts the sync committee message on every subnet of the validator
+// and saves it into the sync committee pool, to be aggregated into contributions.
+func (vs *Server) SubmitSyncMessage(ctx context.Context, msg *prysmv2.SyncCommitteeMessage) (*emptypb.Empty, error) {
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	if headState.Version() == version.Phase0 {
+		return nil, status.Error(codes.FailedPrecondition, "Sync committees are not available before Altair")
+	}
+	subnets, err := altair.SubnetsForSyncCommittee(headState, msg.ValidatorIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute sync committee subnets: %v", err)
+	}
+	if len(subnets) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Validator %d is not in the sync committee", msg.ValidatorIndex)
+	}
+	for _, subnet := range subnets {
+		if err := vs.P2P.BroadcastSyncCommitteeMessage(ctx, subnet, msg); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not broadcast sync committee message: %v", err)
+		}
+	}
+	if err := vs.SyncCommitteePool.SaveSyncCommitteeMessage(msg); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save sync committee message: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSyncSubcommitteeIndex returns the positions of the validator in the sync committee of
+// the period the requested slot belongs to. Validators outside of the committee have no positions.
+func (vs *Server) GetSyncSubcommitteeIndex(ctx context.Context, req *prysmv2.SyncSubcommitteeIndexRequest) (*prysmv2.SyncSubcommitteeIndexResponse, error) {
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	committee, err := syncCommitteeAtSlot(headState, req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not retrieve sync committee: %v", err)
+	}
+	indices := make([]uint64, 0)
+	for i, pubkey := range committee.Pubkeys {
+		if bytes.Equal(pubkey, req.PublicKey) {
+			indices = append(indices, uint64(i))
+		}
+	}
+	return &prysmv2.SyncSubcommitteeIndexResponse{Indices: indices}, nil
+}
+
+// GetSyncCommitteeContribution aggregates the sync committee messages of the subcommittee
+// which signed the head block root at the requested slot into a contribution.
+func (vs *Server) GetSyncCommitteeContribution(ctx context.Context, req *prysmv2.SyncCommitteeContributionRequest) (*prysmv2.SyncCommitteeContribution, error) {
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	msgs, err := vs.SyncCommitteePool.SyncCommitteeMessages(req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync committee messages: %v", err)
+	}
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
+	}
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	committee, err := syncCommitteeAtSlot(headState, req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not retrieve sync committee: %v", err)
+	}
+	subCommitteePubkeys, err := altair.SyncSubCommitteePubkeys(committee, types.CommitteeIndex(req.SubnetId))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get sync subcommittee: %v", err)
+	}
+
+	// A validator holding several positions in the subcommittee contributes its signature once per position.
+	bits := prysmv2.NewSyncCommitteeAggregationBits()
+	sigs := make([]common.Signature, 0, len(subCommitteePubkeys))
+	for _, msg := range msgs {
+		if !bytes.Equal(msg.BlockRoot, headRoot) {
+			continue
+		}
+		pubkey := headState.PubkeyAtIndex(msg.ValidatorIndex)
+		for i, p := range subCommitteePubkeys {
+			if !bytes.Equal(p, pubkey[:]) || bits.BitAt(uint64(i)) {
+				continue
+			}
+			sig, err := bls.SignatureFromBytes(msg.Signature)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not decode sync committee message signature: %v", err)
+			}
+			bits.SetBitAt(uint64(i), true)
+			sigs = append(sigs, sig)
+		}
+	}
+	aggregatedSig := common.InfiniteSignature[:]
+	if len(sigs) > 0 {
+		aggregatedSig = bls.AggregateSignatures(sigs).Marshal()
+	}
+
+	return &prysmv2.SyncCommitteeContribution{
+		Slot:              req.Slot,
+		BlockRoot:         headRoot,
+		SubcommitteeIndex: req.SubnetId,
+		AggregationBits:   bits,
+		Signature:         aggregatedSig,
+	}, nil
+}
+
+// SubmitSignedContributionAndProof saves the contribution of the aggregator into the sync
+// committee pool and broadcasts the signed contribution and proof to the network.
+func (vs *Server) SubmitSignedContributionAndProof(ctx context.Context, s *prysmv2.SignedContributionAndProof) (*emptypb.Empty, error) {
+	if s.Message == nil || s.Message.Contribution == nil {
+		return nil, status.Error(codes.InvalidArgument, "Signed contribution and proof is missing its contribution")
+	}
+	if err := vs.SyncCommitteePool.SaveSyncCommitteeContribution(s.Message.Contribution); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save sync committee contribution: %v", err)
+	}
+	if err := vs.P2P.Broadcast(ctx, s); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast signed contribution and proof: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// syncCommitteeAtSlot returns the sync committee of the period the slot belongs to. Only the
+// current and the next sync committees are known to the state.
+func syncCommitteeAtSlot(st state.BeaconState, slot types.Slot) (*statepb.SyncCommittee, error) {
+	if st.Version() == version.Phase0 {
+		return nil, fmt.Errorf("sync committees are not available before Altair")
+	}
+	statePeriod := helpers.SyncCommitteePeriod(helpers.CurrentEpoch(st))
+	period := helpers.SyncCommitteePeriod(helpers.SlotToEpoch(slot))
+	switch period {
+	case statePeriod:
+		return st.CurrentSyncCommittee()
+	case statePeriod + 1:
+		return st.NextSyncCommittee()
+	}
+	return nil, fmt.Errorf("sync committee of period %d is unknown at period %d", period, statePeriod)
+}
@@ -0,0 +1,227 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	p2pMock "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bls/common"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func deterministicAltairState(t *testing.T, numValidators uint64) (state.BeaconState, []bls.SecretKey) {
+	st, keys := testutil.DeterministicGenesisState(t, numValidators)
+	altairState, err := altair.UpgradeToAltair(context.Background(), st)
+	require.NoError(t, err)
+	return altairState, keys
+}
+
+func TestGetSyncMessageBlockRoot(t *testing.T) {
+	root := bytesOf(0xaa)
+	server := &Server{
+		HeadFetcher: &mockChain.ChainService{Root: root},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	res, err := server.GetSyncMessageBlockRoot(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, root, res.Root)
+}
+
+func TestGetSyncMessageBlockRoot_Syncing(t *testing.T) {
+	server := &Server{
+		HeadFetcher: &mockChain.ChainService{},
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	}
+	_, err := server.GetSyncMessageBlockRoot(context.Background(), &emptypb.Empty{})
+	assert.ErrorContains(t, "Syncing to latest head", err)
+}
+
+func TestSubmitSyncMessage_OK(t *testing.T) {
+	st, _ := deterministicAltairState(t, 64)
+	broadcaster := &p2pMock.MockBroadcaster{}
+	pool := synccommittee.NewStore()
+	server := &Server{
+		HeadFetcher:       &mockChain.ChainService{State: st},
+		P2P:               broadcaster,
+		SyncCommitteePool: pool,
+	}
+	msg := &prysmv2.SyncCommitteeMessage{
+		Slot:           1,
+		ValidatorIndex: 2,
+		BlockRoot:      bytesOf(0xaa),
+		Signature:      make([]byte, 96),
+	}
+	_, err := server.SubmitSyncMessage(context.Background(), msg)
+	require.NoError(t, err)
+
+	subnets, err := altair.SubnetsForSyncCommittee(st, 2)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+	assert.Equal(t, len(subnets), len(broadcaster.BroadcastMessages))
+	saved, err := pool.SyncCommitteeMessages(1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(saved))
+	assert.DeepEqual(t, msg, saved[0])
+}
+
+func TestGetSyncSubcommitteeIndex(t *testing.T) {
+	st, _ := deterministicAltairState(t, 64)
+	server := &Server{
+		HeadFetcher: &mockChain.ChainService{State: st},
+	}
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	pubkey := st.PubkeyAtIndex(3)
+	want := make([]uint64, 0)
+	for i, p := range committee.Pubkeys {
+		if bytes.Equal(p, pubkey[:]) {
+			want = append(want, uint64(i))
+		}
+	}
+	require.NotEqual(t, 0, len(want))
+
+	res, err := server.GetSyncSubcommitteeIndex(context.Background(), &prysmv2.SyncSubcommitteeIndexRequest{
+		PublicKey: pubkey[:],
+		Slot:      1,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, res.Indices)
+
+	res, err = server.GetSyncSubcommitteeIndex(context.Background(), &prysmv2.SyncSubcommitteeIndexRequest{
+		PublicKey: bytesOf(0x01),
+		Slot:      1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Indices))
+}
+
+func TestGetSyncSubcommitteeIndex_UnknownPeriod(t *testing.T) {
+	st, _ := deterministicAltairState(t, 64)
+	server := &Server{
+		HeadFetcher: &mockChain.ChainService{State: st},
+	}
+	pubkey := st.PubkeyAtIndex(3)
+	slot := types.Slot(2*uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod)) * params.BeaconConfig().SlotsPerEpoch
+	_, err := server.GetSyncSubcommitteeIndex(context.Background(), &prysmv2.SyncSubcommitteeIndexRequest{
+		PublicKey: pubkey[:],
+		Slot:      slot,
+	})
+	assert.ErrorContains(t, "sync committee of period 2 is unknown", err)
+}
+
+func TestGetSyncCommitteeContribution(t *testing.T) {
+	st, keys := deterministicAltairState(t, 64)
+	headRoot := bytesOf(0xaa)
+	pool := synccommittee.NewStore()
+	server := &Server{
+		HeadFetcher:       &mockChain.ChainService{State: st, Root: headRoot},
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		SyncCommitteePool: pool,
+	}
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	subCommitteePubkeys, err := altair.SyncSubCommitteePubkeys(committee, 1)
+	require.NoError(t, err)
+
+	// Every validator signs the head root, validator 0 also signs another root which is left out.
+	sigs := make([]common.Signature, 0)
+	wantBits := prysmv2.NewSyncCommitteeAggregationBits()
+	for i := types.ValidatorIndex(0); i < 64; i++ {
+		sig := keys[i].Sign(headRoot)
+		require.NoError(t, pool.SaveSyncCommitteeMessage(&prysmv2.SyncCommitteeMessage{
+			Slot:           1,
+			BlockRoot:      headRoot,
+			ValidatorIndex: i,
+			Signature:      sig.Marshal(),
+		}))
+		pubkey := st.PubkeyAtIndex(i)
+		for j, p := range subCommitteePubkeys {
+			if bytes.Equal(p, pubkey[:]) {
+				wantBits.SetBitAt(uint64(j), true)
+				sigs = append(sigs, sig)
+			}
+		}
+	}
+	require.NoError(t, pool.SaveSyncCommitteeMessage(&prysmv2.SyncCommitteeMessage{
+		Slot:           1,
+		BlockRoot:      bytesOf(0xbb),
+		ValidatorIndex: 0,
+		Signature:      keys[0].Sign(bytesOf(0xbb)).Marshal(),
+	}))
+
+	res, err := server.GetSyncCommitteeContribution(context.Background(), &prysmv2.SyncCommitteeContributionRequest{
+		Slot:     1,
+		SubnetId: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), res.Slot)
+	assert.Equal(t, uint64(1), res.SubcommitteeIndex)
+	assert.DeepEqual(t, headRoot, res.BlockRoot)
+	assert.DeepEqual(t, []byte(wantBits), []byte(res.AggregationBits))
+	assert.DeepEqual(t, bls.AggregateSignatures(sigs).Marshal(), res.Signature)
+}
+
+func TestGetSyncCommitteeContribution_NoMessages(t *testing.T) {
+	st, _ := deterministicAltairState(t, 64)
+	server := &Server{
+		HeadFetcher:       &mockChain.ChainService{State: st, Root: bytesOf(0xaa)},
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		SyncCommitteePool: synccommittee.NewStore(),
+	}
+	res, err := server.GetSyncCommitteeContribution(context.Background(), &prysmv2.SyncCommitteeContributionRequest{
+		Slot:     1,
+		SubnetId: 0,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), res.AggregationBits.Count())
+	assert.DeepEqual(t, common.InfiniteSignature[:], res.Signature)
+}
+
+func TestSubmitSignedContributionAndProof_OK(t *testing.T) {
+	broadcaster := &p2pMock.MockBroadcaster{}
+	pool := synccommittee.NewStore()
+	server := &Server{
+		P2P:               broadcaster,
+		SyncCommitteePool: pool,
+	}
+	contribution := &prysmv2.SyncCommitteeContribution{
+		Slot:              1,
+		BlockRoot:         bytesOf(0xaa),
+		SubcommitteeIndex: 2,
+		AggregationBits:   prysmv2.NewSyncCommitteeAggregationBits(),
+		Signature:         make([]byte, 96),
+	}
+	s := &prysmv2.SignedContributionAndProof{
+		Message: &prysmv2.ContributionAndProof{
+			AggregatorIndex: 5,
+			Contribution:    contribution,
+			SelectionProof:  make([]byte, 96),
+		},
+		Signature: make([]byte, 96),
+	}
+	_, err := server.SubmitSignedContributionAndProof(context.Background(), s)
+	require.NoError(t, err)
+
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+	saved, err := pool.SyncCommitteeContributions(1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(saved))
+	assert.DeepEqual(t, contribution, saved[0])
+}
+
+func bytesOf(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
@@ -0,0 +1,344 @@
+package slasher
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var (
+	_ = Chunker(&MinSpanChunksSlice{})
+	_ = Chunker(&MaxSpanChunksSlice{})
+)
+
+func TestMinSpanChunksSlice_Chunk(t *testing.T) {
+	chunk := EmptyMinSpanChunksSlice(&Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+	})
+	wanted := []uint16{math.MaxUint16, math.MaxUint16, math.MaxUint16, math.MaxUint16}
+	require.DeepEqual(t, wanted, chunk.Chunk())
+}
+
+func TestMaxSpanChunksSlice_Chunk(t *testing.T) {
+	chunk := EmptyMaxSpanChunksSlice(&Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+	})
+	wanted := []uint16{0, 0, 0, 0}
+	require.DeepEqual(t, wanted, chunk.Chunk())
+}
+
+func TestMinSpanChunksSlice_NeutralElement(t *testing.T) {
+	chunk := EmptyMinSpanChunksSlice(&Parameters{})
+	require.Equal(t, uint16(math.MaxUint16), chunk.NeutralElement())
+}
+
+func TestMaxSpanChunksSlice_NeutralElement(t *testing.T) {
+	chunk := EmptyMaxSpanChunksSlice(&Parameters{})
+	require.Equal(t, uint16(0), chunk.NeutralElement())
+}
+
+func TestMinSpanChunksSlice_MinChunkSpansSliceFrom(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+	}
+	_, err := MinChunkSpansSliceFrom(params, []uint16{})
+	require.ErrorContains(t, "chunk has wrong length", err)
+
+	data := []uint16{2, 2, 2, 2, 2, 2}
+	chunk, err := MinChunkSpansSliceFrom(params, data)
+	require.NoError(t, err)
+	require.DeepEqual(t, data, chunk.Chunk())
+}
+
+func TestMaxSpanChunksSlice_MaxChunkSpansSliceFrom(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+	}
+	_, err := MaxChunkSpansSliceFrom(params, []uint16{})
+	require.ErrorContains(t, "chunk has wrong length", err)
+
+	data := []uint16{2, 2, 2, 2, 2, 2}
+	chunk, err := MaxChunkSpansSliceFrom(params, data)
+	require.NoError(t, err)
+	require.DeepEqual(t, data, chunk.Chunk())
+}
+
+func TestMinSpanChunksSlice_CheckSlashable(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupSlasherDB(t)
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      3,
+	}
+	validatorIdx := types.ValidatorIndex(1)
+	source := types.Epoch(1)
+	target := types.Epoch(2)
+	att := createAttestationWrapper(source, target, nil, nil)
+
+	// A faulty chunk should lead to error.
+	chunk := &MinSpanChunksSlice{
+		params: params,
+		data:   []uint16{},
+	}
+	_, err := chunk.CheckSlashable(ctx, nil, validatorIdx, att)
+	require.ErrorContains(t, "could not get min target for validator", err)
+
+	// We initialize a proper slice with 2 chunks with chunk size 3, 2 validators per chunk:
+	// [2, 2, 2, 2, 2, 2]
+	data := []uint16{2, 2, 2, 2, 2, 2}
+	chunk, err = MinChunkSpansSliceFrom(params, data)
+	require.NoError(t, err)
+
+	// An attestation with source 1 and target 2 should not be slashable
+	// based on our min chunk for either validator.
+	slashing, err := chunk.CheckSlashable(ctx, beaconDB, validatorIdx, att)
+	require.NoError(t, err)
+	require.Equal(t, true, slashing == nil)
+
+	// Next up we initialize an empty chunk and set the min target of
+	// validator 1 at epoch 1 to 3 (a distance of 2).
+	chunk = EmptyMinSpanChunksSlice(params)
+	require.NoError(t, setChunkDataAtEpoch(params, chunk.data, validatorIdx, source, target+1))
+
+	// An attestation with source 1 and target 4 surrounds a previous attestation
+	// with target 3, however we have no existing record of it on disk, so it is not slashable.
+	surroundingVote := createAttestationWrapper(source, target+2, nil, nil)
+	slashing, err = chunk.CheckSlashable(ctx, beaconDB, validatorIdx, surroundingVote)
+	require.NoError(t, err)
+	require.Equal(t, true, slashing == nil)
+
+	// We then save the surrounded attestation record to disk and verify
+	// the surrounding vote is now detected as slashable.
+	existingAtt := createAttestationWrapper(source+1, target+1, []uint64{uint64(validatorIdx)}, []byte{1})
+	err = beaconDB.SaveAttestationRecordsForValidators(
+		ctx, []*slashertypes.IndexedAttestationWrapper{existingAtt},
+	)
+	require.NoError(t, err)
+	slashing, err = chunk.CheckSlashable(ctx, beaconDB, validatorIdx, surroundingVote)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	assert.DeepEqual(t, surroundingVote.IndexedAttestation, slashing.Attestation_1)
+	assert.DeepEqual(t, existingAtt.IndexedAttestation, slashing.Attestation_2)
+}
+
+func TestMaxSpanChunksSlice_CheckSlashable(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupSlasherDB(t)
+	params := &Parameters{
+		chunkSize:          4,
+		validatorChunkSize: 2,
+		historyLength:      4,
+	}
+	validatorIdx := types.ValidatorIndex(1)
+	source := types.Epoch(1)
+	target := types.Epoch(2)
+	att := createAttestationWrapper(source, target, nil, nil)
+
+	// A faulty chunk should lead to error.
+	chunk := &MaxSpanChunksSlice{
+		params: params,
+		data:   []uint16{},
+	}
+	_, err := chunk.CheckSlashable(ctx, nil, validatorIdx, att)
+	require.ErrorContains(t, "could not get max target for validator", err)
+
+	// An empty chunk should not lead to any slashable offenses.
+	chunk = EmptyMaxSpanChunksSlice(params)
+	slashing, err := chunk.CheckSlashable(ctx, beaconDB, validatorIdx, att)
+	require.NoError(t, err)
+	require.Equal(t, true, slashing == nil)
+
+	// Next up we set the max target of validator 1 at epoch 1 to 3 (a distance of 2).
+	require.NoError(t, setChunkDataAtEpoch(params, chunk.data, validatorIdx, source, target+1))
+
+	// An attestation with source 1 and target 2 is surrounded by a previous attestation
+	// with target 3, however we have no existing record of it on disk, so it is not slashable.
+	slashing, err = chunk.CheckSlashable(ctx, beaconDB, validatorIdx, att)
+	require.NoError(t, err)
+	require.Equal(t, true, slashing == nil)
+
+	// We then save the surrounding attestation record to disk and verify
+	// the surrounded vote is now detected as slashable.
+	existingAtt := createAttestationWrapper(source-1, target+1, []uint64{uint64(validatorIdx)}, []byte{1})
+	err = beaconDB.SaveAttestationRecordsForValidators(
+		ctx, []*slashertypes.IndexedAttestationWrapper{existingAtt},
+	)
+	require.NoError(t, err)
+	slashing, err = chunk.CheckSlashable(ctx, beaconDB, validatorIdx, att)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	assert.DeepEqual(t, existingAtt.IndexedAttestation, slashing.Attestation_1)
+	assert.DeepEqual(t, att.IndexedAttestation, slashing.Attestation_2)
+}
+
+func TestMinSpanChunksSlice_Update_MultipleChunks(t *testing.T) {
+	// Let's set H = historyLength = 4, meaning a min span will hold 4 epochs worth of
+	// attesting history. Then we set C = 2 meaning we will chunk the min span into
+	// arrays each of length 2 and K = 3 meaning we store each chunk index for 3
+	// validators at a time.
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 3,
+		historyLength:      4,
+	}
+	chunk := EmptyMinSpanChunksSlice(params)
+	target := types.Epoch(3)
+	chunkIdx := uint64(1)
+	validatorIdx := types.ValidatorIndex(0)
+	startEpoch := types.Epoch(3)
+	currentEpoch := types.Epoch(3)
+	keepGoing, err := chunk.Update(chunkIdx, currentEpoch, validatorIdx, startEpoch, target)
+	require.NoError(t, err)
+
+	// We should keep going! We still have to update the data for chunk index 0.
+	require.Equal(t, true, keepGoing)
+	want := []uint16{1, 0, math.MaxUint16, math.MaxUint16, math.MaxUint16, math.MaxUint16}
+	require.DeepEqual(t, want, chunk.Chunk())
+
+	// Now we update for chunk index 0.
+	chunk = EmptyMinSpanChunksSlice(params)
+	chunkIdx = uint64(0)
+	validatorIdx = types.ValidatorIndex(0)
+	startEpoch = types.Epoch(1)
+	currentEpoch = types.Epoch(3)
+	keepGoing, err = chunk.Update(chunkIdx, currentEpoch, validatorIdx, startEpoch, target)
+	require.NoError(t, err)
+	require.Equal(t, false, keepGoing)
+	want = []uint16{3, 2, math.MaxUint16, math.MaxUint16, math.MaxUint16, math.MaxUint16}
+	require.DeepEqual(t, want, chunk.Chunk())
+}
+
+func TestMaxSpanChunksSlice_Update_MultipleChunks(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 3,
+		historyLength:      4,
+	}
+	chunk := EmptyMaxSpanChunksSlice(params)
+	target := types.Epoch(3)
+	chunkIdx := uint64(0)
+	validatorIdx := types.ValidatorIndex(0)
+	startEpoch := types.Epoch(0)
+	currentEpoch := types.Epoch(3)
+	keepGoing, err := chunk.Update(chunkIdx, currentEpoch, validatorIdx, startEpoch, target)
+	require.NoError(t, err)
+
+	// We should keep going! We still have to update the data for chunk index 1.
+	require.Equal(t, true, keepGoing)
+	want := []uint16{3, 2, 0, 0, 0, 0}
+	require.DeepEqual(t, want, chunk.Chunk())
+
+	// Now we update for chunk index 1.
+	chunk = EmptyMaxSpanChunksSlice(params)
+	chunkIdx = uint64(1)
+	startEpoch = types.Epoch(2)
+	keepGoing, err = chunk.Update(chunkIdx, currentEpoch, validatorIdx, startEpoch, target)
+	require.NoError(t, err)
+	require.Equal(t, false, keepGoing)
+	want = []uint16{1, 0, 0, 0, 0, 0}
+	require.DeepEqual(t, want, chunk.Chunk())
+}
+
+func TestMinSpanChunksSlice_StartEpoch(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      3,
+	}
+	tests := []struct {
+		name           string
+		sourceEpoch    types.Epoch
+		currentEpoch   types.Epoch
+		wantEpoch      types.Epoch
+		shouldNotExist bool
+	}{
+		{
+			name:           "source epoch == 0 returns false",
+			sourceEpoch:    0,
+			shouldNotExist: true,
+		},
+		{
+			name:           "source epoch - 1 < lowest epoch in the history returns false",
+			sourceEpoch:    1,
+			currentEpoch:   4,
+			shouldNotExist: true,
+		},
+		{
+			name:         "source epoch within the history returns source epoch - 1",
+			sourceEpoch:  3,
+			currentEpoch: 4,
+			wantEpoch:    2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := EmptyMinSpanChunksSlice(params)
+			gotEpoch, gotExists := m.StartEpoch(tt.sourceEpoch, tt.currentEpoch)
+			assert.Equal(t, !tt.shouldNotExist, gotExists)
+			assert.Equal(t, tt.wantEpoch, gotEpoch)
+		})
+	}
+}
+
+func TestMaxSpanChunksSlice_StartEpoch(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      3,
+	}
+	m := EmptyMaxSpanChunksSlice(params)
+	_, exists := m.StartEpoch(3, 3)
+	assert.Equal(t, false, exists)
+	_, exists = m.StartEpoch(4, 3)
+	assert.Equal(t, false, exists)
+	epoch, exists := m.StartEpoch(1, 3)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(2), epoch)
+}
+
+func TestMinSpanChunksSlice_NextChunkStartEpoch(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      9,
+	}
+	m := EmptyMinSpanChunksSlice(params)
+	assert.Equal(t, types.Epoch(2), m.NextChunkStartEpoch(4))
+	assert.Equal(t, types.Epoch(2), m.NextChunkStartEpoch(3))
+	assert.Equal(t, types.Epoch(5), m.NextChunkStartEpoch(8))
+}
+
+func TestMaxSpanChunksSlice_NextChunkStartEpoch(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      9,
+	}
+	m := EmptyMaxSpanChunksSlice(params)
+	assert.Equal(t, types.Epoch(6), m.NextChunkStartEpoch(4))
+	assert.Equal(t, types.Epoch(6), m.NextChunkStartEpoch(5))
+	assert.Equal(t, types.Epoch(3), m.NextChunkStartEpoch(0))
+}
+
+func Test_epochDistance(t *testing.T) {
+	_, err := epochDistance(1, 2)
+	require.ErrorContains(t, "base epoch 2 cannot be greater than epoch 1", err)
+
+	_, err = epochDistance(math.MaxUint16+1, 0)
+	require.ErrorContains(t, "overflows uint16", err)
+
+	distance, err := epochDistance(5, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(3), distance)
+}
@@ -0,0 +1,436 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// chunkUpdateArgs are the arguments shared by the helpers which load, update and save
+// the min or max span chunks of a validator chunk index.
+type chunkUpdateArgs struct {
+	kind                slashertypes.ChunkKind
+	validatorChunkIndex uint64
+	currentEpoch        types.Epoch
+}
+
+// Takes in a list of indexed attestation wrappers and returns any
+// found attester slashings to the caller.
+func (s *Service) checkSlashableAttestations(
+	ctx context.Context, currentEpoch types.Epoch, atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.checkSlashableAttestations")
+	defer span.End()
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+
+	// Check for double votes.
+	doubleVoteSlashings, err := s.checkDoubleVotes(ctx, atts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check slashable double votes")
+	}
+	slashings = append(slashings, doubleVoteSlashings...)
+
+	// Check for surrounding and surrounded votes, one batch per validator chunk index.
+	groupedAtts := s.groupByValidatorChunkIndex(atts)
+	log.WithField("numBatches", len(groupedAtts)).Debug("Batching attestations by validator chunk index")
+	for validatorChunkIdx, batch := range groupedAtts {
+		attSlashings, err := s.detectAllAttesterSlashings(ctx, &chunkUpdateArgs{
+			validatorChunkIndex: validatorChunkIdx,
+			currentEpoch:        currentEpoch,
+		}, batch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not detect slashings for validator chunk index %d", validatorChunkIdx)
+		}
+		slashings = append(slashings, attSlashings...)
+	}
+	return slashings, nil
+}
+
+// Given a list of attestations all corresponding to a validator chunk index as well
+// as the current epoch in time, we perform slashing detection.
+// The process is as follows given a list of attestations:
+//
+// 1. Reset the spans of every validator in the chunk for all epochs written
+//    since the last time we performed detection for them.
+// 2. Group the attestations by chunk index.
+// 3. Update the min and max spans for those grouped attestations, check if any slashings are
+//    found in the process.
+// 4. Save the updated chunks and the latest written epoch for all validators
+//    in the chunk to the database.
+func (s *Service) detectAllAttesterSlashings(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	attestations []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	// Map of updated chunks by chunk index, which will be saved at the end.
+	updatedMinChunks, updatedMaxChunks := map[uint64]Chunker{}, map[uint64]Chunker{}
+	minArgs := &chunkUpdateArgs{
+		kind:                slashertypes.MinSpan,
+		validatorChunkIndex: args.validatorChunkIndex,
+		currentEpoch:        args.currentEpoch,
+	}
+	maxArgs := &chunkUpdateArgs{
+		kind:                slashertypes.MaxSpan,
+		validatorChunkIndex: args.validatorChunkIndex,
+		currentEpoch:        args.currentEpoch,
+	}
+
+	// Clear out stale data for epochs we have not written since the last detection round.
+	validatorIndices := s.params.validatorIndicesInChunk(args.validatorChunkIndex)
+	attestedEpochs, err := s.serviceCfg.Database.LastEpochWrittenForValidators(ctx, validatorIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last epoch written for validators")
+	}
+	for _, attestedEpoch := range attestedEpochs {
+		if err := s.epochUpdateForValidator(ctx, minArgs, updatedMinChunks, attestedEpoch); err != nil {
+			return nil, errors.Wrapf(err, "could not update min span epochs for validator %d", attestedEpoch.ValidatorIndex)
+		}
+		if err := s.epochUpdateForValidator(ctx, maxArgs, updatedMaxChunks, attestedEpoch); err != nil {
+			return nil, errors.Wrapf(err, "could not update max span epochs for validator %d", attestedEpoch.ValidatorIndex)
+		}
+	}
+
+	// Check for surrounding votes.
+	groupedAtts := s.groupByChunkIndex(attestations)
+	surroundingSlashings, err := s.updateSpans(ctx, updatedMinChunks, minArgs, groupedAtts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not update min attestation spans for validator chunk index %d", args.validatorChunkIndex)
+	}
+
+	// Check for surrounded votes.
+	surroundedSlashings, err := s.updateSpans(ctx, updatedMaxChunks, maxArgs, groupedAtts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not update max attestation spans for validator chunk index %d", args.validatorChunkIndex)
+	}
+
+	// Save the updated chunks and the latest epoch written into the database.
+	if err := s.saveUpdatedChunks(ctx, minArgs, updatedMinChunks); err != nil {
+		return nil, errors.Wrap(err, "could not save chunks for min spans")
+	}
+	if err := s.saveUpdatedChunks(ctx, maxArgs, updatedMaxChunks); err != nil {
+		return nil, errors.Wrap(err, "could not save chunks for max spans")
+	}
+	if err := s.serviceCfg.Database.SaveLastEpochWrittenForValidators(ctx, validatorIndices, args.currentEpoch); err != nil {
+		return nil, errors.Wrap(err, "could not save last epoch written for validators")
+	}
+
+	slashings := make([]*ethpb.AttesterSlashing, 0, len(surroundingSlashings)+len(surroundedSlashings))
+	slashings = append(slashings, surroundingSlashings...)
+	slashings = append(slashings, surroundedSlashings...)
+	return slashings, nil
+}
+
+// Check for attester slashing double votes by looking at every single validator index
+// in each attestation's attesting indices and checking if there already exist records for it,
+// both within the given batch of attestations and in the database.
+func (s *Service) checkDoubleVotes(
+	ctx context.Context, attestations []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.checkDoubleVotes")
+	defer span.End()
+	// We check if there are any slashable double votes in the input list
+	// of attestations with respect to each other.
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	existingAtts := make(map[string]*slashertypes.IndexedAttestationWrapper)
+	for _, att := range attestations {
+		for _, valIdx := range att.IndexedAttestation.AttestingIndices {
+			key := voteKey(att.IndexedAttestation.Data.Target.Epoch, valIdx)
+			existingAtt, ok := existingAtts[key]
+			if !ok {
+				existingAtts[key] = att
+				continue
+			}
+			if att.SigningRoot != existingAtt.SigningRoot {
+				doubleVotesTotal.Inc()
+				slashings = append(slashings, &ethpb.AttesterSlashing{
+					Attestation_1: existingAtt.IndexedAttestation,
+					Attestation_2: att.IndexedAttestation,
+				})
+			}
+		}
+	}
+
+	// We check if there are any slashable double votes in the input list
+	// of attestations with respect to our database.
+	doubleVotes, err := s.serviceCfg.Database.CheckAttesterDoubleVotes(ctx, attestations)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve potential double votes from disk")
+	}
+	for _, doubleVote := range doubleVotes {
+		doubleVotesTotal.Inc()
+		slashings = append(slashings, &ethpb.AttesterSlashing{
+			Attestation_1: doubleVote.PrevAttestationWrapper.IndexedAttestation,
+			Attestation_2: doubleVote.AttestationWrapper.IndexedAttestation,
+		})
+	}
+	return slashings, nil
+}
+
+// Resets the span of a validator to its neutral element for every epoch written since the
+// last epoch we performed detection for the validator, up to and including the current epoch.
+// Spans are stored in a ring of historyLength epochs, so any data we find for these epochs
+// belongs to an epoch historyLength epochs ago and must be discarded before it is used.
+func (s *Service) epochUpdateForValidator(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	updatedChunks map[uint64]Chunker,
+	attestedEpoch *slashertypes.AttestedEpochForValidator,
+) error {
+	epoch := attestedEpoch.Epoch + 1
+	if args.currentEpoch >= s.params.historyLength && epoch <= args.currentEpoch-s.params.historyLength {
+		epoch = args.currentEpoch - s.params.historyLength + 1
+	}
+	for epoch <= args.currentEpoch {
+		chunkIdx := s.params.chunkIndex(epoch)
+		currentChunk, err := s.getChunk(ctx, args, updatedChunks, chunkIdx)
+		if err != nil {
+			return err
+		}
+		for s.params.chunkIndex(epoch) == chunkIdx && epoch <= args.currentEpoch {
+			if err := setChunkRawDistance(
+				s.params,
+				currentChunk.Chunk(),
+				attestedEpoch.ValidatorIndex,
+				epoch,
+				currentChunk.NeutralElement(),
+			); err != nil {
+				return err
+			}
+			epoch++
+		}
+		updatedChunks[chunkIdx] = currentChunk
+	}
+	return nil
+}
+
+// Updates spans and detects any slashable attester offenses along the way.
+// 1. Determine the chunks we need to use for updating for the validator indices
+//    in a validator chunk index, then retrieve those chunks from the database.
+// 2. Using the chunks from step (1):
+//      for every attestation by chunk index:
+//        for each validator in the attestation's attesting indices:
+//          - Check if the attestation is slashable, if so return a slashing object.
+// 3. Save the updated chunks to disk.
+func (s *Service) updateSpans(
+	ctx context.Context,
+	updatedChunks map[uint64]Chunker,
+	args *chunkUpdateArgs,
+	attestationsByChunkIdx map[uint64][]*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.updateSpans")
+	defer span.End()
+	// Apply the attestations to the related chunks and find any
+	// slashings along the way.
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	for _, attestationBatch := range attestationsByChunkIdx {
+		for _, att := range attestationBatch {
+			for _, validatorIdx := range att.IndexedAttestation.AttestingIndices {
+				validatorIndex := types.ValidatorIndex(validatorIdx)
+				computedValidatorChunkIdx := s.params.validatorChunkIndex(validatorIndex)
+
+				// Every validator chunk index represents a range of validators.
+				// It is possible that the validator index in this loop iteration is
+				// not part of the validator chunk index we are updating chunks for.
+				//
+				// For example, if there are 4 validators per validator chunk index,
+				// then validator chunk index 0 contains validator indices [0, 1, 2, 3].
+				// If we see an attestation with attesting indices [3, 4, 5] and we are updating
+				// chunks for validator chunk index 0, only validator index 3 should make
+				// it past this line.
+				if args.validatorChunkIndex != computedValidatorChunkIdx {
+					continue
+				}
+				slashing, err := s.applyAttestationForValidator(
+					ctx, args, validatorIndex, updatedChunks, att,
+				)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not apply attestation for validator index %d", validatorIndex)
+				}
+				if slashing != nil {
+					slashings = append(slashings, slashing)
+				}
+			}
+		}
+	}
+	return slashings, nil
+}
+
+// Checks if an incoming attestation is slashable based on the validator chunk it
+// corresponds to. If a slashable offense is found, we return it to the caller.
+// If not, then update every single chunk the attestation covers, starting from its
+// source epoch up to its target.
+func (s *Service) applyAttestationForValidator(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	validatorIndex types.ValidatorIndex,
+	chunksByChunkIdx map[uint64]Chunker,
+	attestation *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	sourceEpoch := attestation.IndexedAttestation.Data.Source.Epoch
+	targetEpoch := attestation.IndexedAttestation.Data.Target.Epoch
+	chunkIdx := s.params.chunkIndex(sourceEpoch)
+	chunk, err := s.getChunk(ctx, args, chunksByChunkIdx, chunkIdx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get chunk at index %d", chunkIdx)
+	}
+
+	// Check slashable, if so, return the slashing.
+	slashing, err := chunk.CheckSlashable(
+		ctx,
+		s.serviceCfg.Database,
+		validatorIndex,
+		attestation,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"could not check if attestation for validator index %d is slashable",
+			validatorIndex,
+		)
+	}
+	if slashing != nil {
+		return slashing, nil
+	}
+
+	// Get the first start epoch for the chunk. If it does not exist or
+	// is not possible based on the input arguments, do not continue with the update.
+	startEpoch, exists := chunk.StartEpoch(sourceEpoch, args.currentEpoch)
+	if !exists {
+		return nil, nil
+	}
+
+	// Given a single attestation could span across multiple chunks
+	// for a validator min or max span, we attempt to update the current chunk
+	// for the source epoch of the attestation. If the update function tells
+	// us we need to proceed to the next chunk, we continue by determining
+	// the start epoch of the next chunk. We exit once we no longer need to
+	// keep updating chunks.
+	for {
+		chunkIdx = s.params.chunkIndex(startEpoch)
+		chunk, err := s.getChunk(ctx, args, chunksByChunkIdx, chunkIdx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get chunk at index %d", chunkIdx)
+		}
+		keepGoing, err := chunk.Update(
+			chunkIdx,
+			args.currentEpoch,
+			validatorIndex,
+			startEpoch,
+			targetEpoch,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"could not update chunk at chunk index %d for validator index %d and current epoch %d",
+				chunkIdx,
+				validatorIndex,
+				args.currentEpoch,
+			)
+		}
+		// We update the chunksByChunkIdx map with the chunk we just updated.
+		chunksByChunkIdx[chunkIdx] = chunk
+		if !keepGoing {
+			break
+		}
+		// Move to first epoch of next chunk if needed.
+		startEpoch = chunk.NextChunkStartEpoch(startEpoch)
+	}
+	return nil, nil
+}
+
+// Retrieves a chunk at a chunk index from a map. If such chunk does not exist, which
+// should be rare (occurring when we receive an attestation with source and target epochs
+// that span multiple chunk indices), then we fallback to fetching from disk.
+func (s *Service) getChunk(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	chunksByChunkIdx map[uint64]Chunker,
+	chunkIdx uint64,
+) (Chunker, error) {
+	chunk, ok := chunksByChunkIdx[chunkIdx]
+	if ok {
+		return chunk, nil
+	}
+	// We can ensure we load the appropriate chunk we need by fetching from the DB.
+	diskChunks, err := s.loadChunks(ctx, args, []uint64{chunkIdx})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load chunk at index %d", chunkIdx)
+	}
+	if chunk, ok := diskChunks[chunkIdx]; ok {
+		return chunk, nil
+	}
+	return nil, fmt.Errorf("could not retrieve chunk at chunk index %d from disk", chunkIdx)
+}
+
+// Load chunks for a specified list of chunk indices. We attempt to load it from the database.
+// If the data exists, then we initialize a chunk of a specified kind. Otherwise, we create
+// an empty chunk, add it to our map, and then return it to the caller.
+func (s *Service) loadChunks(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	chunkIndices []uint64,
+) (map[uint64]Chunker, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.loadChunks")
+	defer span.End()
+	chunkKeys := make([][]byte, 0, len(chunkIndices))
+	for _, chunkIdx := range chunkIndices {
+		chunkKeys = append(chunkKeys, s.params.flatSliceID(args.validatorChunkIndex, chunkIdx))
+	}
+	rawChunks, chunksExist, err := s.serviceCfg.Database.LoadSlasherChunks(ctx, args.kind, chunkKeys)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"could not load slasher chunk index for validator chunk index %d",
+			args.validatorChunkIndex,
+		)
+	}
+	chunksByChunkIdx := make(map[uint64]Chunker, len(rawChunks))
+	for i := 0; i < len(rawChunks); i++ {
+		// If the chunk exists in the database, we initialize it from the raw bytes data.
+		// If it does not exist, we initialize an empty chunk.
+		var chunk Chunker
+		switch args.kind {
+		case slashertypes.MinSpan:
+			if chunksExist[i] {
+				chunk, err = MinChunkSpansSliceFrom(s.params, rawChunks[i])
+			} else {
+				chunk = EmptyMinSpanChunksSlice(s.params)
+			}
+		case slashertypes.MaxSpan:
+			if chunksExist[i] {
+				chunk, err = MaxChunkSpansSliceFrom(s.params, rawChunks[i])
+			} else {
+				chunk = EmptyMaxSpanChunksSlice(s.params)
+			}
+		default:
+			return nil, fmt.Errorf("unknown chunk kind %d", args.kind)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize chunk")
+		}
+		chunksByChunkIdx[chunkIndices[i]] = chunk
+	}
+	return chunksByChunkIdx, nil
+}
+
+// Saves updated chunks to disk given the required database schema.
+func (s *Service) saveUpdatedChunks(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	updatedChunksByChunkIdx map[uint64]Chunker,
+) error {
+	ctx, span := trace.StartSpan(ctx, "slasher.saveUpdatedChunks")
+	defer span.End()
+	chunkKeys := make([][]byte, 0, len(updatedChunksByChunkIdx))
+	chunks := make([][]uint16, 0, len(updatedChunksByChunkIdx))
+	for chunkIdx, chunk := range updatedChunksByChunkIdx {
+		chunkKeys = append(chunkKeys, s.params.flatSliceID(args.validatorChunkIndex, chunkIdx))
+		chunks = append(chunks, chunk.Chunk())
+	}
+	return s.serviceCfg.Database.SaveSlasherChunks(ctx, args.kind, chunkKeys, chunks)
+}
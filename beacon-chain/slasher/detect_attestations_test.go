@@ -0,0 +1,183 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func Test_processQueuedAttestations(t *testing.T) {
+	type args struct {
+		attestationQueue []*slashertypes.IndexedAttestationWrapper
+		currentEpoch     types.Epoch
+	}
+	tests := []struct {
+		name                 string
+		previousAttestations []*slashertypes.IndexedAttestationWrapper
+		args                 args
+		wantSlashings        int
+	}{
+		{
+			name: "no slashings for consecutive attestations",
+			previousAttestations: []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(1, 2, []uint64{0, 1}, []byte{1}),
+			},
+			args: args{
+				attestationQueue: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(2, 3, []uint64{0, 1}, []byte{2}),
+				},
+				currentEpoch: 4,
+			},
+			wantSlashings: 0,
+		},
+		{
+			name: "detects surrounding vote",
+			previousAttestations: []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(1, 2, []uint64{0}, []byte{1}),
+			},
+			args: args{
+				attestationQueue: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(0, 3, []uint64{0}, []byte{2}),
+				},
+				currentEpoch: 4,
+			},
+			wantSlashings: 1,
+		},
+		{
+			name: "detects surrounded vote",
+			previousAttestations: []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(0, 3, []uint64{0}, []byte{1}),
+			},
+			args: args{
+				attestationQueue: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(1, 2, []uint64{0}, []byte{2}),
+				},
+				currentEpoch: 4,
+			},
+			wantSlashings: 1,
+		},
+		{
+			name: "detects surround vote across validator chunks",
+			previousAttestations: []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(1, 2, []uint64{3}, []byte{1}),
+			},
+			args: args{
+				attestationQueue: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(0, 3, []uint64{0, 3}, []byte{2}),
+				},
+				currentEpoch: 4,
+			},
+			wantSlashings: 1,
+		},
+		{
+			name: "detects double vote against previous attestation",
+			previousAttestations: []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(1, 2, []uint64{0}, []byte{1}),
+			},
+			args: args{
+				attestationQueue: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(1, 2, []uint64{0}, []byte{2}),
+				},
+				currentEpoch: 4,
+			},
+			wantSlashings: 1,
+		},
+		{
+			name: "same attestation seen twice is not slashable",
+			previousAttestations: []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(1, 2, []uint64{0}, []byte{1}),
+			},
+			args: args{
+				attestationQueue: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(1, 2, []uint64{0}, []byte{1}),
+				},
+				currentEpoch: 4,
+			},
+			wantSlashings: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			beaconDB := dbtest.SetupSlasherDB(t)
+			s := &Service{
+				params: &Parameters{
+					chunkSize:          2,
+					validatorChunkSize: 2,
+					historyLength:      8,
+				},
+				serviceCfg: &ServiceConfig{
+					Database: beaconDB,
+				},
+			}
+
+			// Process the previous attestations in an earlier epoch, as the slasher would
+			// have done when receiving them.
+			require.NoError(t, beaconDB.SaveAttestationRecordsForValidators(ctx, tt.previousAttestations))
+			slashings, err := s.checkSlashableAttestations(ctx, tt.args.currentEpoch-1, tt.previousAttestations)
+			require.NoError(t, err)
+			require.Equal(t, 0, len(slashings))
+
+			slashings, err = s.checkSlashableAttestations(ctx, tt.args.currentEpoch, tt.args.attestationQueue)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSlashings, len(slashings))
+		})
+	}
+}
+
+func TestService_checkDoubleVotes_WithinBatch(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database: dbtest.SetupSlasherDB(t),
+		},
+	}
+	att1 := createAttestationWrapper(1, 2, []uint64{1, 2}, []byte{1})
+	att2 := createAttestationWrapper(1, 2, []uint64{2, 3}, []byte{2})
+	slashings, err := s.checkDoubleVotes(ctx, []*slashertypes.IndexedAttestationWrapper{att1, att2})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.DeepEqual(t, att1.IndexedAttestation, slashings[0].Attestation_1)
+	assert.DeepEqual(t, att2.IndexedAttestation, slashings[0].Attestation_2)
+}
+
+func TestService_epochUpdateForValidator(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params: &Parameters{
+			chunkSize:          2,
+			validatorChunkSize: 2,
+			historyLength:      4,
+		},
+		serviceCfg: &ServiceConfig{
+			Database: dbtest.SetupSlasherDB(t),
+		},
+	}
+	args := &chunkUpdateArgs{
+		kind:         slashertypes.MaxSpan,
+		currentEpoch: 3,
+	}
+	chunk := EmptyMaxSpanChunksSlice(s.params)
+	for i := range chunk.data {
+		chunk.data[i] = 1
+	}
+	updatedChunks := map[uint64]Chunker{1: chunk}
+	err := s.epochUpdateForValidator(ctx, args, updatedChunks, &slashertypes.AttestedEpochForValidator{
+		ValidatorIndex: 1,
+		Epoch:          1,
+	})
+	require.NoError(t, err)
+
+	// Epochs 2 and 3 for validator 1 are reset to the neutral element, while
+	// the data for validator 0 remains untouched.
+	assert.DeepEqual(t, []uint16{1, 1, 0, 0}, updatedChunks[1].Chunk())
+	// Chunk 0 is not touched at all.
+	_, ok := updatedChunks[0]
+	assert.Equal(t, false, ok)
+}
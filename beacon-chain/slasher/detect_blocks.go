@@ -0,0 +1,74 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// detectProposerSlashings takes in signed block header wrappers and returns a list of proposer slashings detected.
+func (s *Service) detectProposerSlashings(
+	ctx context.Context,
+	proposedBlocks []*slashertypes.SignedBlockHeaderWrapper,
+) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.detectProposerSlashings")
+	defer span.End()
+	// We check if there are any slashable double proposals in the input list
+	// of proposals with respect to each other.
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	existingProposals := make(map[string]*slashertypes.SignedBlockHeaderWrapper)
+	for _, proposal := range proposedBlocks {
+		key := proposalKey(proposal.SignedBeaconBlockHeader.Header)
+		existingProposal, ok := existingProposals[key]
+		if !ok {
+			existingProposals[key] = proposal
+			continue
+		}
+		if existingProposal.SigningRoot != proposal.SigningRoot {
+			doubleProposalsTotal.Inc()
+			slashings = append(slashings, &ethpb.ProposerSlashing{
+				Header_1: existingProposal.SignedBeaconBlockHeader,
+				Header_2: proposal.SignedBeaconBlockHeader,
+			})
+		}
+	}
+
+	// We then check the proposals against those we have previously seen in our database.
+	proposerSlashings, err := s.serviceCfg.Database.CheckDoubleBlockProposals(ctx, proposedBlocks)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for double proposals on disk")
+	}
+	doubleProposalsTotal.Add(float64(len(proposerSlashings)))
+	if err := s.saveSafeProposals(ctx, proposedBlocks, proposerSlashings); err != nil {
+		return nil, errors.Wrap(err, "could not save safe proposals")
+	}
+	slashings = append(slashings, proposerSlashings...)
+	return slashings, nil
+}
+
+// Saves a list of proposals to the database. Only the first proposal seen for a slot and
+// proposer index is kept as the record, so proposals which conflict with a record already
+// on disk or with an earlier proposal in the same batch are skipped.
+func (s *Service) saveSafeProposals(
+	ctx context.Context,
+	proposedBlocks []*slashertypes.SignedBlockHeaderWrapper,
+	proposerSlashings []*ethpb.ProposerSlashing,
+) error {
+	skip := make(map[string]bool, len(proposerSlashings))
+	for _, slashing := range proposerSlashings {
+		skip[proposalKey(slashing.Header_1.Header)] = true
+	}
+	safeProposals := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(proposedBlocks))
+	for _, proposal := range proposedBlocks {
+		key := proposalKey(proposal.SignedBeaconBlockHeader.Header)
+		if skip[key] {
+			continue
+		}
+		skip[key] = true
+		safeProposals = append(safeProposals, proposal)
+	}
+	return s.serviceCfg.Database.SaveBlockProposals(ctx, safeProposals)
+}
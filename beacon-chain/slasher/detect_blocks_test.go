@@ -0,0 +1,42 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_detectProposerSlashings(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database: dbtest.SetupSlasherDB(t),
+		},
+	}
+
+	// Two distinct proposals for the same slot and proposer within a batch are slashable.
+	blk1 := createProposalWrapper(t, 1, 1, []byte{1})
+	blk2 := createProposalWrapper(t, 1, 1, []byte{2})
+	blk3 := createProposalWrapper(t, 2, 1, []byte{1})
+	slashings, err := s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{blk1, blk2, blk3})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.DeepEqual(t, blk1.SignedBeaconBlockHeader, slashings[0].Header_1)
+	assert.DeepEqual(t, blk2.SignedBeaconBlockHeader, slashings[0].Header_2)
+
+	// Seeing the same proposal again is not slashable.
+	slashings, err = s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{blk3})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(slashings))
+
+	// A distinct proposal conflicting with one saved in an earlier batch is slashable.
+	blk4 := createProposalWrapper(t, 2, 1, []byte{3})
+	slashings, err = s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{blk4})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+}
@@ -0,0 +1,130 @@
+package slasher
+
+import (
+	"strconv"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Group a list of attestations into batches by validator chunk index.
+// This way, we can detect on the batch of attestations for each validator chunk index
+// separately, allowing us to effectively use a single 2D chunk for slashing detection
+// through this logical grouping.
+func (s *Service) groupByValidatorChunkIndex(
+	attestations []*slashertypes.IndexedAttestationWrapper,
+) map[uint64][]*slashertypes.IndexedAttestationWrapper {
+	groupedAttestations := make(map[uint64][]*slashertypes.IndexedAttestationWrapper)
+	for _, att := range attestations {
+		validatorChunkIndices := make(map[uint64]bool)
+		for _, validatorIdx := range att.IndexedAttestation.AttestingIndices {
+			validatorChunkIndex := s.params.validatorChunkIndex(types.ValidatorIndex(validatorIdx))
+			validatorChunkIndices[validatorChunkIndex] = true
+		}
+		for validatorChunkIndex := range validatorChunkIndices {
+			groupedAttestations[validatorChunkIndex] = append(
+				groupedAttestations[validatorChunkIndex],
+				att,
+			)
+		}
+	}
+	return groupedAttestations
+}
+
+// Group attestations by the chunk index their source epoch corresponds to.
+func (s *Service) groupByChunkIndex(
+	attestations []*slashertypes.IndexedAttestationWrapper,
+) map[uint64][]*slashertypes.IndexedAttestationWrapper {
+	attestationsByChunkIndex := make(map[uint64][]*slashertypes.IndexedAttestationWrapper)
+	for _, att := range attestations {
+		chunkIdx := s.params.chunkIndex(att.IndexedAttestation.Data.Source.Epoch)
+		attestationsByChunkIndex[chunkIdx] = append(attestationsByChunkIndex[chunkIdx], att)
+	}
+	return attestationsByChunkIndex
+}
+
+// Filter a list of attestations based on whether they are valid in the current epoch.
+// Attestations whose source epoch falls outside of the history we keep are dropped,
+// while attestations with a target epoch in the future are returned separately so
+// they can be deferred for processing in a later epoch.
+func (s *Service) filterAttestations(
+	atts []*slashertypes.IndexedAttestationWrapper, currentEpoch types.Epoch,
+) (valid, validInFuture []*slashertypes.IndexedAttestationWrapper, numDropped int) {
+	valid = make([]*slashertypes.IndexedAttestationWrapper, 0, len(atts))
+	validInFuture = make([]*slashertypes.IndexedAttestationWrapper, 0)
+
+	for _, attWrapper := range atts {
+		if attWrapper == nil || !validateAttestationIntegrity(attWrapper.IndexedAttestation) {
+			numDropped++
+			continue
+		}
+
+		// If an attestation's source epoch is older than the max history length
+		// we keep track of for slashing detection, we drop it.
+		if attWrapper.IndexedAttestation.Data.Source.Epoch+s.params.historyLength <= currentEpoch {
+			numDropped++
+			continue
+		}
+
+		// If an attestation's target epoch is in the future, we defer processing for later.
+		if attWrapper.IndexedAttestation.Data.Target.Epoch > currentEpoch {
+			validInFuture = append(validInFuture, attWrapper)
+		} else {
+			valid = append(valid, attWrapper)
+		}
+	}
+	return
+}
+
+// Validates the attestation data integrity, ensuring we have no nil values for
+// source and target epochs, and that the source epoch of the attestation must
+// be less than the target epoch, which is a precondition for performing slashing
+// detection (except for the genesis epoch).
+func validateAttestationIntegrity(att *ethpb.IndexedAttestation) bool {
+	// If an attestation is malformed, we drop it.
+	if att == nil ||
+		att.Data == nil ||
+		att.Data.Source == nil ||
+		att.Data.Target == nil {
+		return false
+	}
+
+	sourceEpoch := att.Data.Source.Epoch
+	targetEpoch := att.Data.Target.Epoch
+
+	// The genesis epoch is a special case, since all attestations formed in it
+	// will have source and target 0, and they should be considered valid.
+	if sourceEpoch == 0 && targetEpoch == 0 {
+		return true
+	}
+	return sourceEpoch < targetEpoch
+}
+
+// Validates the signed beacon block header integrity, ensuring we have no nil values.
+func validateBlockHeaderIntegrity(header *ethpb.SignedBeaconBlockHeader) bool {
+	// If a signed block header is malformed, we drop it.
+	if header == nil ||
+		header.Header == nil ||
+		len(header.Signature) != params.BeaconConfig().BLSSignatureLength {
+		return false
+	}
+	return true
+}
+
+// Returns a key for a block proposal used to detect double proposals within a
+// single batch, comprised of the proposal's slot and proposer index.
+func proposalKey(header *ethpb.BeaconBlockHeader) string {
+	return uintToString(uint64(header.Slot)) + ":" + uintToString(uint64(header.ProposerIndex))
+}
+
+// Returns a key for an attestation vote used to detect double votes within a
+// single batch, comprised of the target epoch and the attesting validator index.
+func voteKey(targetEpoch types.Epoch, validatorIdx uint64) string {
+	return uintToString(uint64(targetEpoch)) + ":" + uintToString(validatorIdx)
+}
+
+func uintToString(val uint64) string {
+	return strconv.FormatUint(val, 10)
+}
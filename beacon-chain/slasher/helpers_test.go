@@ -0,0 +1,173 @@
+package slasher
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_groupByValidatorChunkIndex(t *testing.T) {
+	s := &Service{
+		params: &Parameters{validatorChunkSize: 2},
+	}
+	att1 := createAttestationWrapper(0, 1, []uint64{0, 1}, nil)
+	att2 := createAttestationWrapper(0, 1, []uint64{1, 2, 3}, nil)
+	grouped := s.groupByValidatorChunkIndex([]*slashertypes.IndexedAttestationWrapper{att1, att2})
+	require.Equal(t, 2, len(grouped))
+	assert.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{att1, att2}, grouped[0])
+	assert.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{att2}, grouped[1])
+}
+
+func TestService_groupByChunkIndex(t *testing.T) {
+	s := &Service{
+		params: &Parameters{
+			chunkSize:     2,
+			historyLength: 6,
+		},
+	}
+	att1 := createAttestationWrapper(0, 1, []uint64{0}, nil)
+	att2 := createAttestationWrapper(1, 2, []uint64{0}, nil)
+	att3 := createAttestationWrapper(2, 3, []uint64{0}, nil)
+	grouped := s.groupByChunkIndex([]*slashertypes.IndexedAttestationWrapper{att1, att2, att3})
+	require.Equal(t, 2, len(grouped))
+	assert.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{att1, att2}, grouped[0])
+	assert.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{att3}, grouped[1])
+}
+
+func TestService_filterAttestations(t *testing.T) {
+	s := &Service{
+		params: &Parameters{historyLength: 4},
+	}
+	current := createAttestationWrapper(4, 5, []uint64{0}, nil)
+	future := createAttestationWrapper(5, 6, []uint64{0}, nil)
+	tooOld := createAttestationWrapper(1, 5, []uint64{0}, nil)
+	malformed := createAttestationWrapper(3, 2, []uint64{0}, nil)
+	valid, validInFuture, numDropped := s.filterAttestations(
+		[]*slashertypes.IndexedAttestationWrapper{current, future, tooOld, malformed, nil}, 5,
+	)
+	assert.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{current}, valid)
+	assert.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{future}, validInFuture)
+	assert.Equal(t, 3, numDropped)
+}
+
+func Test_validateAttestationIntegrity(t *testing.T) {
+	tests := []struct {
+		name string
+		att  *ethpb.IndexedAttestation
+		want bool
+	}{
+		{
+			name: "nil attestation",
+			att:  nil,
+			want: false,
+		},
+		{
+			name: "nil attestation data",
+			att:  &ethpb.IndexedAttestation{},
+			want: false,
+		},
+		{
+			name: "nil source",
+			att: &ethpb.IndexedAttestation{
+				Data: &ethpb.AttestationData{Target: &ethpb.Checkpoint{}},
+			},
+			want: false,
+		},
+		{
+			name: "nil target",
+			att: &ethpb.IndexedAttestation{
+				Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{}},
+			},
+			want: false,
+		},
+		{
+			name: "source 0 target 0 returns true (genesis epoch)",
+			att:  createAttestationWrapper(0, 0, nil, nil).IndexedAttestation,
+			want: true,
+		},
+		{
+			name: "source 1 target 0 returns false",
+			att:  createAttestationWrapper(1, 0, nil, nil).IndexedAttestation,
+			want: false,
+		},
+		{
+			name: "source 1 target 1 returns false",
+			att:  createAttestationWrapper(1, 1, nil, nil).IndexedAttestation,
+			want: false,
+		},
+		{
+			name: "source 1 target 2 returns true",
+			att:  createAttestationWrapper(1, 2, nil, nil).IndexedAttestation,
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validateAttestationIntegrity(tt.att))
+		})
+	}
+}
+
+func Test_validateBlockHeaderIntegrity(t *testing.T) {
+	assert.Equal(t, false, validateBlockHeaderIntegrity(nil))
+	assert.Equal(t, false, validateBlockHeaderIntegrity(&ethpb.SignedBeaconBlockHeader{}))
+	assert.Equal(t, false, validateBlockHeaderIntegrity(&ethpb.SignedBeaconBlockHeader{
+		Header:    &ethpb.BeaconBlockHeader{},
+		Signature: []byte("hi"),
+	}))
+	assert.Equal(t, true, validateBlockHeaderIntegrity(&ethpb.SignedBeaconBlockHeader{
+		Header:    &ethpb.BeaconBlockHeader{},
+		Signature: make([]byte, params.BeaconConfig().BLSSignatureLength),
+	}))
+}
+
+func createAttestationWrapper(
+	source, target types.Epoch, indices []uint64, signingRoot []byte,
+) *slashertypes.IndexedAttestationWrapper {
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: bytesutil.PadTo(signingRoot, 32),
+		Source: &ethpb.Checkpoint{
+			Epoch: source,
+			Root:  params.BeaconConfig().ZeroHash[:],
+		},
+		Target: &ethpb.Checkpoint{
+			Epoch: target,
+			Root:  params.BeaconConfig().ZeroHash[:],
+		},
+	}
+	return &slashertypes.IndexedAttestationWrapper{
+		IndexedAttestation: &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data:             data,
+			Signature:        params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: bytesutil.ToBytes32(signingRoot),
+	}
+}
+
+func createProposalWrapper(
+	t *testing.T, slot types.Slot, proposerIndex types.ValidatorIndex, signingRoot []byte,
+) *slashertypes.SignedBlockHeaderWrapper {
+	header := &ethpb.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    params.BeaconConfig().ZeroHash[:],
+		StateRoot:     bytesutil.PadTo(signingRoot, 32),
+		BodyRoot:      params.BeaconConfig().ZeroHash[:],
+	}
+	headerRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	return &slashertypes.SignedBlockHeaderWrapper{
+		SignedBeaconBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header:    header,
+			Signature: params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: headerRoot,
+	}
+}
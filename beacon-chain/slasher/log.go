@@ -0,0 +1,30 @@
+package slasher
+
+import (
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "slasher")
+
+func logAttesterSlashing(slashing *ethpb.AttesterSlashing) {
+	indices := sliceutil.IntersectionUint64(
+		slashing.Attestation_1.AttestingIndices,
+		slashing.Attestation_2.AttestingIndices,
+	)
+	log.WithFields(logrus.Fields{
+		"validatorIndices": indices,
+		"prevSourceEpoch":  slashing.Attestation_1.Data.Source.Epoch,
+		"prevTargetEpoch":  slashing.Attestation_1.Data.Target.Epoch,
+		"sourceEpoch":      slashing.Attestation_2.Data.Source.Epoch,
+		"targetEpoch":      slashing.Attestation_2.Data.Target.Epoch,
+	}).Info("Attester slashing detected")
+}
+
+func logProposerSlashing(slashing *ethpb.ProposerSlashing) {
+	log.WithFields(logrus.Fields{
+		"proposerIndex": slashing.Header_1.Header.ProposerIndex,
+		"slot":          slashing.Header_1.Header.Slot,
+	}).Info("Proposer slashing detected")
+}
@@ -0,0 +1,41 @@
+package slasher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	processedAttestationsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_processed_attestations_total",
+		Help: "Total number of attestations processed by slasher",
+	})
+	processedBlocksTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_processed_blocks_total",
+		Help: "Total number of block headers processed by slasher",
+	})
+	doubleProposalsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_double_proposals_total",
+		Help: "Total double proposals detected by slasher",
+	})
+	doubleVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_double_votes_total",
+		Help: "Total double votes detected by slasher",
+	})
+	surroundingVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_surrounding_votes_total",
+		Help: "Total surrounding votes detected by slasher",
+	})
+	surroundedVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_surrounded_votes_total",
+		Help: "Total surrounded votes detected by slasher",
+	})
+	attesterSlashingsInsertedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attester_slashings_inserted_total",
+		Help: "Total attester slashings found by slasher and inserted into the slashings pool",
+	})
+	proposerSlashingsInsertedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_proposer_slashings_inserted_total",
+		Help: "Total proposer slashings found by slasher and inserted into the slashings pool",
+	})
+)
@@ -0,0 +1,105 @@
+package slasher
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Parameters for slashing detection.
+//
+// To properly access the element at epoch `e` for a validator index `i`, we leverage helper
+// functions from these parameter values as nice abstractions. the following parameters are
+// required for the helper functions defined in this file.
+//
+// (C) chunkSize defines how many elements are in a chunk for a validator
+// min or max span slice.
+// (K) validatorChunkSize defines how many validators' chunks we store in a single
+// flat byte slice on disk.
+// (H) historyLength defines how many epochs we keep of min or max spans.
+type Parameters struct {
+	chunkSize          uint64
+	validatorChunkSize uint64
+	historyLength      types.Epoch
+}
+
+// DefaultParams defines default values for slasher's important parameters, defined
+// based on optimization analysis for best and worst case scenarios for
+// slasher's performance.
+//
+// The default values for chunkSize and validatorChunkSize were
+// decided after an optimization analysis performed by the Sigma Prime team.
+// See: https://hackmd.io/@sproul/min-max-slasher#1D-Chunking for more details.
+// We decide to keep 4096 epochs worth of data in each validator's min max spans.
+func DefaultParams() *Parameters {
+	return &Parameters{
+		chunkSize:          16,
+		validatorChunkSize: 256,
+		historyLength:      4096,
+	}
+}
+
+// Validator min and max spans are split into chunks of length C = chunkSize.
+// That is, if we are keeping N epochs worth of attesting history, finding what
+// chunk a certain epoch, e, falls into can be computed as (e % N) / C. For example,
+// if we are keeping 6 epochs worth of data, and we have chunks of size 2, then epoch
+// 4 will fall into chunk index (4 % 6) / 2 = 2.
+//
+//  span    = [-, -, -, -, -, -]
+//  chunked = [[-, -], [-, -], [-, -]]
+//                              |-> epoch 4, chunk idx 2
+//
+func (p *Parameters) chunkIndex(epoch types.Epoch) uint64 {
+	return (uint64(epoch) % uint64(p.historyLength)) / p.chunkSize
+}
+
+// When storing data on disk, we take K validators' chunks. To figure out
+// which validator chunk index a validator index is for, we simply divide
+// the validator index, i, by K.
+func (p *Parameters) validatorChunkIndex(validatorIndex types.ValidatorIndex) uint64 {
+	return uint64(validatorIndex) / p.validatorChunkSize
+}
+
+// Given a validator index, we determine the index of a validator within a chunk
+// of validators of size K. For example, with K = 3 validators per chunk,
+// validator index 4 has an offset of 4 % 3 = 1 within its chunk.
+func (p *Parameters) validatorOffset(validatorIndex types.ValidatorIndex) uint64 {
+	return uint64(validatorIndex) % p.validatorChunkSize
+}
+
+// Given an epoch, we determine the offset of that epoch within the chunk it belongs to.
+// For example, with chunks of size 3, epoch 4 has an offset of 4 % 3 = 1.
+func (p *Parameters) chunkOffset(epoch types.Epoch) uint64 {
+	return uint64(epoch) % p.chunkSize
+}
+
+// In a flat, 1-dimensional chunk of K validators' spans of length C, the data for
+// a validator's offset, v, at a chunk offset, c, is stored at index v * C + c.
+//
+//  val0     val1     val2
+//   |        |        |
+//  [[-, -], [-, -], [-, -]]
+//               |-> validator offset 1, chunk offset 1, cell index 1 * 2 + 1 = 3
+//
+func (p *Parameters) cellIndex(validatorOffset, chunkOffset uint64) uint64 {
+	return validatorOffset*p.chunkSize + chunkOffset
+}
+
+// Given a validator chunk index and a chunk index, we determine the key under which
+// the flat chunk is stored in the database. As there are H / C chunks per validator
+// chunk index, the key is computed as validatorChunkIndex * (H / C) + chunkIndex.
+func (p *Parameters) flatSliceID(validatorChunkIndex, chunkIndex uint64) []byte {
+	width := uint64(p.historyLength) / p.chunkSize
+	return ssz.MarshalUint64(make([]byte, 0), validatorChunkIndex*width+chunkIndex)
+}
+
+// Given a validator chunk index, we determine all of the validator
+// indices that will belong in that chunk.
+func (p *Parameters) validatorIndicesInChunk(validatorChunkIdx uint64) []types.ValidatorIndex {
+	validatorIndices := make([]types.ValidatorIndex, 0, p.validatorChunkSize)
+	low := validatorChunkIdx * p.validatorChunkSize
+	high := (validatorChunkIdx + 1) * p.validatorChunkSize
+	for i := low; i < high; i++ {
+		validatorIndices = append(validatorIndices, types.ValidatorIndex(i))
+	}
+	return validatorIndices
+}
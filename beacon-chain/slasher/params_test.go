@@ -0,0 +1,137 @@
+package slasher
+
+import (
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestDefaultParams(t *testing.T) {
+	def := DefaultParams()
+	assert.Equal(t, true, def.chunkSize > 0)
+	assert.Equal(t, true, def.validatorChunkSize > 0)
+	assert.Equal(t, true, def.historyLength > 0)
+}
+
+func TestParams_chunkIndex(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields *Parameters
+		epoch  types.Epoch
+		want   uint64
+	}{
+		{
+			name: "epoch 0",
+			fields: &Parameters{
+				chunkSize:     3,
+				historyLength: 3,
+			},
+			epoch: 0,
+			want:  0,
+		},
+		{
+			name: "epoch < historyLength, epoch < chunkSize",
+			fields: &Parameters{
+				chunkSize:     3,
+				historyLength: 3,
+			},
+			epoch: 2,
+			want:  0,
+		},
+		{
+			name: "epoch = historyLength, epoch < chunkSize",
+			fields: &Parameters{
+				chunkSize:     4,
+				historyLength: 3,
+			},
+			epoch: 3,
+			want:  0,
+		},
+		{
+			name: "epoch < historyLength, epoch > chunkSize",
+			fields: &Parameters{
+				chunkSize:     2,
+				historyLength: 6,
+			},
+			epoch: 4,
+			want:  2,
+		},
+		{
+			name: "epoch > historyLength, epoch > chunkSize",
+			fields: &Parameters{
+				chunkSize:     2,
+				historyLength: 6,
+			},
+			epoch: 10,
+			want:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.fields.chunkIndex(tt.epoch))
+		})
+	}
+}
+
+func TestParams_validatorChunkIndex(t *testing.T) {
+	p := &Parameters{validatorChunkSize: 3}
+	assert.Equal(t, uint64(0), p.validatorChunkIndex(0))
+	assert.Equal(t, uint64(0), p.validatorChunkIndex(2))
+	assert.Equal(t, uint64(1), p.validatorChunkIndex(3))
+	assert.Equal(t, uint64(3), p.validatorChunkIndex(10))
+}
+
+func TestParams_cellIndex(t *testing.T) {
+	tests := []struct {
+		name            string
+		fields          *Parameters
+		validatorOffset uint64
+		chunkOffset     uint64
+		want            uint64
+	}{
+		{
+			name:            "validator offset and chunk offset 0",
+			fields:          &Parameters{chunkSize: 2},
+			validatorOffset: 0,
+			chunkOffset:     0,
+			want:            0,
+		},
+		{
+			name:            "validator offset 1, chunk offset 1",
+			fields:          &Parameters{chunkSize: 2},
+			validatorOffset: 1,
+			chunkOffset:     1,
+			want:            3,
+		},
+		{
+			name:            "validator offset 2, chunk offset 0",
+			fields:          &Parameters{chunkSize: 3},
+			validatorOffset: 2,
+			chunkOffset:     0,
+			want:            6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.fields.cellIndex(tt.validatorOffset, tt.chunkOffset))
+		})
+	}
+}
+
+func TestParams_flatSliceID(t *testing.T) {
+	p := &Parameters{
+		chunkSize:     2,
+		historyLength: 6,
+	}
+	assert.DeepEqual(t, ssz.MarshalUint64(make([]byte, 0), 0), p.flatSliceID(0, 0))
+	assert.DeepEqual(t, ssz.MarshalUint64(make([]byte, 0), 2), p.flatSliceID(0, 2))
+	assert.DeepEqual(t, ssz.MarshalUint64(make([]byte, 0), 5), p.flatSliceID(1, 2))
+}
+
+func TestParams_validatorIndicesInChunk(t *testing.T) {
+	p := &Parameters{validatorChunkSize: 3}
+	assert.DeepEqual(t, []types.ValidatorIndex{0, 1, 2}, p.validatorIndicesInChunk(0))
+	assert.DeepEqual(t, []types.ValidatorIndex{6, 7, 8}, p.validatorIndicesInChunk(2))
+}
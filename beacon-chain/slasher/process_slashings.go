@@ -0,0 +1,52 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// Verifies attester slashings, logs them, and submits them to the slashing operations pool
+// in the beacon node. The pool verifies every slashing against the head state,
+// including the signatures of both attestations, before accepting it.
+func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) error {
+	if len(slashings) == 0 {
+		return nil
+	}
+	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	for _, sl := range slashings {
+		logAttesterSlashing(sl)
+		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(ctx, headState, sl); err != nil {
+			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+			continue
+		}
+		attesterSlashingsInsertedTotal.Inc()
+	}
+	return nil
+}
+
+// Verifies proposer slashings, logs them, and submits them to the slashing operations pool
+// in the beacon node. The pool verifies every slashing against the head state,
+// including the signatures of both block headers, before accepting it.
+func (s *Service) processProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
+	if len(slashings) == 0 {
+		return nil
+	}
+	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	for _, sl := range slashings {
+		logProposerSlashing(sl)
+		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, headState, sl); err != nil {
+			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
+			continue
+		}
+		proposerSlashingsInsertedTotal.Inc()
+	}
+	return nil
+}
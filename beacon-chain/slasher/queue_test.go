@@ -0,0 +1,41 @@
+package slasher
+
+import (
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func Test_attestationsQueue(t *testing.T) {
+	q := newAttestationsQueue()
+	att1 := createAttestationWrapper(0, 1, []uint64{1}, nil)
+	att2 := createAttestationWrapper(1, 2, []uint64{1}, nil)
+	q.push(att1)
+	assert.Equal(t, 1, q.size())
+	q.extend([]*slashertypes.IndexedAttestationWrapper{att2})
+	assert.Equal(t, 2, q.size())
+
+	dequeued := q.dequeue()
+	require.Equal(t, 2, len(dequeued))
+	assert.DeepEqual(t, att1, dequeued[0])
+	assert.DeepEqual(t, att2, dequeued[1])
+	assert.Equal(t, 0, q.size())
+}
+
+func Test_blocksQueue(t *testing.T) {
+	q := newBlocksQueue()
+	blk1 := createProposalWrapper(t, 1, 1, nil)
+	blk2 := createProposalWrapper(t, 2, 1, nil)
+	q.push(blk1)
+	assert.Equal(t, 1, q.size())
+	q.extend([]*slashertypes.SignedBlockHeaderWrapper{blk2})
+	assert.Equal(t, 2, q.size())
+
+	dequeued := q.dequeue()
+	require.Equal(t, 2, len(dequeued))
+	assert.DeepEqual(t, blk1, dequeued[0])
+	assert.DeepEqual(t, blk2, dequeued[1])
+	assert.Equal(t, 0, q.size())
+}
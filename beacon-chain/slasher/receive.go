@@ -0,0 +1,196 @@
+package slasher
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// Receive indexed attestations from some source event feed,
+// validating their integrity before appending them to an attestation queue
+// for batch processing in a separate routine.
+func (s *Service) receiveAttestations(ctx context.Context, indexedAttsChan chan *ethpb.IndexedAttestation) {
+	sub := s.serviceCfg.IndexedAttestationsFeed.Subscribe(indexedAttsChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case att := <-indexedAttsChan:
+			if !validateAttestationIntegrity(att) {
+				continue
+			}
+			signingRoot, err := att.Data.HashTreeRoot()
+			if err != nil {
+				log.WithError(err).Error("Could not get hash tree root of attestation")
+				continue
+			}
+			s.attsQueue.push(&slashertypes.IndexedAttestationWrapper{
+				IndexedAttestation: att,
+				SigningRoot:        signingRoot,
+			})
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Receive beacon blocks from some source event feed,
+// validating their integrity before appending them to a block queue
+// for batch processing in a separate routine.
+func (s *Service) receiveBlocks(ctx context.Context, beaconBlockHeadersChan chan *ethpb.SignedBeaconBlockHeader) {
+	sub := s.serviceCfg.BeaconBlockHeadersFeed.Subscribe(beaconBlockHeadersChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case blockHeader := <-beaconBlockHeadersChan:
+			if !validateBlockHeaderIntegrity(blockHeader) {
+				continue
+			}
+			signingRoot, err := blockHeader.Header.HashTreeRoot()
+			if err != nil {
+				log.WithError(err).Error("Could not get hash tree root of signed block header")
+				continue
+			}
+			s.blksQueue.push(&slashertypes.SignedBlockHeaderWrapper{
+				SignedBeaconBlockHeader: blockHeader,
+				SigningRoot:             signingRoot,
+			})
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Process queued attestations once per epoch, on the first slot ticker tick of every epoch.
+// We retrieve these attestations from a queue, then group them all by validator chunk index.
+// This grouping will allow us to perform detection on batches of attestations
+// per validator chunk index.
+func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			if !helpers.IsEpochStart(currentSlot) {
+				continue
+			}
+			attestations := s.attsQueue.dequeue()
+			currentEpoch := helpers.SlotToEpoch(currentSlot)
+			// We take all the attestations in the queue and filter out
+			// those which are valid in the current epoch and those which
+			// will be valid in the future.
+			validAtts, validInFuture, numDropped := s.filterAttestations(attestations, currentEpoch)
+			// We add back those attestations that are valid in the future to the queue.
+			s.attsQueue.extend(validInFuture)
+
+			log.WithFields(logrus.Fields{
+				"currentSlot":     currentSlot,
+				"currentEpoch":    currentEpoch,
+				"numValidAtts":    len(validAtts),
+				"numDeferredAtts": len(validInFuture),
+				"numDroppedAtts":  numDropped,
+			}).Info("Processing queued attestations for slashing detection")
+
+			// Save the attestation records to our database.
+			if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(
+				ctx, validAtts,
+			); err != nil {
+				log.WithError(err).Error("Could not save attestation records to DB")
+				continue
+			}
+
+			// Check for slashings.
+			slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAtts)
+			if err != nil {
+				log.WithError(err).Error("Could not check slashable attestations")
+				continue
+			}
+
+			// Process attester slashings by verifying their signatures, submitting
+			// to the beacon node's operations pool, and logging them.
+			if err := s.processAttesterSlashings(ctx, slashings); err != nil {
+				log.WithError(err).Error("Could not process attester slashings")
+				continue
+			}
+
+			processedAttestationsTotal.Add(float64(len(validAtts)))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Process queued blocks every time a slot ticker fires. We retrieve
+// these blocks from a queue, then perform double proposal detection.
+func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			blocks := s.blksQueue.dequeue()
+			currentEpoch := helpers.SlotToEpoch(currentSlot)
+
+			log.WithFields(logrus.Fields{
+				"currentSlot":  currentSlot,
+				"currentEpoch": currentEpoch,
+				"numBlocks":    len(blocks),
+			}).Debug("Processing queued blocks for slashing detection")
+
+			// Check for slashings.
+			slashings, err := s.detectProposerSlashings(ctx, blocks)
+			if err != nil {
+				log.WithError(err).Error("Could not detect proposer slashings")
+				continue
+			}
+
+			// Process proposer slashings by verifying their signatures, submitting
+			// to the beacon node's operations pool, and logging them.
+			if err := s.processProposerSlashings(ctx, slashings); err != nil {
+				log.WithError(err).Error("Could not process proposer slashings")
+				continue
+			}
+
+			processedBlocksTotal.Add(float64(len(blocks)))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Prunes slasher data on each epoch start, removing attestation and proposal
+// records older than the history length we keep for slashing detection.
+func (s *Service) pruneSlasherData(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			if !helpers.IsEpochStart(currentSlot) {
+				continue
+			}
+			currentEpoch := helpers.SlotToEpoch(currentSlot)
+			if err := s.serviceCfg.Database.PruneAttestations(
+				ctx, currentEpoch, pruningEpochIncrements, s.params.historyLength,
+			); err != nil {
+				log.WithError(err).Error("Could not prune attestations")
+				continue
+			}
+			if err := s.serviceCfg.Database.PruneProposals(
+				ctx, currentEpoch, pruningEpochIncrements, s.params.historyLength,
+			); err != nil {
+				log.WithError(err).Error("Could not prune proposals")
+				continue
+			}
+			log.WithFields(logrus.Fields{
+				"currentEpoch":  currentEpoch,
+				"historyLength": s.params.historyLength,
+			}).Debug("Pruned slasher data")
+		case <-ctx.Done():
+			return
+		}
+	}
+}
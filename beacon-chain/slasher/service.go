@@ -0,0 +1,178 @@
+// Package slasher implements slashing detection for Ethereum consensus as a service
+// within the beacon node. It receives indexed attestations and block headers from the
+// gossip pipeline, batches them, detects double votes, surround votes and double proposals
+// using min-max span chunks persisted in the slasher database, and submits any slashings
+// it finds to the beacon node's slashings pool.
+package slasher
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// pruningEpochIncrements is the number of epochs of slasher data pruned per database
+// transaction, as bolt does not handle long-running transactions well.
+const pruningEpochIncrements = types.Epoch(100)
+
+// ServiceConfig for the slasher service in the beacon node.
+// This struct allows us to specify required dependencies and
+// parameters for slasher to function as needed.
+type ServiceConfig struct {
+	IndexedAttestationsFeed *event.Feed
+	BeaconBlockHeadersFeed  *event.Feed
+	Database                db.SlasherDatabase
+	StateNotifier           statefeed.Notifier
+	HeadStateFetcher        blockchain.HeadFetcher
+	SlashingPoolInserter    slashings.PoolManager
+	SyncChecker             sync.Checker
+}
+
+// Service defining a slasher implementation as part of
+// the beacon node, able to detect eth2 slashable offenses.
+type Service struct {
+	params            *Parameters
+	serviceCfg        *ServiceConfig
+	attsQueue         *attestationsQueue
+	blksQueue         *blocksQueue
+	ctx               context.Context
+	cancel            context.CancelFunc
+	attsSlotTicker    *slotutil.SlotTicker
+	blocksSlotTicker  *slotutil.SlotTicker
+	pruningSlotTicker *slotutil.SlotTicker
+	genesisTime       time.Time
+}
+
+// New instantiates a new slasher from configuration values.
+func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		params:     DefaultParams(),
+		serviceCfg: srvCfg,
+		attsQueue:  newAttestationsQueue(),
+		blksQueue:  newBlocksQueue(),
+		ctx:        ctx,
+		cancel:     cancel,
+	}, nil
+}
+
+// Start listening for received indexed attestations and blocks
+// and perform slashing detection on them.
+func (s *Service) Start() {
+	go s.run()
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.serviceCfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	var stateEvent *feed.Event
+	select {
+	case stateEvent = <-stateChannel:
+	case <-s.ctx.Done():
+		stateSub.Unsubscribe()
+		return
+	}
+	stateSub.Unsubscribe()
+
+	// Wait for us to receive the genesis time via a chain started notification.
+	switch stateEvent.Type {
+	case statefeed.ChainStarted:
+		data, ok := stateEvent.Data.(*statefeed.ChainStartedData)
+		if !ok {
+			log.Error("Could not receive chain start notification, want *statefeed.ChainStartedData")
+			return
+		}
+		s.genesisTime = data.StartTime
+		log.WithField("genesisTime", s.genesisTime).Info("Starting slasher, received chain start event")
+	case statefeed.Initialized:
+		// Alternatively, if the chain has already started, we then read the genesis
+		// time value from this data.
+		data, ok := stateEvent.Data.(*statefeed.InitializedData)
+		if !ok {
+			log.Error("Could not receive chain start notification, want *statefeed.InitializedData")
+			return
+		}
+		s.genesisTime = data.StartTime
+		log.WithField("genesisTime", s.genesisTime).Info("Starting slasher, chain already initialized")
+	default:
+		// This should not happen.
+		log.Error("Could not start slasher, could not receive chain start event")
+		return
+	}
+
+	s.waitForSync(s.genesisTime)
+	if s.ctx.Err() != nil {
+		return
+	}
+
+	log.Info("Completed chain sync, starting slashing detection")
+
+	indexedAttsChan := make(chan *ethpb.IndexedAttestation, 1)
+	beaconBlockHeadersChan := make(chan *ethpb.SignedBeaconBlockHeader, 1)
+	go s.receiveAttestations(s.ctx, indexedAttsChan)
+	go s.receiveBlocks(s.ctx, beaconBlockHeadersChan)
+
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	s.attsSlotTicker = slotutil.NewSlotTicker(s.genesisTime, secondsPerSlot)
+	s.blocksSlotTicker = slotutil.NewSlotTicker(s.genesisTime, secondsPerSlot)
+	s.pruningSlotTicker = slotutil.NewSlotTicker(s.genesisTime, secondsPerSlot)
+	go s.processQueuedAttestations(s.ctx, s.attsSlotTicker.C())
+	go s.processQueuedBlocks(s.ctx, s.blocksSlotTicker.C())
+	go s.pruneSlasherData(s.ctx, s.pruningSlotTicker.C())
+}
+
+// Stop the slasher service.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.attsSlotTicker != nil {
+		s.attsSlotTicker.Done()
+	}
+	if s.blocksSlotTicker != nil {
+		s.blocksSlotTicker.Done()
+	}
+	if s.pruningSlotTicker != nil {
+		s.pruningSlotTicker.Done()
+	}
+	return nil
+}
+
+// Status of the slasher service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Waits until the beacon node has completed initial sync, as slashing detection relies
+// on the head state to verify and insert the slashings it finds.
+func (s *Service) waitForSync(genesisTime time.Time) {
+	if slotutil.SlotsSinceGenesis(genesisTime) == 0 || !s.serviceCfg.SyncChecker.Syncing() {
+		return
+	}
+	slotTicker := slotutil.NewSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer slotTicker.Done()
+	for {
+		select {
+		case <-slotTicker.C():
+			// If node is still syncing, do not operate slasher.
+			if s.serviceCfg.SyncChecker.Syncing() {
+				continue
+			}
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
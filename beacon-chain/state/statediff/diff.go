@@ -0,0 +1,400 @@
+// Package statediff computes compact differences between two beacon states, such as the states
+// of two consecutive epochs, so that historical states can be stored as a full snapshot followed
+// by a chain of differences instead of as full states.
+package statediff
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"google.golang.org/protobuf/proto"
+)
+
+// validatorSSZSize is the size of an SSZ encoded validator.
+const validatorSSZSize = 121
+
+// rootChange is a new value at an index of a vector of roots.
+type rootChange struct {
+	index uint64
+	root  []byte
+}
+
+// uint64Change is a new value at an index of a vector of integers.
+type uint64Change struct {
+	index uint64
+	value uint64
+}
+
+// validatorChange is a new or modified validator of the registry.
+type validatorChange struct {
+	index     uint64
+	validator *ethpb.Validator
+}
+
+// Diff is the difference between a base state and a target state. Fields of constant size are
+// copied over from the target state, while the large vectors and lists only record the entries
+// which changed or were appended, and the balances are stored as deltas.
+type Diff struct {
+	// fixed is the target state without the fields listed below.
+	fixed               *statepb.BeaconState
+	blockRoots          []*rootChange
+	stateRoots          []*rootChange
+	randaoMixes         []*rootChange
+	slashings           []*uint64Change
+	baseHistoricalRoots uint64
+	historicalRoots     [][]byte
+	baseValidators      uint64
+	targetValidators    uint64
+	validators          []*validatorChange
+	balanceDeltas       []int64
+}
+
+// Compute returns the difference from the base state to the target state. The target state must
+// descend from the base state: its historical roots and validator registry can only grow. The
+// difference shares memory with the target state, which must not be modified afterwards.
+func Compute(base, target *statepb.BeaconState) (*Diff, error) {
+	if base == nil || target == nil {
+		return nil, errors.New("nil state")
+	}
+	if !bytes.Equal(base.GenesisValidatorsRoot, target.GenesisValidatorsRoot) {
+		return nil, errors.New("states have different genesis validators roots")
+	}
+	if len(target.HistoricalRoots) < len(base.HistoricalRoots) {
+		return nil, errors.New("target state has fewer historical roots than the base state")
+	}
+	if len(target.Validators) < len(base.Validators) {
+		return nil, errors.New("target state has fewer validators than the base state")
+	}
+	if len(target.Balances) != len(target.Validators) || len(base.Balances) != len(base.Validators) {
+		return nil, errors.New("state balances do not match its validators")
+	}
+	d := &Diff{
+		fixed: &statepb.BeaconState{
+			GenesisTime:                 target.GenesisTime,
+			GenesisValidatorsRoot:       target.GenesisValidatorsRoot,
+			Slot:                        target.Slot,
+			Fork:                        target.Fork,
+			LatestBlockHeader:           target.LatestBlockHeader,
+			Eth1Data:                    target.Eth1Data,
+			Eth1DataVotes:               target.Eth1DataVotes,
+			Eth1DepositIndex:            target.Eth1DepositIndex,
+			PreviousEpochAttestations:   target.PreviousEpochAttestations,
+			CurrentEpochAttestations:    target.CurrentEpochAttestations,
+			JustificationBits:           target.JustificationBits,
+			PreviousJustifiedCheckpoint: target.PreviousJustifiedCheckpoint,
+			CurrentJustifiedCheckpoint:  target.CurrentJustifiedCheckpoint,
+			FinalizedCheckpoint:         target.FinalizedCheckpoint,
+		},
+		baseHistoricalRoots: uint64(len(base.HistoricalRoots)),
+		historicalRoots:     target.HistoricalRoots[len(base.HistoricalRoots):],
+		baseValidators:      uint64(len(base.Validators)),
+		targetValidators:    uint64(len(target.Validators)),
+		balanceDeltas:       make([]int64, len(target.Balances)),
+	}
+	var err error
+	if d.blockRoots, err = diffRoots(base.BlockRoots, target.BlockRoots); err != nil {
+		return nil, errors.Wrap(err, "block roots")
+	}
+	if d.stateRoots, err = diffRoots(base.StateRoots, target.StateRoots); err != nil {
+		return nil, errors.Wrap(err, "state roots")
+	}
+	if d.randaoMixes, err = diffRoots(base.RandaoMixes, target.RandaoMixes); err != nil {
+		return nil, errors.Wrap(err, "randao mixes")
+	}
+	if len(base.Slashings) != len(target.Slashings) {
+		return nil, errors.New("slashings vectors have different lengths")
+	}
+	for i, v := range target.Slashings {
+		if v != base.Slashings[i] {
+			d.slashings = append(d.slashings, &uint64Change{index: uint64(i), value: v})
+		}
+	}
+	for i, v := range target.Validators {
+		if i >= len(base.Validators) || !validatorEqual(base.Validators[i], v) {
+			d.validators = append(d.validators, &validatorChange{index: uint64(i), validator: v})
+		}
+	}
+	for i, b := range target.Balances {
+		var prev uint64
+		if i < len(base.Balances) {
+			prev = base.Balances[i]
+		}
+		d.balanceDeltas[i] = int64(b - prev)
+	}
+	return d, nil
+}
+
+// Slot returns the slot of the target state.
+func (d *Diff) Slot() types.Slot {
+	return d.fixed.Slot
+}
+
+// Apply turns the base state of the difference into its target state. The state is modified
+// in place.
+func (d *Diff) Apply(st *statepb.BeaconState) error {
+	if st == nil {
+		return errors.New("nil state")
+	}
+	if uint64(len(st.HistoricalRoots)) != d.baseHistoricalRoots || uint64(len(st.Validators)) != d.baseValidators ||
+		len(st.Balances) != len(st.Validators) {
+		return errors.New("state is not the base state of the difference")
+	}
+	if err := applyRoots(st.BlockRoots, d.blockRoots); err != nil {
+		return errors.Wrap(err, "block roots")
+	}
+	if err := applyRoots(st.StateRoots, d.stateRoots); err != nil {
+		return errors.Wrap(err, "state roots")
+	}
+	if err := applyRoots(st.RandaoMixes, d.randaoMixes); err != nil {
+		return errors.Wrap(err, "randao mixes")
+	}
+	for _, c := range d.slashings {
+		if c.index >= uint64(len(st.Slashings)) {
+			return errors.Errorf("slashings index %d out of range", c.index)
+		}
+		st.Slashings[c.index] = c.value
+	}
+	st.HistoricalRoots = append(st.HistoricalRoots, d.historicalRoots...)
+	for uint64(len(st.Validators)) < d.targetValidators {
+		st.Validators = append(st.Validators, nil)
+		st.Balances = append(st.Balances, 0)
+	}
+	for _, c := range d.validators {
+		if c.index >= d.targetValidators {
+			return errors.Errorf("validator index %d out of range", c.index)
+		}
+		st.Validators[c.index] = c.validator
+	}
+	for i, v := range st.Validators {
+		if v == nil {
+			return errors.Errorf("missing appended validator %d", i)
+		}
+	}
+	for i, delta := range d.balanceDeltas {
+		st.Balances[i] += uint64(delta)
+	}
+
+	st.GenesisTime = d.fixed.GenesisTime
+	st.GenesisValidatorsRoot = d.fixed.GenesisValidatorsRoot
+	st.Slot = d.fixed.Slot
+	st.Fork = d.fixed.Fork
+	st.LatestBlockHeader = d.fixed.LatestBlockHeader
+	st.Eth1Data = d.fixed.Eth1Data
+	st.Eth1DataVotes = d.fixed.Eth1DataVotes
+	st.Eth1DepositIndex = d.fixed.Eth1DepositIndex
+	st.PreviousEpochAttestations = d.fixed.PreviousEpochAttestations
+	st.CurrentEpochAttestations = d.fixed.CurrentEpochAttestations
+	st.JustificationBits = d.fixed.JustificationBits
+	st.PreviousJustifiedCheckpoint = d.fixed.PreviousJustifiedCheckpoint
+	st.CurrentJustifiedCheckpoint = d.fixed.CurrentJustifiedCheckpoint
+	st.FinalizedCheckpoint = d.fixed.FinalizedCheckpoint
+	return nil
+}
+
+// MarshalBinary encodes the difference. Indices, lengths and balance deltas are encoded as
+// variable length integers, the fixed size fields of the target state as a protobuf message.
+func (d *Diff) MarshalBinary() ([]byte, error) {
+	fixed, err := proto.Marshal(d.fixed)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, len(fixed)+len(d.validators)*(validatorSSZSize+4)+len(d.balanceDeltas)*3)
+	buf = appendUvarint(buf, uint64(len(fixed)))
+	buf = append(buf, fixed...)
+	for _, changes := range [][]*rootChange{d.blockRoots, d.stateRoots, d.randaoMixes} {
+		buf = appendUvarint(buf, uint64(len(changes)))
+		for _, c := range changes {
+			buf = appendUvarint(buf, c.index)
+			buf = append(buf, c.root...)
+		}
+	}
+	buf = appendUvarint(buf, uint64(len(d.slashings)))
+	for _, c := range d.slashings {
+		buf = appendUvarint(buf, c.index)
+		buf = appendUvarint(buf, c.value)
+	}
+	buf = appendUvarint(buf, d.baseHistoricalRoots)
+	buf = appendUvarint(buf, uint64(len(d.historicalRoots)))
+	for _, r := range d.historicalRoots {
+		buf = append(buf, r...)
+	}
+	buf = appendUvarint(buf, d.baseValidators)
+	buf = appendUvarint(buf, d.targetValidators)
+	buf = appendUvarint(buf, uint64(len(d.validators)))
+	for _, c := range d.validators {
+		enc, err := c.validator.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal validator %d", c.index)
+		}
+		buf = appendUvarint(buf, c.index)
+		buf = append(buf, enc...)
+	}
+	for _, delta := range d.balanceDeltas {
+		buf = appendVarint(buf, delta)
+	}
+	return buf, nil
+}
+
+// Unmarshal decodes a difference encoded with MarshalBinary.
+func Unmarshal(enc []byte) (*Diff, error) {
+	r := &reader{buf: enc}
+	d := &Diff{fixed: &statepb.BeaconState{}}
+	fixed := r.bytes(r.uvarint())
+	if r.err == nil {
+		if err := proto.Unmarshal(fixed, d.fixed); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal fixed state fields")
+		}
+	}
+	for _, changes := range []*[]*rootChange{&d.blockRoots, &d.stateRoots, &d.randaoMixes} {
+		n := r.count(1 + 32)
+		for i := uint64(0); i < n && r.err == nil; i++ {
+			*changes = append(*changes, &rootChange{index: r.uvarint(), root: r.bytes(32)})
+		}
+	}
+	n := r.count(2)
+	for i := uint64(0); i < n && r.err == nil; i++ {
+		d.slashings = append(d.slashings, &uint64Change{index: r.uvarint(), value: r.uvarint()})
+	}
+	d.baseHistoricalRoots = r.uvarint()
+	n = r.count(32)
+	for i := uint64(0); i < n && r.err == nil; i++ {
+		d.historicalRoots = append(d.historicalRoots, r.bytes(32))
+	}
+	d.baseValidators = r.uvarint()
+	d.targetValidators = r.uvarint()
+	n = r.count(1 + validatorSSZSize)
+	for i := uint64(0); i < n && r.err == nil; i++ {
+		c := &validatorChange{index: r.uvarint(), validator: &ethpb.Validator{}}
+		if enc := r.bytes(validatorSSZSize); r.err == nil {
+			if err := c.validator.UnmarshalSSZ(enc); err != nil {
+				return nil, errors.Wrapf(err, "could not unmarshal validator %d", c.index)
+			}
+		}
+		d.validators = append(d.validators, c)
+	}
+	if d.targetValidators > uint64(len(r.buf)-r.off) {
+		return nil, errors.New("difference has fewer balance deltas than validators")
+	}
+	d.balanceDeltas = make([]int64, d.targetValidators)
+	for i := range d.balanceDeltas {
+		d.balanceDeltas[i] = r.varint()
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.off != len(r.buf) {
+		return nil, errors.New("unexpected data after the state difference")
+	}
+	return d, nil
+}
+
+// reader decodes the fields of an encoded difference, recording the first error.
+type reader struct {
+	buf []byte
+	off int
+	err error
+}
+
+func (r *reader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf[r.off:])
+	if n <= 0 {
+		r.err = errors.New("invalid variable length integer")
+		return 0
+	}
+	r.off += n
+	return v
+}
+
+func (r *reader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.buf[r.off:])
+	if n <= 0 {
+		r.err = errors.New("invalid variable length integer")
+		return 0
+	}
+	r.off += n
+	return v
+}
+
+// count reads the number of items of a list, bounded by the remaining bytes given the minimum
+// encoded size of an item.
+func (r *reader) count(minSize int) uint64 {
+	n := r.uvarint()
+	if r.err == nil && n > uint64((len(r.buf)-r.off)/minSize) {
+		r.err = errors.New("list length exceeds the encoded difference")
+		return 0
+	}
+	return n
+}
+
+func (r *reader) bytes(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.buf)-r.off) {
+		r.err = errors.New("encoded state difference is truncated")
+		return nil
+	}
+	b := make([]byte, n)
+	copy(b, r.buf[r.off:])
+	r.off += int(n)
+	return b
+}
+
+func diffRoots(base, target [][]byte) ([]*rootChange, error) {
+	if len(base) != len(target) {
+		return nil, errors.New("vectors have different lengths")
+	}
+	var changes []*rootChange
+	for i, r := range target {
+		if len(r) != 32 {
+			return nil, errors.Errorf("invalid root length %d at index %d", len(r), i)
+		}
+		if !bytes.Equal(base[i], r) {
+			changes = append(changes, &rootChange{index: uint64(i), root: r})
+		}
+	}
+	return changes, nil
+}
+
+func applyRoots(roots [][]byte, changes []*rootChange) error {
+	for _, c := range changes {
+		if c.index >= uint64(len(roots)) {
+			return errors.Errorf("index %d out of range", c.index)
+		}
+		roots[c.index] = c.root
+	}
+	return nil
+}
+
+func validatorEqual(a, b *ethpb.Validator) bool {
+	return bytes.Equal(a.PublicKey, b.PublicKey) &&
+		bytes.Equal(a.WithdrawalCredentials, b.WithdrawalCredentials) &&
+		a.EffectiveBalance == b.EffectiveBalance &&
+		a.Slashed == b.Slashed &&
+		a.ActivationEligibilityEpoch == b.ActivationEligibilityEpoch &&
+		a.ActivationEpoch == b.ActivationEpoch &&
+		a.ExitEpoch == b.ExitEpoch &&
+		a.WithdrawableEpoch == b.WithdrawableEpoch
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
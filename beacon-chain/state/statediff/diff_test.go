@@ -0,0 +1,148 @@
+package statediff
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testStates(t *testing.T) (*statepb.BeaconState, *statepb.BeaconState) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	base, err := v1.ProtobufBeaconState(st.CloneInnerState())
+	require.NoError(t, err)
+	target, ok := proto.Clone(base).(*statepb.BeaconState)
+	require.Equal(t, true, ok)
+
+	target.Slot = params.BeaconConfig().SlotsPerEpoch
+	target.LatestBlockHeader = &ethpb.BeaconBlockHeader{
+		Slot:       target.Slot - 1,
+		ParentRoot: bytesutil.PadTo([]byte("parent"), 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   bytesutil.PadTo([]byte("body"), 32),
+	}
+	target.BlockRoots[3] = bytesutil.PadTo([]byte("block"), 32)
+	target.StateRoots[5] = bytesutil.PadTo([]byte("state"), 32)
+	target.RandaoMixes[1] = bytesutil.PadTo([]byte("randao"), 32)
+	target.Slashings[2] = 32e9
+	target.HistoricalRoots = append(target.HistoricalRoots, bytesutil.PadTo([]byte("historical"), 32))
+	target.Balances[0] += 1000
+	target.Balances[1] -= 5e8
+	target.Validators[4] = &ethpb.Validator{
+		PublicKey:                  base.Validators[4].PublicKey,
+		WithdrawalCredentials:      base.Validators[4].WithdrawalCredentials,
+		EffectiveBalance:           base.Validators[4].EffectiveBalance,
+		ActivationEligibilityEpoch: base.Validators[4].ActivationEligibilityEpoch,
+		ActivationEpoch:            base.Validators[4].ActivationEpoch,
+		ExitEpoch:                  10,
+		WithdrawableEpoch:          266,
+	}
+	target.Validators = append(target.Validators, &ethpb.Validator{
+		PublicKey:                  bytesutil.PadTo([]byte("new"), 48),
+		WithdrawalCredentials:      make([]byte, 32),
+		EffectiveBalance:           params.BeaconConfig().MaxEffectiveBalance,
+		ActivationEligibilityEpoch: params.BeaconConfig().FarFutureEpoch,
+		ActivationEpoch:            params.BeaconConfig().FarFutureEpoch,
+		ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+		WithdrawableEpoch:          params.BeaconConfig().FarFutureEpoch,
+	})
+	target.Balances = append(target.Balances, params.BeaconConfig().MaxEffectiveBalance)
+	target.Eth1DepositIndex = 65
+	target.JustificationBits = []byte{0x01}
+	target.CurrentJustifiedCheckpoint = &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("justified"), 32)}
+	target.CurrentEpochAttestations = []*statepb.PendingAttestation{{
+		AggregationBits: []byte{0x03},
+		Data:            testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 2}),
+		InclusionDelay:  1,
+	}}
+	return base, target
+}
+
+func TestDiff_ComputeApply(t *testing.T) {
+	base, target := testStates(t)
+	d, err := Compute(base, target)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(d.blockRoots))
+	require.Equal(t, 2, len(d.validators))
+
+	require.NoError(t, d.Apply(base))
+	wanted, err := target.HashTreeRoot()
+	require.NoError(t, err)
+	got, err := base.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, wanted, got)
+}
+
+func TestDiff_MarshalRoundTrip(t *testing.T) {
+	base, target := testStates(t)
+	d, err := Compute(base, target)
+	require.NoError(t, err)
+	enc, err := d.MarshalBinary()
+	require.NoError(t, err)
+	decoded, err := Unmarshal(enc)
+	require.NoError(t, err)
+
+	require.NoError(t, decoded.Apply(base))
+	require.DeepSSZEqual(t, target, base)
+}
+
+func TestDiff_Unchanged(t *testing.T) {
+	base, _ := testStates(t)
+	target, ok := proto.Clone(base).(*statepb.BeaconState)
+	require.Equal(t, true, ok)
+	d, err := Compute(base, target)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(d.blockRoots))
+	require.Equal(t, 0, len(d.validators))
+	enc, err := d.MarshalBinary()
+	require.NoError(t, err)
+	decoded, err := Unmarshal(enc)
+	require.NoError(t, err)
+	require.NoError(t, decoded.Apply(base))
+	require.DeepSSZEqual(t, target, base)
+}
+
+func TestCompute_ShrinkingRegistry(t *testing.T) {
+	base, target := testStates(t)
+	_, err := Compute(target, base)
+	require.ErrorContains(t, "fewer historical roots", err)
+
+	target.HistoricalRoots = base.HistoricalRoots
+	_, err = Compute(target, base)
+	require.ErrorContains(t, "fewer validators", err)
+}
+
+func TestDiff_ApplyWrongBase(t *testing.T) {
+	base, target := testStates(t)
+	d, err := Compute(base, target)
+	require.NoError(t, err)
+	require.ErrorContains(t, "not the base state", d.Apply(target))
+}
+
+func TestUnmarshal_Truncated(t *testing.T) {
+	base, target := testStates(t)
+	d, err := Compute(base, target)
+	require.NoError(t, err)
+	enc, err := d.MarshalBinary()
+	require.NoError(t, err)
+	for _, n := range []int{0, 10, len(enc) / 2, len(enc) - 1} {
+		_, err := Unmarshal(enc[:n])
+		require.NotNil(t, err, "expected an error for %d bytes", n)
+	}
+	_, err = Unmarshal(append(enc, 0))
+	require.ErrorContains(t, "unexpected data", err)
+}
+
+func TestDiff_Slot(t *testing.T) {
+	base, target := testStates(t)
+	d, err := Compute(base, target)
+	require.NoError(t, err)
+	require.Equal(t, types.Slot(params.BeaconConfig().SlotsPerEpoch), d.Slot())
+}
@@ -173,6 +173,17 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 	}
 	targetSlot := summary.Slot
 
+	// Finalized states are rebuilt from the saved state of their epoch when it is available.
+	if s.epochsPerStateSnapshot > 0 && targetSlot < s.finalizedSlot() && s.beaconDB.IsFinalizedBlock(ctx, blockRoot) {
+		st, err := s.loadColdStateByRoot(ctx, blockRoot, targetSlot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load finalized state using root")
+		}
+		if st != nil {
+			return st, nil
+		}
+	}
+
 	// Since the requested state is not in caches, start replaying using the last available ancestor state which is
 	// retrieved using input block's parent root.
 	startState, err := s.lastAncestorState(ctx, blockRoot)
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/sirupsen/logrus"
@@ -38,6 +39,12 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 			return ctx.Err()
 		}
 
+		if s.epochsPerStateSnapshot > 0 && helpers.IsEpochStart(slot) && slot != 0 {
+			if err := s.saveEpochState(ctx, slot); err != nil {
+				return err
+			}
+		}
+
 		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
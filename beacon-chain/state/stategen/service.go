@@ -48,6 +48,15 @@ type State struct {
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
+	epochsPerStateSnapshot  types.Epoch
+	lastEpochState          *epochState
+}
+
+// This tracks the last state saved in the cold section as a snapshot or a difference,
+// which is the base of the difference saved for the next epoch.
+type epochState struct {
+	epoch types.Epoch
+	state state.BeaconState
 }
 
 // This tracks the config in the event of long non-finality,
@@ -80,6 +89,7 @@ func New(beaconDB db.NoHeadAccessDatabase) *State {
 		saveHotStateDB: &saveHotStateDbConfig{
 			duration: defaultHotStateDBInterval,
 		},
+		epochsPerStateSnapshot: params.BeaconConfig().EpochsPerStateSnapshot,
 	}
 }
 
@@ -134,6 +144,13 @@ func (s *State) isFinalizedRoot(r [32]byte) bool {
 	return r == s.finalizedInfo.root
 }
 
+// Returns the slot of the finalized state.
+func (s *State) finalizedSlot() types.Slot {
+	s.finalizedInfo.lock.RLock()
+	defer s.finalizedInfo.lock.RUnlock()
+	return s.finalizedInfo.slot
+}
+
 // Returns the cached and copied finalized state.
 func (s *State) finalizedState() state.BeaconState {
 	s.finalizedInfo.lock.RLock()
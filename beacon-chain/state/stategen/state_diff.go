@@ -0,0 +1,110 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// This saves the state at the start slot of a finalized epoch in the cold section, as a snapshot every
+// epochsPerStateSnapshot epochs and otherwise as the difference from the state of the previous epoch.
+func (s *State) saveEpochState(ctx context.Context, slot types.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveEpochState")
+	defer span.End()
+
+	epoch := helpers.SlotToEpoch(slot)
+	st, err := s.epochStartState(ctx, slot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get state of epoch %d", epoch)
+	}
+
+	var base state.BeaconState
+	if epoch%s.epochsPerStateSnapshot != 0 {
+		if s.lastEpochState != nil && s.lastEpochState.epoch+1 == epoch {
+			base = s.lastEpochState.state
+		} else {
+			// The previous epoch state is not in memory after a restart.
+			base, err = s.beaconDB.EpochState(ctx, epoch-1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if base == nil || base.IsNil() {
+		if err := s.beaconDB.SaveStateSnapshot(ctx, epoch, st); err != nil {
+			return err
+		}
+		log.WithFields(logrus.Fields{
+			"epoch": epoch,
+			"slot":  slot,
+		}).Info("Saved state snapshot in DB")
+	} else if err := s.beaconDB.SaveStateDiff(ctx, epoch, base, st); err != nil {
+		return err
+	}
+	s.lastEpochState = &epochState{epoch: epoch, state: st}
+	return nil
+}
+
+// This returns the state at the start slot of an epoch, from the epoch boundary state cache or
+// regenerated from the last block at or before the slot.
+func (s *State) epochStartState(ctx context.Context, slot types.Slot) (state.BeaconState, error) {
+	cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return cached.state.Copy(), nil
+	}
+
+	blks, err := s.beaconDB.HighestSlotBlocksBelow(ctx, slot+1)
+	if err != nil {
+		return nil, err
+	}
+	// Given the block has been finalized, the db should not have more than one block in a given slot.
+	if len(blks) != 1 {
+		return nil, errUnknownBlock
+	}
+	root, err := blks[0].Block().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.StateByRoot(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil || st.IsNil() {
+		return nil, errUnknownState
+	}
+	if st.Slot() == slot {
+		return st.Copy(), nil
+	}
+	return processSlotsStateGen(ctx, st.Copy(), slot)
+}
+
+// This loads a finalized state by replaying the blocks of its epoch on the saved state of the epoch.
+// It returns nil if no state was saved for the epoch.
+func (s *State) loadColdStateByRoot(ctx context.Context, blockRoot [32]byte, targetSlot types.Slot) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.loadColdStateByRoot")
+	defer span.End()
+
+	st, err := s.beaconDB.EpochState(ctx, helpers.SlotToEpoch(targetSlot))
+	if err != nil || st == nil || st.IsNil() {
+		return nil, err
+	}
+	if st.Slot() == targetSlot {
+		return st, nil
+	}
+
+	blks, err := s.LoadBlocks(ctx, st.Slot()+1, targetSlot, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load blocks for cold state using root")
+	}
+	replayBlockCount.Observe(float64(len(blks)))
+
+	return s.ReplayBlocks(ctx, st, blks, targetSlot)
+}
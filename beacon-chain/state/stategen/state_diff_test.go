@@ -0,0 +1,116 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestMigrateToCold_SavesEpochStates(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB)
+	service.epochsPerStateSnapshot = 2
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	var states []state.BeaconState
+	for epoch := types.Epoch(1); epoch <= 3; epoch++ {
+		st := beaconState.Copy()
+		require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))))
+		require.NoError(t, st.UpdateBalancesAtIndex(types.ValidatorIndex(epoch), uint64(epoch)*1e9))
+		require.NoError(t, service.epochBoundaryStateCache.put([32]byte{byte(epoch)}, st))
+		states = append(states, st)
+	}
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 3*params.BeaconConfig().SlotsPerEpoch + 1
+	fRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	for i, want := range states {
+		got, err := service.beaconDB.EpochState(ctx, types.Epoch(i+1))
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.DeepSSZEqual(t, want.InnerStateUnsafe(), got.InnerStateUnsafe())
+	}
+	assert.Equal(t, types.Epoch(3), service.lastEpochState.epoch)
+	require.LogsContain(t, hook, "Saved state snapshot in DB")
+	require.LogsDoNotContain(t, hook, "Saved state in DB")
+}
+
+func TestSaveEpochState_DiffAfterRestart(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB)
+	service.epochsPerStateSnapshot = 4
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	st1 := beaconState.Copy()
+	require.NoError(t, st1.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, beaconDB.SaveStateSnapshot(ctx, 1, st1))
+
+	st2 := beaconState.Copy()
+	require.NoError(t, st2.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, st2.UpdateBalancesAtIndex(0, 1))
+	require.NoError(t, service.epochBoundaryStateCache.put([32]byte{'a'}, st2))
+	require.NoError(t, service.saveEpochState(ctx, st2.Slot()))
+
+	// The state of the second epoch is saved as a difference from the state in DB.
+	require.LogsDoNotContain(t, hook, "Saved state snapshot in DB")
+	got, err := beaconDB.EpochState(ctx, 2)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st2.InnerStateUnsafe(), got.InnerStateUnsafe())
+}
+
+func TestStateByRoot_ColdStateFromEpochState(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB)
+	service.epochsPerStateSnapshot = 4
+	beaconState, pks := testutil.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	// The genesis state is only saved as the state of the first epoch.
+	epochStart := params.BeaconConfig().SlotsPerEpoch
+	epochState, err := processSlotsStateGen(ctx, beaconState.Copy(), epochStart)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveStateSnapshot(ctx, 1, epochState))
+
+	b, err := testutil.GenerateFullBlock(beaconState, pks, testutil.DefaultBlockGenConfig(), epochStart+2)
+	require.NoError(t, err)
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &statepb.StateSummary{Slot: b.Block.Slot, Root: r[:]}))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: r[:]}))
+	service.finalizedInfo = &finalizedInfo{slot: 2 * epochStart, root: [32]byte{'f'}}
+
+	st, err := service.StateByRoot(ctx, r)
+	require.NoError(t, err)
+	assert.Equal(t, b.Block.Slot, st.Slot())
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, b.Block.StateRoot, stateRoot[:])
+}
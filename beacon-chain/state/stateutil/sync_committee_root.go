@@ -0,0 +1,60 @@
+package stateutil
+
+import (
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// SyncCommitteeRoot computes the HashTreeRoot Merkleization of
+// a SyncCommittee struct according to the Ethereum
+// Simple Serialize specification.
+func SyncCommitteeRoot(committee *statepb.SyncCommittee) ([32]byte, error) {
+	hasher := hashutil.CustomSHA256Hasher()
+	if committee == nil {
+		committee = &statepb.SyncCommittee{}
+	}
+
+	// Field 1: Vector[BLSPubkey, SYNC_COMMITTEE_SIZE]
+	size := params.BeaconConfig().SyncCommitteeSize
+	pubKeyRoots := make([][32]byte, size)
+	for i := uint64(0); i < size; i++ {
+		var pubkey []byte
+		if i < uint64(len(committee.Pubkeys)) {
+			pubkey = committee.Pubkeys[i]
+		}
+		r, err := merkleizePubkey(hasher, pubkey)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		pubKeyRoots[i] = r
+	}
+	pubkeyRoot, err := htrutils.BitwiseMerkleizeArrays(hasher, pubKeyRoots, uint64(len(pubKeyRoots)), uint64(len(pubKeyRoots)))
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	// Field 2: BLSPubkey
+	aggregateKeyRoot, err := merkleizePubkey(hasher, committee.AggregatePubkey)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	fieldRoots := [][32]byte{pubkeyRoot, aggregateKeyRoot}
+	return htrutils.BitwiseMerkleizeArrays(hasher, fieldRoots, uint64(len(fieldRoots)), uint64(len(fieldRoots)))
+}
+
+// merkleizePubkey packs a 48 byte public key into 32 byte chunks and merkleizes them.
+func merkleizePubkey(hasher htrutils.HashFn, pubkey []byte) ([32]byte, error) {
+	key := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	copy(key, pubkey)
+	chunks, err := htrutils.Pack([][]byte{key})
+	if err != nil {
+		return [32]byte{}, err
+	}
+	outputChunks := make([][32]byte, len(chunks))
+	for i, c := range chunks {
+		copy(outputChunks[i][:], c)
+	}
+	return htrutils.BitwiseMerkleizeArrays(hasher, outputChunks, uint64(len(outputChunks)), uint64(len(outputChunks)))
+}
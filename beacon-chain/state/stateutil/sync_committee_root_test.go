@@ -0,0 +1,28 @@
+package stateutil_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncCommitteeRoot_MatchesSSZ(t *testing.T) {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = bytesutil.PadTo([]byte{byte(i), byte(i >> 8)}, 48)
+	}
+	committee := &statepb.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: bytesutil.PadTo([]byte{'a'}, 48),
+	}
+	want, err := committee.HashTreeRoot()
+	require.NoError(t, err)
+	got, err := stateutil.SyncCommitteeRoot(committee)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+}
@@ -6,8 +6,6 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
-	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
 
 // FieldTrie is the representation of the representative
@@ -17,6 +15,7 @@ type FieldTrie struct {
 	reference   *stateutil.Reference
 	fieldLayers [][]*[32]byte
 	field       fieldIndex
+	length      uint64
 }
 
 // NewFieldTrie is the constructor for the field trie data structure. It creates the corresponding
@@ -28,6 +27,7 @@ func NewFieldTrie(field fieldIndex, elements interface{}, length uint64) (*Field
 			field:     field,
 			reference: stateutil.NewRef(1),
 			RWMutex:   new(sync.RWMutex),
+			length:    length,
 		}, nil
 	}
 	datType, ok := fieldMap[field]
@@ -38,6 +38,9 @@ func NewFieldTrie(field fieldIndex, elements interface{}, length uint64) (*Field
 	if err != nil {
 		return nil, err
 	}
+	if err := validateElements(field, elements, length); err != nil {
+		return nil, err
+	}
 	switch datType {
 	case basicArray:
 		return &FieldTrie{
@@ -45,6 +48,7 @@ func NewFieldTrie(field fieldIndex, elements interface{}, length uint64) (*Field
 			field:       field,
 			reference:   stateutil.NewRef(1),
 			RWMutex:     new(sync.RWMutex),
+			length:      length,
 		}, nil
 	case compositeArray:
 		return &FieldTrie{
@@ -52,6 +56,7 @@ func NewFieldTrie(field fieldIndex, elements interface{}, length uint64) (*Field
 			field:       field,
 			reference:   stateutil.NewRef(1),
 			RWMutex:     new(sync.RWMutex),
+			length:      length,
 		}, nil
 	default:
 		return nil, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(datType).Name())
@@ -77,6 +82,9 @@ func (f *FieldTrie) RecomputeTrie(indices []uint64, elements interface{}) ([32]b
 	if err != nil {
 		return [32]byte{}, err
 	}
+	if err := f.validateIndices(indices); err != nil {
+		return [32]byte{}, err
+	}
 	switch datType {
 	case basicArray:
 		fieldRoot, f.fieldLayers, err = stateutil.RecomputeFromLayer(fieldRoots, indices, f.fieldLayers)
@@ -104,6 +112,7 @@ func (f *FieldTrie) CopyTrie() *FieldTrie {
 			field:     f.field,
 			reference: stateutil.NewRef(1),
 			RWMutex:   new(sync.RWMutex),
+			length:    f.length,
 		}
 	}
 	dstFieldTrie := make([][]*[32]byte, len(f.fieldLayers))
@@ -116,6 +125,7 @@ func (f *FieldTrie) CopyTrie() *FieldTrie {
 		field:       f.field,
 		reference:   stateutil.NewRef(1),
 		RWMutex:     new(sync.RWMutex),
+		length:      f.length,
 	}
 }
 
@@ -135,32 +145,3 @@ func (f *FieldTrie) TrieRoot() ([32]byte, error) {
 		return [32]byte{}, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(datType).Name())
 	}
 }
-
-// this converts the corresponding field and the provided elements to the appropriate roots.
-func fieldConverters(field fieldIndex, indices []uint64, elements interface{}, convertAll bool) ([][32]byte, error) {
-	switch field {
-	case blockRoots, stateRoots, randaoMixes:
-		val, ok := elements.([][]byte)
-		if !ok {
-			return nil, errors.Errorf("Wanted type of %v but got %v",
-				reflect.TypeOf([][]byte{}).Name(), reflect.TypeOf(elements).Name())
-		}
-		return stateutil.HandleByteArrays(val, indices, convertAll)
-	case eth1DataVotes:
-		val, ok := elements.([]*ethpb.Eth1Data)
-		if !ok {
-			return nil, errors.Errorf("Wanted type of %v but got %v",
-				reflect.TypeOf([]*ethpb.Eth1Data{}).Name(), reflect.TypeOf(elements).Name())
-		}
-		return v1.HandleEth1DataSlice(val, indices, convertAll)
-	case validators:
-		val, ok := elements.([]*ethpb.Validator)
-		if !ok {
-			return nil, errors.Errorf("Wanted type of %v but got %v",
-				reflect.TypeOf([]*ethpb.Validator{}).Name(), reflect.TypeOf(elements).Name())
-		}
-		return stateutil.HandleValidatorSlice(val, indices, convertAll)
-	default:
-		return [][32]byte{}, errors.Errorf("got unsupported type of %v", reflect.TypeOf(elements).Name())
-	}
-}
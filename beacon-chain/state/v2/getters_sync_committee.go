@@ -0,0 +1,73 @@
+package v2
+
+import (
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// currentSyncCommittee of the current sync committee in beacon chain state.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) currentSyncCommittee() *statepb.SyncCommittee {
+	if !b.hasInnerState() {
+		return nil
+	}
+
+	return copySyncCommittee(b.state.CurrentSyncCommittee)
+}
+
+// nextSyncCommittee of the next sync committee in beacon chain state.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) nextSyncCommittee() *statepb.SyncCommittee {
+	if !b.hasInnerState() {
+		return nil
+	}
+
+	return copySyncCommittee(b.state.NextSyncCommittee)
+}
+
+// CurrentSyncCommittee of the current sync committee in beacon chain state.
+func (b *BeaconState) CurrentSyncCommittee() (*statepb.SyncCommittee, error) {
+	if !b.hasInnerState() {
+		return nil, nil
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	if b.state.CurrentSyncCommittee == nil {
+		return nil, nil
+	}
+
+	return b.currentSyncCommittee(), nil
+}
+
+// NextSyncCommittee of the next sync committee in beacon chain state.
+func (b *BeaconState) NextSyncCommittee() (*statepb.SyncCommittee, error) {
+	if !b.hasInnerState() {
+		return nil, nil
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	if b.state.NextSyncCommittee == nil {
+		return nil, nil
+	}
+
+	return b.nextSyncCommittee(), nil
+}
+
+// copySyncCommittee copies the provided sync committee object.
+func copySyncCommittee(data *statepb.SyncCommittee) *statepb.SyncCommittee {
+	if data == nil {
+		return nil
+	}
+	pubkeys := make([][]byte, len(data.Pubkeys))
+	for i, pubkey := range data.Pubkeys {
+		pubkeys[i] = bytesutil.SafeCopyBytes(pubkey)
+	}
+	return &statepb.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: bytesutil.SafeCopyBytes(data.AggregatePubkey),
+	}
+}
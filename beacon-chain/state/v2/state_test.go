@@ -0,0 +1,291 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBeaconState_HashTreeRoot_IncrementalMatchesFull(t *testing.T) {
+	st, err := InitializeFromProto(fullStateProto(64))
+	require.NoError(t, err)
+
+	// fullRoot recomputes every field root from scratch on a fresh state.
+	fullRoot := func() [32]byte {
+		fresh, err := InitializeFromProto(st.CloneInnerState().(*statepb.BeaconStateAltair))
+		require.NoError(t, err)
+		r, err := fresh.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		return r
+	}
+
+	got, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, fullRoot(), got)
+
+	// Mutate each of the Altair specific fields and ensure the
+	// incrementally computed root still matches the full root.
+	require.NoError(t, st.AppendCurrentParticipationBits(7))
+	require.NoError(t, st.AppendPreviousParticipationBits(3))
+	require.NoError(t, st.AppendInactivityScore(10))
+	require.NoError(t, st.SetInactivityScores(make([]uint64, 64)))
+	committee := syncCommittee(2)
+	require.NoError(t, st.SetCurrentSyncCommittee(committee))
+	require.NoError(t, st.SetNextSyncCommittee(committee))
+
+	got, err = st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, fullRoot(), got)
+}
+
+func TestBeaconState_Copy_AltairFields(t *testing.T) {
+	st, err := InitializeFromProto(fullStateProto(8))
+	require.NoError(t, err)
+	cp := st.Copy()
+
+	require.NoError(t, st.SetCurrentParticipationBits([]byte{1, 1, 1, 1, 1, 1, 1, 1}))
+	require.NoError(t, st.SetInactivityScores([]uint64{1, 2, 3, 4, 5, 6, 7, 8}))
+	require.NoError(t, st.SetNextSyncCommittee(syncCommittee(3)))
+
+	bits, err := cp.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, 8), bits)
+	scores, err := cp.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]uint64, 8), scores)
+	committee, err := cp.NextSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, syncCommittee(1), committee)
+}
+
+func TestBeaconState_Copy_ValidatorsCopyOnWrite(t *testing.T) {
+	st, err := InitializeFromProto(fullStateProto(8))
+	require.NoError(t, err)
+	cp := st.Copy()
+
+	val, err := cp.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, cp.UpdateValidatorAtIndex(1, val))
+	require.NoError(t, cp.AppendValidator(&ethpb.Validator{PublicKey: bytesutil.PadTo([]byte{'a'}, 48)}))
+
+	original, err := st.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	assert.Equal(t, false, original.Slashed)
+	assert.Equal(t, 8, st.NumValidators())
+	assert.Equal(t, 9, cp.NumValidators())
+
+	// Mutating the original after the copy diverged must not leak into the copy either.
+	val, err = st.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	val.ExitEpoch = 10
+	require.NoError(t, st.UpdateValidatorAtIndex(2, val))
+	copied, err := cp.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().FarFutureEpoch, copied.ExitEpoch)
+}
+
+func TestBeaconState_Copy_BalancesCopyOnWrite(t *testing.T) {
+	st, err := InitializeFromProto(fullStateProto(8))
+	require.NoError(t, err)
+	cp := st.Copy()
+
+	require.NoError(t, cp.UpdateBalancesAtIndex(0, 1))
+	require.NoError(t, cp.AppendBalance(2))
+
+	bal, err := st.BalanceAtIndex(0)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, bal)
+	assert.Equal(t, 8, st.BalancesLength())
+	assert.Equal(t, 9, cp.BalancesLength())
+
+	require.NoError(t, st.UpdateBalancesAtIndex(3, 4))
+	bal, err = cp.BalanceAtIndex(3)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, bal)
+}
+
+func TestBeaconState_Copy_InactivityScoresCopyOnWrite(t *testing.T) {
+	st, err := InitializeFromProto(fullStateProto(8))
+	require.NoError(t, err)
+	cp := st.Copy()
+
+	require.NoError(t, cp.AppendInactivityScore(5))
+	scores, err := st.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]uint64, 8), scores)
+
+	// Mutating the returned slice must not change the state.
+	scores[0] = 100
+	scores, err = st.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]uint64, 8), scores)
+
+	require.NoError(t, st.AppendInactivityScore(7))
+	scores, err = cp.InactivityScores()
+	require.NoError(t, err)
+	assert.DeepEqual(t, append(make([]uint64, 8), 5), scores)
+}
+
+func TestBeaconState_Copy_ParticipationCopyOnWrite(t *testing.T) {
+	st, err := InitializeFromProto(fullStateProto(8))
+	require.NoError(t, err)
+	cp := st.Copy()
+
+	require.NoError(t, cp.AppendCurrentParticipationBits(1))
+	require.NoError(t, cp.AppendPreviousParticipationBits(2))
+
+	current, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, 8), current)
+	previous, err := st.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, 8), previous)
+
+	// Mutating the returned slices must not change the state.
+	current[0] = 7
+	previous[0] = 7
+	current, err = st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, 8), current)
+	previous, err = st.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, 8), previous)
+
+	require.NoError(t, st.AppendCurrentParticipationBits(3))
+	require.NoError(t, st.AppendPreviousParticipationBits(4))
+	current, err = cp.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, append(make([]byte, 8), 1), current)
+	previous, err = cp.PreviousEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, append(make([]byte, 8), 2), previous)
+}
+
+func TestBeaconState_Copy_RootsCopyOnWrite(t *testing.T) {
+	st, err := InitializeFromProto(fullStateProto(8))
+	require.NoError(t, err)
+	cp := st.Copy()
+
+	require.NoError(t, cp.UpdateBlockRootAtIndex(0, [32]byte{'a'}))
+	require.NoError(t, cp.UpdateStateRootAtIndex(0, [32]byte{'b'}))
+	require.NoError(t, cp.UpdateRandaoMixesAtIndex(0, bytesutil.PadTo([]byte{'c'}, 32)))
+	require.NoError(t, cp.UpdateSlashingsAtIndex(0, 1))
+
+	zeroRoot := make([]byte, 32)
+	blockRoot, err := st.BlockRootAtIndex(0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, zeroRoot, blockRoot)
+	stateRoot, err := st.StateRootAtIndex(0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, zeroRoot, stateRoot)
+	mix, err := st.RandaoMixAtIndex(0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, zeroRoot, mix)
+	assert.Equal(t, uint64(0), st.Slashings()[0])
+}
+
+func TestBeaconState_SyncCommittee_ReturnsCopy(t *testing.T) {
+	st, err := InitializeFromProto(fullStateProto(8))
+	require.NoError(t, err)
+
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	committee.Pubkeys[0][0] = 'x'
+	committee.AggregatePubkey[0] = 'x'
+
+	committee, err = st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, syncCommittee(1), committee)
+}
+
+func TestBeaconState_UnsupportedMethods(t *testing.T) {
+	st, err := InitializeFromProto(&statepb.BeaconStateAltair{})
+	require.NoError(t, err)
+
+	_, err = st.PreviousEpochAttestations()
+	assert.ErrorContains(t, "PreviousEpochAttestations is not supported", err)
+	_, err = st.CurrentEpochAttestations()
+	assert.ErrorContains(t, "CurrentEpochAttestations is not supported", err)
+	assert.ErrorContains(t, "AppendCurrentEpochAttestations is not supported", st.AppendCurrentEpochAttestations(nil))
+	assert.ErrorContains(t, "AppendPreviousEpochAttestations is not supported", st.AppendPreviousEpochAttestations(nil))
+	assert.ErrorContains(t, "RotateAttestations is not supported", st.RotateAttestations())
+}
+
+// fullStateProto returns an Altair state proto with every vector field sized
+// to its SSZ length, so the result can be hashed by fastssz directly.
+func fullStateProto(numValidators int) *statepb.BeaconStateAltair {
+	cfg := params.BeaconConfig()
+	zeroRoot := make([]byte, 32)
+	roots := func(n uint64) [][]byte {
+		r := make([][]byte, n)
+		for i := range r {
+			r[i] = bytesutil.PadTo(bytesutil.Bytes8(uint64(i)), 32)
+		}
+		return r
+	}
+	vals := make([]*ethpb.Validator, numValidators)
+	balances := make([]uint64, numValidators)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{
+			PublicKey:             bytesutil.PadTo(bytesutil.Bytes8(uint64(i)), 48),
+			WithdrawalCredentials: zeroRoot,
+			EffectiveBalance:      cfg.MaxEffectiveBalance,
+			ExitEpoch:             cfg.FarFutureEpoch,
+			WithdrawableEpoch:     cfg.FarFutureEpoch,
+		}
+		balances[i] = cfg.MaxEffectiveBalance
+	}
+	return &statepb.BeaconStateAltair{
+		GenesisTime:           1,
+		GenesisValidatorsRoot: zeroRoot,
+		Slot:                  5,
+		Fork: &statepb.Fork{
+			PreviousVersion: cfg.GenesisForkVersion,
+			CurrentVersion:  cfg.AltairForkVersion,
+		},
+		LatestBlockHeader: &ethpb.BeaconBlockHeader{
+			ParentRoot: zeroRoot,
+			StateRoot:  zeroRoot,
+			BodyRoot:   zeroRoot,
+		},
+		BlockRoots:      roots(uint64(cfg.SlotsPerHistoricalRoot)),
+		StateRoots:      roots(uint64(cfg.SlotsPerHistoricalRoot)),
+		HistoricalRoots: roots(2),
+		Eth1Data: &ethpb.Eth1Data{
+			DepositRoot: zeroRoot,
+			BlockHash:   zeroRoot,
+		},
+		Eth1DataVotes:               []*ethpb.Eth1Data{},
+		Validators:                  vals,
+		Balances:                    balances,
+		RandaoMixes:                 roots(uint64(cfg.EpochsPerHistoricalVector)),
+		Slashings:                   make([]uint64, cfg.EpochsPerSlashingsVector),
+		PreviousEpochParticipation:  make([]byte, numValidators),
+		CurrentEpochParticipation:   make([]byte, numValidators),
+		JustificationBits:           []byte{0},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: zeroRoot},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: zeroRoot},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Root: zeroRoot},
+		InactivityScores:            make([]uint64, numValidators),
+		CurrentSyncCommittee:        syncCommittee(1),
+		NextSyncCommittee:           syncCommittee(1),
+	}
+}
+
+func syncCommittee(seed byte) *statepb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = bytesutil.PadTo([]byte{seed, byte(i)}, params.BeaconConfig().BLSPubkeyLength)
+	}
+	return &statepb.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: bytesutil.PadTo([]byte{seed}, params.BeaconConfig().BLSPubkeyLength),
+	}
+}
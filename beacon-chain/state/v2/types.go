@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	fieldMap = make(map[fieldIndex]dataType, params.BeaconConfig().BeaconStateFieldCount)
+	fieldMap = make(map[fieldIndex]dataType, params.BeaconConfig().BeaconStateAltairFieldCount)
 
 	// Initialize the fixed sized arrays.
 	fieldMap[blockRoots] = basicArray
@@ -78,7 +78,7 @@ var ErrNilInnerState = errors.New("nil inner state")
 type BeaconState struct {
 	state                 *statepb.BeaconStateAltair
 	lock                  sync.RWMutex
-	dirtyFields           map[fieldIndex]interface{}
+	dirtyFields           map[fieldIndex]bool
 	dirtyIndices          map[fieldIndex][]uint64
 	stateFieldLeaves      map[fieldIndex]*FieldTrie
 	rebuildTrie           map[fieldIndex]bool
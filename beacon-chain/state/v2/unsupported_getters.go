@@ -0,0 +1,22 @@
+package v2
+
+import (
+	"github.com/pkg/errors"
+	v1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+)
+
+// PreviousEpochAttestations is not supported for hard fork 1 beacon state.
+func (b *BeaconState) PreviousEpochAttestations() ([]*statepb.PendingAttestation, error) {
+	return nil, errors.New("PreviousEpochAttestations is not supported for hard fork 1 beacon state")
+}
+
+// CurrentEpochAttestations is not supported for hard fork 1 beacon state.
+func (b *BeaconState) CurrentEpochAttestations() ([]*statepb.PendingAttestation, error) {
+	return nil, errors.New("CurrentEpochAttestations is not supported for hard fork 1 beacon state")
+}
+
+// ToProto is not supported for hard fork 1 beacon state.
+func (b *BeaconState) ToProto() (*v1.BeaconState, error) {
+	return nil, errors.New("ToProto is not supported for hard fork 1 beacon state")
+}
@@ -0,0 +1,21 @@
+package v2
+
+import (
+	"github.com/pkg/errors"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+)
+
+// AppendCurrentEpochAttestations is not supported for hard fork 1 beacon state.
+func (b *BeaconState) AppendCurrentEpochAttestations(val *statepb.PendingAttestation) error {
+	return errors.New("AppendCurrentEpochAttestations is not supported for hard fork 1 beacon state")
+}
+
+// AppendPreviousEpochAttestations is not supported for hard fork 1 beacon state.
+func (b *BeaconState) AppendPreviousEpochAttestations(val *statepb.PendingAttestation) error {
+	return errors.New("AppendPreviousEpochAttestations is not supported for hard fork 1 beacon state")
+}
+
+// RotateAttestations is not supported for hard fork 1 beacon state.
+func (b *BeaconState) RotateAttestations() error {
+	return errors.New("RotateAttestations is not supported for hard fork 1 beacon state")
+}
@@ -0,0 +1,268 @@
+// Package backfill downloads the blocks preceding the checkpoint a beacon node was synced from,
+// walking the chain backwards from the checkpoint block until it reaches the genesis block.
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+const (
+	// syncCheckInterval is how often the service checks whether initial sync has completed.
+	syncCheckInterval = 12 * time.Second
+	// batchInterval is the pause between two batches, which keeps backfilling at a low priority
+	// compared to following the head of the chain.
+	batchInterval = 2 * time.Second
+	// retryInterval is the pause after a failed batch, or while no suitable peer is connected.
+	retryInterval = 12 * time.Second
+)
+
+// errInvalidBatch is returned when the blocks received from a peer do not extend the backfilled chain.
+var errInvalidBatch = errors.New("invalid backfill batch")
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P         p2p.P2P
+	DB          db.NoHeadAccessDatabase
+	Chain       blockchain.ChainInfoFetcher
+	InitialSync prysmsync.Checker
+}
+
+// Service downloads the blocks before the origin checkpoint of the database, in batches of
+// consecutive slots going backwards.
+type Service struct {
+	cfg         *Config
+	ctx         context.Context
+	cancel      context.CancelFunc
+	batchSize   uint64
+	originState state.ReadOnlyBeaconState
+	// endSlot is the exclusive upper bound of the next requested batch, lowered past empty
+	// slot ranges. Zero means the batch ends at the current backfill block.
+	endSlot types.Slot
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:       cfg,
+		ctx:       ctx,
+		cancel:    cancel,
+		batchSize: params.BeaconNetworkConfig().MaxRequestBlocks,
+	}
+}
+
+// Start backfilling once initial sync has completed. Nothing is done if the database was
+// initialized from genesis.
+func (s *Service) Start() {
+	if _, err := s.cfg.DB.BackfillBlockRoot(s.ctx); err != nil {
+		if !errors.Is(err, db.ErrNotFoundBackfillBlockRoot) {
+			log.WithError(err).Error("Could not retrieve backfill block root")
+		}
+		return
+	}
+	originRoot, err := s.cfg.DB.OriginCheckpointBlockRoot(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve origin checkpoint block root")
+		return
+	}
+	s.originState, err = s.cfg.DB.State(s.ctx, originRoot)
+	if err != nil || s.originState == nil || s.originState.IsNil() {
+		log.WithError(err).Error("Could not retrieve origin checkpoint state")
+		return
+	}
+	if !s.waitForInitialSync() {
+		return
+	}
+	log.Info("Starting to backfill blocks before the origin checkpoint")
+	for {
+		done, err := s.backfillBatch()
+		if err != nil {
+			if errors.Is(s.ctx.Err(), context.Canceled) {
+				return
+			}
+			log.WithError(err).Debug("Could not backfill batch")
+			if !s.sleep(retryInterval) {
+				return
+			}
+			continue
+		}
+		if done {
+			log.Info("Backfilled all blocks down to genesis")
+			return
+		}
+		if !s.sleep(batchInterval) {
+			return
+		}
+	}
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// waitForInitialSync blocks until initial sync has completed, returning false if the service
+// is stopped in the meantime.
+func (s *Service) waitForInitialSync() bool {
+	for s.cfg.InitialSync.Syncing() {
+		if !s.sleep(syncCheckInterval) {
+			return false
+		}
+	}
+	return true
+}
+
+// sleep pauses for the given duration, returning false if the service is stopped in the meantime.
+func (s *Service) sleep(d time.Duration) bool {
+	select {
+	case <-s.ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// backfillBatch requests the batch of blocks preceding the current backfill block from a peer,
+// verifies it and saves it. It returns true once the genesis block has been reached.
+func (s *Service) backfillBatch() (bool, error) {
+	backfillRoot, err := s.cfg.DB.BackfillBlockRoot(s.ctx)
+	if err != nil {
+		return false, err
+	}
+	child, err := s.cfg.DB.Block(s.ctx, backfillRoot)
+	if err != nil {
+		return false, err
+	}
+	if child == nil || child.IsNil() {
+		return false, fmt.Errorf("missing backfill block in database: block root=%#x", backfillRoot)
+	}
+	if child.Block().Slot() == 0 {
+		return true, nil
+	}
+	if s.endSlot == 0 || s.endSlot > child.Block().Slot() {
+		s.endSlot = child.Block().Slot()
+	}
+	start := types.Slot(0)
+	if uint64(s.endSlot) > s.batchSize {
+		start = s.endSlot.SubSlot(types.Slot(s.batchSize))
+	}
+
+	_, pids := s.cfg.P2P.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, s.cfg.Chain.FinalizedCheckpt().Epoch)
+	if len(pids) == 0 {
+		return false, errors.New("no suitable peer to backfill from")
+	}
+	pid := pids[rand.NewGenerator().Intn(len(pids))]
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     uint64(s.endSlot.SubSlot(start)),
+		Step:      1,
+	}
+	blks, err := prysmsync.SendBeaconBlocksByRangeRequest(s.ctx, s.cfg.Chain, s.cfg.P2P, pid, req, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not request blocks from peer %s", pid)
+	}
+	if len(blks) == 0 {
+		// The requested slots are all empty, so the parent of the backfill block is further back.
+		if start == 0 {
+			s.endSlot = 0
+			return false, fmt.Errorf("peer %s returned no block before slot %d", pid, child.Block().Slot())
+		}
+		s.endSlot = start
+		return false, nil
+	}
+	if err := s.verifyBatch(pid, blks, bytesutil.ToBytes32(child.Block().ParentRoot())); err != nil {
+		s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		return false, err
+	}
+	if err := s.cfg.DB.SaveBackfillBlocks(s.ctx, blks); err != nil {
+		return false, errors.Wrap(err, "could not save backfill blocks")
+	}
+	s.endSlot = 0
+	log.WithFields(logrus.Fields{
+		"slot":   blks[0].Block().Slot(),
+		"blocks": len(blks),
+		"peer":   pid,
+	}).Debug("Backfilled blocks")
+	return false, nil
+}
+
+// verifyBatch checks that the blocks form a chain ending at the given parent root, and verifies
+// their proposer signatures against the validator registry of the origin state.
+func (s *Service) verifyBatch(pid peer.ID, blks []interfaces.SignedBeaconBlock, parentRoot [32]byte) error {
+	gvr := s.originState.GenesisValidatorRoot()
+	set := bls.NewSet()
+	for i := len(blks) - 1; i >= 0; i-- {
+		blk := blks[i].Block()
+		root, err := blk.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if root != parentRoot {
+			return errors.Wrapf(errInvalidBatch, "peer %s returned block %#x at slot %d instead of %#x",
+				pid, root, blk.Slot(), parentRoot)
+		}
+		parentRoot = bytesutil.ToBytes32(blk.ParentRoot())
+		// The genesis block is not signed.
+		if blk.Slot() == 0 {
+			continue
+		}
+		if uint64(blk.ProposerIndex()) >= uint64(s.originState.NumValidators()) {
+			return errors.Wrapf(errInvalidBatch, "unknown proposer index %d at slot %d", blk.ProposerIndex(), blk.Slot())
+		}
+		epoch := helpers.SlotToEpoch(blk.Slot())
+		fork, err := p2putils.Fork(epoch)
+		if err != nil {
+			return err
+		}
+		domain, err := helpers.Domain(fork, epoch, params.BeaconConfig().DomainBeaconProposer, gvr)
+		if err != nil {
+			return err
+		}
+		pubkey := s.originState.PubkeyAtIndex(blk.ProposerIndex())
+		blkSet, err := helpers.BlockSignatureSet(pubkey[:], blks[i].Signature(), domain, blk.HashTreeRoot)
+		if err != nil {
+			return errors.Wrapf(errInvalidBatch, "could not build signature set: %v", err)
+		}
+		set.Join(blkSet)
+	}
+	if len(set.Signatures) == 0 {
+		return nil
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		return errors.Wrapf(errInvalidBatch, "could not verify block signatures: %v", err)
+	}
+	if !verified {
+		return errors.Wrapf(errInvalidBatch, "peer %s returned blocks with invalid signatures", pid)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_VerifyBatch(t *testing.T) {
+	st, keys := testutil.DeterministicGenesisState(t, 8)
+	s := &Service{ctx: context.Background(), originState: st}
+
+	parentRoot := make([]byte, 32)
+	blks := make([]interfaces.SignedBeaconBlock, 0, 3)
+	var lastRoot [32]byte
+	for i, slot := range []types.Slot{0, 3, 5} {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ProposerIndex = types.ValidatorIndex(i)
+		blk.Block.ParentRoot = parentRoot
+		if slot > 0 {
+			sig, err := helpers.ComputeDomainAndSign(st, 0, blk.Block, params.BeaconConfig().DomainBeaconProposer, keys[i])
+			require.NoError(t, err)
+			blk.Signature = sig
+		}
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		parentRoot = root[:]
+		lastRoot = root
+		blks = append(blks, wrapper.WrappedPhase0SignedBeaconBlock(blk))
+	}
+	require.NoError(t, s.verifyBatch("peer", blks, lastRoot))
+
+	// The batch must end at the parent of the backfill block.
+	err := s.verifyBatch("peer", blks[:2], lastRoot)
+	assert.ErrorContains(t, "instead of", err)
+
+	// The blocks must link up to each other.
+	err = s.verifyBatch("peer", []interfaces.SignedBeaconBlock{blks[0], blks[2]}, lastRoot)
+	assert.ErrorContains(t, "instead of", err)
+
+	// The blocks must be signed by their proposer.
+	forged := blks[2].Proto().(*ethpb.SignedBeaconBlock)
+	forged.Block.ProposerIndex = 7
+	forgedRoot, err := forged.Block.HashTreeRoot()
+	require.NoError(t, err)
+	err = s.verifyBatch("peer", []interfaces.SignedBeaconBlock{blks[0], blks[1], wrapper.WrappedPhase0SignedBeaconBlock(forged)}, forgedRoot)
+	assert.ErrorContains(t, "invalid signatures", err)
+
+	forged.Block.ProposerIndex = 8
+	forgedRoot, err = forged.Block.HashTreeRoot()
+	require.NoError(t, err)
+	err = s.verifyBatch("peer", []interfaces.SignedBeaconBlock{wrapper.WrappedPhase0SignedBeaconBlock(forged)}, forgedRoot)
+	assert.ErrorContains(t, "unknown proposer index", err)
+}
@@ -0,0 +1,111 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// signatureVerificationInterval is how long signature sets are collected
+// before the pending batch is verified.
+const signatureVerificationInterval = 50 * time.Millisecond
+
+// verifierLimit is the number of signature sets which triggers an early verification of the batch.
+const verifierLimit = 50
+
+type signatureVerifier struct {
+	set     *bls.SignatureSet
+	resChan chan error
+}
+
+// verifierRoutine collects the signature sets submitted by gossip validators and
+// verifies them together, either once the batch is full or on every tick.
+func (s *Service) verifierRoutine() {
+	verificationSet := make([]*signatureVerifier, 0)
+	ticker := time.NewTicker(signatureVerificationInterval)
+	for {
+		select {
+		case <-s.ctx.Done():
+			ticker.Stop()
+			for i := 0; i < len(verificationSet); i++ {
+				verificationSet[i].resChan <- s.ctx.Err()
+			}
+			return
+		case sig := <-s.signatureChan:
+			verificationSet = append(verificationSet, sig)
+			if len(verificationSet) >= verifierLimit {
+				verifyBatch(verificationSet)
+				verificationSet = []*signatureVerifier{}
+			}
+		case <-ticker.C:
+			if len(verificationSet) > 0 {
+				verifyBatch(verificationSet)
+				verificationSet = []*signatureVerifier{}
+			}
+		}
+	}
+}
+
+// validateWithBatchVerifier submits the signature set to the batch verifier and waits for the
+// result. If the batch fails as a whole, the set is verified on its own so that one invalid
+// message does not cause valid messages in the same batch to be rejected.
+func (s *Service) validateWithBatchVerifier(ctx context.Context, message string, set *bls.SignatureSet) pubsub.ValidationResult {
+	ctx, span := trace.StartSpan(ctx, "sync.validateWithBatchVerifier")
+	defer span.End()
+
+	resChan := make(chan error, 1)
+	select {
+	case s.signatureChan <- &signatureVerifier{set: set, resChan: resChan}:
+	case <-ctx.Done():
+		return pubsub.ValidationIgnore
+	}
+
+	var resErr error
+	select {
+	case resErr = <-resChan:
+	case <-ctx.Done():
+		return pubsub.ValidationIgnore
+	}
+	if resErr == nil {
+		return pubsub.ValidationAccept
+	}
+	log.WithError(resErr).Tracef("Could not perform batch verification of %s", message)
+	verified, err := set.Verify()
+	if err != nil {
+		traceutil.AnnotateError(span, errors.Wrapf(err, "could not verify %s", message))
+		return pubsub.ValidationReject
+	}
+	if !verified {
+		traceutil.AnnotateError(span, errors.Errorf("verification of %s failed", message))
+		return pubsub.ValidationReject
+	}
+	return pubsub.ValidationAccept
+}
+
+func verifyBatch(verifierBatch []*signatureVerifier) {
+	if len(verifierBatch) == 0 {
+		return
+	}
+	// Join into a fresh set, joining into the first submitted set
+	// would mutate the set its validator falls back to.
+	aggSet := bls.NewSet()
+	for _, v := range verifierBatch {
+		aggSet.Join(v.set)
+	}
+	verificationErr := errors.New("batch signature verification failed")
+	verified, err := aggSet.Verify()
+	switch {
+	case err != nil:
+		verificationErr = err
+	case verified:
+		verificationErr = nil
+	}
+	for i := 0; i < len(verifierBatch); i++ {
+		verifierBatch[i].resChan <- verificationErr
+	}
+}
@@ -0,0 +1,73 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func signatureSet(t *testing.T, msg [32]byte, valid bool) *bls.SignatureSet {
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign(msg[:])
+	if !valid {
+		other, err := bls.RandKey()
+		require.NoError(t, err)
+		sig = other.Sign(msg[:])
+	}
+	return &bls.SignatureSet{
+		Signatures: [][]byte{sig.Marshal()},
+		PublicKeys: []bls.PublicKey{priv.PublicKey()},
+		Messages:   [][32]byte{msg},
+	}
+}
+
+func TestValidateWithBatchVerifier(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+		want  pubsub.ValidationResult
+	}{
+		{name: "valid set", valid: true, want: pubsub.ValidationAccept},
+		{name: "invalid set", valid: false, want: pubsub.ValidationReject},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			s := &Service{ctx: ctx, signatureChan: make(chan *signatureVerifier, verifierLimit)}
+			go s.verifierRoutine()
+			assert.Equal(t, tt.want, s.validateWithBatchVerifier(ctx, "test", signatureSet(t, [32]byte{'a'}, tt.valid)))
+		})
+	}
+}
+
+func TestVerifyBatch_FailedBatchLeavesSetsIntact(t *testing.T) {
+	good := signatureSet(t, [32]byte{'a'}, true)
+	bad := signatureSet(t, [32]byte{'b'}, false)
+	batch := []*signatureVerifier{
+		{set: good, resChan: make(chan error, 1)},
+		{set: bad, resChan: make(chan error, 1)},
+	}
+	verifyBatch(batch)
+	for _, v := range batch {
+		assert.NotNil(t, <-v.resChan)
+	}
+	// The individual fallback verification must still see only its own signature.
+	require.Equal(t, 1, len(good.Signatures))
+	verified, err := good.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, true, verified)
+}
+
+func TestValidateWithBatchVerifier_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// No verifier routine and no buffer, so the submission can only end through the context.
+	s := &Service{ctx: ctx, signatureChan: make(chan *signatureVerifier)}
+	assert.Equal(t, pubsub.ValidationIgnore, s.validateWithBatchVerifier(ctx, "test", signatureSet(t, [32]byte{'a'}, true)))
+}
@@ -0,0 +1,75 @@
+// Package checkpoint downloads the finalized state and block of a trusted beacon node through
+// its standard API, so that a new beacon node can start syncing from them instead of from genesis.
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultTimeout is the timeout applied to each request, generous enough to download a mainnet state.
+	DefaultTimeout     = 5 * time.Minute
+	finalizedBlockPath = "/eth/v1/beacon/blocks/finalized"
+	statePathFormat    = "/eth/v1/debug/beacon/states/%#x"
+	sszContentType     = "application/octet-stream"
+)
+
+// DownloadFinalized downloads the SSZ encoded finalized block of the beacon node at the given url,
+// along with the state the block was applied to.
+func DownloadFinalized(ctx context.Context, client *http.Client, url string) (stateBytes, blockBytes []byte, err error) {
+	url = strings.TrimSuffix(url, "/")
+	blockBytes, err = getSSZ(ctx, client, url+finalizedBlockPath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not download finalized block")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(blockBytes); err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal finalized block")
+	}
+	if blk.Block == nil {
+		return nil, nil, errors.New("nil finalized block")
+	}
+	log.WithFields(logrus.Fields{
+		"slot":      blk.Block.Slot,
+		"stateRoot": fmt.Sprintf("%#x", blk.Block.StateRoot),
+	}).Info("Downloaded finalized block, downloading its state")
+	stateBytes, err = getSSZ(ctx, client, url+fmt.Sprintf(statePathFormat, blk.Block.StateRoot))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not download finalized state")
+	}
+	return stateBytes, blockBytes, nil
+}
+
+func getSSZ(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", sszContentType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read response body")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d: %s", url, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
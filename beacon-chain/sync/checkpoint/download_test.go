@@ -0,0 +1,75 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDownloadFinalized(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(64))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	wantState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 64
+	blk.Block.StateRoot = stateRoot[:]
+	wantBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(finalizedBlockPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, sszContentType, r.Header.Get("Accept"))
+		_, err := w.Write(wantBlock)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc(fmt.Sprintf(statePathFormat, stateRoot), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, sszContentType, r.Header.Get("Accept"))
+		_, err := w.Write(wantState)
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	stateBytes, blockBytes, err := DownloadFinalized(context.Background(), srv.Client(), srv.URL+"/")
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantState, stateBytes)
+	assert.DeepEqual(t, wantBlock, blockBytes)
+}
+
+func TestDownloadFinalized_Errors(t *testing.T) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.StateRoot = bytesutil.PadTo([]byte("unknown"), 32)
+	blockBytes, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(finalizedBlockPath, func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write(blockBytes)
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	_, _, err = DownloadFinalized(context.Background(), srv.Client(), srv.URL)
+	assert.ErrorContains(t, "could not download finalized state", err)
+
+	mux = http.NewServeMux()
+	mux.HandleFunc(finalizedBlockPath, func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte("foo"))
+		require.NoError(t, err)
+	})
+	badSrv := httptest.NewServer(mux)
+	defer badSrv.Close()
+	_, _, err = DownloadFinalized(context.Background(), badSrv.Client(), badSrv.URL)
+	assert.ErrorContains(t, "could not unmarshal finalized block", err)
+}
@@ -1,15 +1,22 @@
 package sync
 
 import (
-	"errors"
+	"fmt"
 
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 )
 
+// Specifies the fixed size context length.
+const forkDigestLength = 4
+
 // writes peer's current context for the expected payload to the stream.
-func writeContextToStream(stream network.Stream, chain blockchain.ChainInfoFetcher) error {
+func writeContextToStream(objCtx []byte, stream network.Stream, chain blockchain.ChainInfoFetcher) error {
+	// The rpc context for our v2 methods is the fork-digest of
+	// the relevant payload. We write the associated fork-digest(context)
+	// into the stream for the payload.
 	rpcCtx, err := rpcContext(stream, chain)
 	if err != nil {
 		return err
@@ -18,6 +25,10 @@ func writeContextToStream(stream network.Stream, chain blockchain.ChainInfoFetch
 	if len(rpcCtx) == 0 {
 		return nil
 	}
+	// Always choose the object's context when writing to the stream.
+	if objCtx != nil {
+		rpcCtx = objCtx
+	}
 	_, err = stream.Write(rpcCtx)
 	return err
 }
@@ -32,7 +43,7 @@ func readContextFromStream(stream network.Stream, chain blockchain.ChainInfoFetc
 		return []byte{}, nil
 	}
 	// Read context (fork-digest) from stream
-	b := make([]byte, 4)
+	b := make([]byte, forkDigestLength)
 	if _, err := stream.Read(b); err != nil {
 		return nil, err
 	}
@@ -41,7 +52,7 @@ func readContextFromStream(stream network.Stream, chain blockchain.ChainInfoFetc
 
 // retrieve expected context depending on rpc topic schema version.
 func rpcContext(stream network.Stream, chain blockchain.ChainInfoFetcher) ([]byte, error) {
-	_, _, version, err := p2p.TopicDeconstructor(string(stream.Protocol()))
+	_, message, version, err := p2p.TopicDeconstructor(string(stream.Protocol()))
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +60,19 @@ func rpcContext(stream network.Stream, chain blockchain.ChainInfoFetcher) ([]byt
 	case p2p.SchemaVersionV1:
 		// Return empty context for a v1 method.
 		return []byte{}, nil
+	case p2p.SchemaVersionV2:
+		// Metadata responses are not prefixed with any context.
+		if message == p2p.MetadataMessageName {
+			return []byte{}, nil
+		}
+		currFork := chain.CurrentFork()
+		genRoot := chain.GenesisValidatorRoot()
+		digest, err := helpers.ComputeForkDigest(currFork.CurrentVersion, genRoot[:])
+		if err != nil {
+			return nil, err
+		}
+		return digest[:], nil
 	default:
-		return nil, errors.New("invalid version of %s registered for topic: %s")
+		return nil, fmt.Errorf("invalid version of %s registered for topic: %s", version, message)
 	}
 }
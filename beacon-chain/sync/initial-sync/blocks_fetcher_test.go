@@ -12,6 +12,7 @@ import (
 	core "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/network"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -23,11 +24,13 @@ import (
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	p2ppb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	wrapperv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -520,6 +523,74 @@ func TestBlocksFetcher_requestBeaconBlocksByRange(t *testing.T) {
 	assert.ErrorContains(t, "context canceled", err)
 }
 
+func TestBlocksFetcher_requestBeaconBlocksByRange_V2ForkDigestChunks(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	bCfg := params.BeaconConfig()
+	bCfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(bCfg)
+
+	p1 := p2pt.NewTestP2P(t)
+	p2 := p2pt.NewTestP2P(t)
+	p1.Connect(p2)
+	chain := &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{'A'}}
+
+	req := &p2ppb.BeaconBlocksByRangeRequest{
+		StartSlot: 1,
+		Step:      1,
+		Count:     4,
+	}
+	pcl := core.ProtocolID(p2pm.RPCBlocksByRangeTopicV2 + p1.Encoding().ProtocolSuffix())
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		r := &p2ppb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, r))
+		for i := r.StartSlot; i < r.StartSlot.Add(r.Count*r.Step); i += types.Slot(r.Step) {
+			blk, err := wrapperv2.WrappedAltairSignedBeaconBlock(newAltairSignedBlock(i))
+			require.NoError(t, err)
+			assert.NoError(t, beaconsync.WriteBlockChunk(stream, chain, p2.Encoding(), blk))
+		}
+	})
+	// Identify pushes the new protocol asynchronously, record it right away.
+	require.NoError(t, p1.BHost.Peerstore().AddProtocols(p2.PeerID(), string(pcl)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{chain: chain, p2p: p1})
+	blocks, err := fetcher.requestBlocks(ctx, req, p2.PeerID())
+	require.NoError(t, err)
+	require.Equal(t, int(req.Count), len(blocks))
+	for i, blk := range blocks {
+		assert.Equal(t, version.Altair, blk.Version())
+		assert.Equal(t, req.StartSlot+types.Slot(i), blk.Block().Slot())
+	}
+}
+
+// newAltairSignedBlock creates an Altair block with minimum marshalable fields.
+func newAltairSignedBlock(slot types.Slot) *p2ppb.SignedBeaconBlock {
+	return &p2ppb.SignedBeaconBlock{
+		Block: &p2ppb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			Body: &p2ppb.BeaconBlockBody{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data: &eth.Eth1Data{
+					DepositRoot: make([]byte, 32),
+					BlockHash:   make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+				SyncAggregate: &p2ppb.SyncAggregate{
+					SyncCommitteeBits:      bitfield.NewBitvector512(),
+					SyncCommitteeSignature: make([]byte, 96),
+				},
+			},
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
 func TestBlocksFetcher_RequestBlocksRateLimitingLocks(t *testing.T) {
 	p1 := p2pt.NewTestP2P(t)
 	p2 := p2pt.NewTestP2P(t)
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -83,10 +84,17 @@ func (s *Service) updateMetrics() {
 		}
 	}
 
+	syncTopic := p2p.GossipTypeMapping[reflect.TypeOf(&prysmv2.SyncCommitteeMessage{})]
+	syncTopic += s.cfg.P2P.Encoding().ProtocolSuffix()
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+		formattedTopic := fmt.Sprintf(syncTopic, digest, i)
+		topicPeerCount.WithLabelValues(formattedTopic).Set(float64(len(s.cfg.P2P.PubSub().ListPeers(formattedTopic))))
+	}
+
 	// We update all other gossip topics.
 	for topic := range p2p.GossipTopicMappings {
-		// We already updated attestation subnet topics.
-		if strings.Contains(topic, "beacon_attestation") {
+		// We already updated attestation and sync committee subnet topics.
+		if strings.Contains(topic, "beacon_attestation") || topic == p2p.SyncCommitteeSubnetTopicFormat {
 			continue
 		}
 		topic += s.cfg.P2P.Encoding().ProtocolSuffix()
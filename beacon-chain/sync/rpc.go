@@ -54,6 +54,18 @@ func (s *Service) registerRPCHandlers() {
 		p2p.RPCMetaDataTopicV1,
 		s.metaDataHandler,
 	)
+	s.registerRPC(
+		p2p.RPCBlocksByRangeTopicV2,
+		s.beaconBlocksByRangeRPCHandler,
+	)
+	s.registerRPC(
+		p2p.RPCBlocksByRootTopicV2,
+		s.beaconBlocksRootRPCHandler,
+	)
+	s.registerRPC(
+		p2p.RPCMetaDataTopicV2,
+		s.metaDataHandler,
+	)
 }
 
 // registerRPC for a given topic with an expected protobuf message type.
@@ -113,7 +125,7 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 
 		// since metadata requests do not have any data in the payload, we
 		// do not decode anything.
-		if baseTopic == p2p.RPCMetaDataTopicV1 {
+		if baseTopic == p2p.RPCMetaDataTopicV1 || baseTopic == p2p.RPCMetaDataTopicV2 {
 			if err := handle(ctx, base, stream); err != nil {
 				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
 				if err != p2ptypes.ErrWrongForkDigestVersion {
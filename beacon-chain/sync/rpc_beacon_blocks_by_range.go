@@ -162,7 +162,13 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 		if b == nil || b.IsNil() || b.Block().IsNil() {
 			continue
 		}
-		if chunkErr := s.chunkWriter(stream, b.Proto()); chunkErr != nil {
+		chunkErr := s.chunkBlockWriter(stream, b)
+		// Blocks from later forks cannot be served over a v1 topic, so the
+		// response ends at the fork boundary.
+		if errors.Is(chunkErr, errBlockVersionUnsupported) {
+			break
+		}
+		if chunkErr != nil {
 			log.WithError(chunkErr).Debug("Could not send a chunked response")
 			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
 			traceutil.AnnotateError(span, chunkErr)
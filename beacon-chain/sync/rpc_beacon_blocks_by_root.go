@@ -71,7 +71,12 @@ func (s *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 		if blk == nil || blk.IsNil() {
 			continue
 		}
-		if err := s.chunkWriter(stream, blk.Proto()); err != nil {
+		err = s.chunkBlockWriter(stream, blk)
+		// Skip blocks which cannot be served over the requested topic version.
+		if errors.Is(err, errBlockVersionUnsupported) {
+			continue
+		}
+		if err != nil {
 			return err
 		}
 	}
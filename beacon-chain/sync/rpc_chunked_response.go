@@ -2,16 +2,27 @@ package sync
 
 import (
 	"errors"
+	"fmt"
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	wrapperv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
 )
 
+// errBlockVersionUnsupported is returned when a block cannot be sent over the
+// negotiated rpc topic, such as an Altair block over a v1 topic.
+var errBlockVersionUnsupported = errors.New("block version is not supported by the rpc topic")
+
 // chunkWriter writes the given message as a chunked response to the given network
 // stream.
 // response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
@@ -20,19 +31,67 @@ func (s *Service) chunkWriter(stream libp2pcore.Stream, msg interface{}) error {
 	return WriteChunk(stream, s.cfg.Chain, s.cfg.P2P.Encoding(), msg)
 }
 
+// chunkBlockWriter writes the given block as a chunked response to the given network
+// stream, prefixed with the fork digest of the block for v2 topics.
+func (s *Service) chunkBlockWriter(stream libp2pcore.Stream, blk interfaces.SignedBeaconBlock) error {
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	return WriteBlockChunk(stream, s.cfg.Chain, s.cfg.P2P.Encoding(), blk)
+}
+
 // WriteChunk object to stream.
 // response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
 func WriteChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, encoding encoder.NetworkEncoding, msg interface{}) error {
 	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
 		return err
 	}
-	if err := writeContextToStream(stream, chain); err != nil {
+	if err := writeContextToStream(nil, stream, chain); err != nil {
 		return err
 	}
 	_, err := encoding.EncodeWithMaxLength(stream, msg)
 	return err
 }
 
+// WriteBlockChunk writes block chunk object to stream. The context of the chunk is the
+// fork digest of the fork the block belongs to.
+// response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
+func WriteBlockChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, encoding encoder.NetworkEncoding, blk interfaces.SignedBeaconBlock) error {
+	_, _, topicVersion, err := p2p.TopicDeconstructor(string(stream.Protocol()))
+	if err != nil {
+		return err
+	}
+	// Only phase 0 blocks can be sent over a v1 topic.
+	if topicVersion == p2p.SchemaVersionV1 && blk.Version() != version.Phase0 {
+		return errBlockVersionUnsupported
+	}
+	var obtainedCtx []byte
+	if topicVersion != p2p.SchemaVersionV1 {
+		var forkVersion []byte
+		switch blk.Version() {
+		case version.Phase0:
+			forkVersion = params.BeaconConfig().GenesisForkVersion
+		case version.Altair:
+			forkVersion = params.BeaconConfig().AltairForkVersion
+		default:
+			return errBlockVersionUnsupported
+		}
+		valRoot := chain.GenesisValidatorRoot()
+		digest, err := helpers.ComputeForkDigest(forkVersion, valRoot[:])
+		if err != nil {
+			return err
+		}
+		obtainedCtx = digest[:]
+	}
+
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	if err := writeContextToStream(obtainedCtx, stream, chain); err != nil {
+		return err
+	}
+	_, err = encoding.EncodeWithMaxLength(stream, blk)
+	return err
+}
+
 // ReadChunkedBlock handles each response chunk that is sent by the
 // peer and converts it into a beacon block.
 func ReadChunkedBlock(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, p2p p2p.P2P, isFirstChunk bool) (interfaces.SignedBeaconBlock, error) {
@@ -40,17 +99,12 @@ func ReadChunkedBlock(stream libp2pcore.Stream, chain blockchain.ChainInfoFetche
 	if isFirstChunk {
 		return readFirstChunkedBlock(stream, chain, p2p)
 	}
-	blk := &eth.SignedBeaconBlock{}
-	if err := readResponseChunk(stream, chain, p2p, blk); err != nil {
-		return nil, err
-	}
-	return wrapper.WrappedPhase0SignedBeaconBlock(blk), nil
+	return readResponseChunk(stream, chain, p2p)
 }
 
 // readFirstChunkedBlock reads the first chunked block and applies the appropriate deadlines to
 // it.
 func readFirstChunkedBlock(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, p2p p2p.P2P) (interfaces.SignedBeaconBlock, error) {
-	blk := &eth.SignedBeaconBlock{}
 	code, errMsg, err := ReadStatusCode(stream, p2p.Encoding())
 	if err != nil {
 		return nil, err
@@ -58,30 +112,65 @@ func readFirstChunkedBlock(stream libp2pcore.Stream, chain blockchain.ChainInfoF
 	if code != 0 {
 		return nil, errors.New(errMsg)
 	}
-	// No-op for now with the rpc context.
-	_, err = readContextFromStream(stream, chain)
+	rpcCtx, err := readContextFromStream(stream, chain)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := extractBlockDataType(rpcCtx, chain)
 	if err != nil {
 		return nil, err
 	}
 	err = p2p.Encoding().DecodeWithMaxLength(stream, blk)
-	return wrapper.WrappedPhase0SignedBeaconBlock(blk), err
+	return blk, err
 }
 
 // readResponseChunk reads the response from the stream and decodes it into the
-// provided message type.
-func readResponseChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, p2p p2p.P2P, to interface{}) error {
+// appropriate block type.
+func readResponseChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, p2p p2p.P2P) (interfaces.SignedBeaconBlock, error) {
 	SetStreamReadDeadline(stream, respTimeout)
 	code, errMsg, err := readStatusCodeNoDeadline(stream, p2p.Encoding())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if code != 0 {
-		return errors.New(errMsg)
+		return nil, errors.New(errMsg)
 	}
-	// No-op for now with the rpc context.
-	_, err = readContextFromStream(stream, chain)
+	rpcCtx, err := readContextFromStream(stream, chain)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	blk, err := extractBlockDataType(rpcCtx, chain)
+	if err != nil {
+		return nil, err
+	}
+	err = p2p.Encoding().DecodeWithMaxLength(stream, blk)
+	return blk, err
+}
+
+// extractBlockDataType returns an empty block of the type matching the provided
+// context. An empty context denotes a v1 topic, which only carries phase 0 blocks.
+func extractBlockDataType(digest []byte, chain blockchain.ChainInfoFetcher) (interfaces.SignedBeaconBlock, error) {
+	if len(digest) == 0 {
+		return wrapper.WrappedPhase0SignedBeaconBlock(&eth.SignedBeaconBlock{}), nil
+	}
+	if len(digest) != forkDigestLength {
+		return nil, fmt.Errorf("invalid digest returned, wanted a length of %d but received %d", forkDigestLength, len(digest))
+	}
+	vRoot := chain.GenesisValidatorRoot()
+	phase0Digest, err := helpers.ComputeForkDigest(params.BeaconConfig().GenesisForkVersion, vRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	altairDigest, err := helpers.ComputeForkDigest(params.BeaconConfig().AltairForkVersion, vRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	switch bytesutil.ToBytes4(digest) {
+	case phase0Digest:
+		return wrapper.WrappedPhase0SignedBeaconBlock(&eth.SignedBeaconBlock{}), nil
+	case altairDigest:
+		return wrapperv2.WrappedAltairSignedBeaconBlock(&prysmv2.SignedBeaconBlock{Block: &prysmv2.BeaconBlock{}})
+	default:
+		return nil, errors.New("no valid digest matched")
 	}
-	return p2p.Encoding().DecodeWithMaxLength(stream, to)
 }
@@ -0,0 +1,112 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	wrapperv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestWriteBlockChunk_V2Topic(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	chain := &mock.ChainService{
+		Genesis:        time.Now(),
+		ValidatorsRoot: [32]byte{'A'},
+		Fork: &statepb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().AltairForkVersion,
+		},
+	}
+
+	phase0Blk := testutil.NewBeaconBlock()
+	phase0Blk.Block.Slot = 1
+	altairBlk, err := wrapperv2.WrappedAltairSignedBeaconBlock(newAltairSignedBlock(2))
+	require.NoError(t, err)
+	blks := []interfaces.SignedBeaconBlock{wrapper.WrappedPhase0SignedBeaconBlock(phase0Blk), altairBlk}
+
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV2 + p1.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for i, want := range blks {
+			blk, err := ReadChunkedBlock(stream, chain, p2, i == 0)
+			require.NoError(t, err)
+			assert.Equal(t, want.Version(), blk.Version())
+			assert.Equal(t, want.Block().Slot(), blk.Block().Slot())
+		}
+	})
+
+	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), pcl)
+	require.NoError(t, err)
+	for _, blk := range blks {
+		require.NoError(t, WriteBlockChunk(stream, chain, p1.Encoding(), blk))
+	}
+	require.NoError(t, stream.Close())
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestWriteBlockChunk_V1TopicRejectsAltairBlock(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV1 + p1.Encoding().ProtocolSuffix())
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		// no-op
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), pcl)
+	require.NoError(t, err)
+
+	altairBlk, err := wrapperv2.WrappedAltairSignedBeaconBlock(newAltairSignedBlock(1))
+	require.NoError(t, err)
+	err = WriteBlockChunk(stream, &mock.ChainService{}, p1.Encoding(), altairBlk)
+	assert.ErrorContains(t, errBlockVersionUnsupported.Error(), err)
+}
+
+// newAltairSignedBlock creates an Altair block with minimum marshalable fields.
+func newAltairSignedBlock(slot types.Slot) *prysmv2.SignedBeaconBlock {
+	return &prysmv2.SignedBeaconBlock{
+		Block: &prysmv2.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			Body: &prysmv2.BeaconBlockBody{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data: &ethpb.Eth1Data{
+					DepositRoot: make([]byte, 32),
+					BlockHash:   make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+				SyncAggregate: &prysmv2.SyncAggregate{
+					SyncCommitteeBits:      bitfield.NewBitvector512(),
+					SyncCommitteeSignature: make([]byte, 96),
+				},
+			},
+		},
+		Signature: make([]byte, 96),
+	}
+}
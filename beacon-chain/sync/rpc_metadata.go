@@ -6,6 +6,7 @@ import (
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
@@ -27,10 +28,37 @@ func (s *Service) metaDataHandler(_ context.Context, _ interface{}, stream libp2
 	if s.cfg.P2P.Metadata() == nil || s.cfg.P2P.Metadata().IsNil() {
 		return errors.New("nil metadata stored for host")
 	}
-	_, err := s.cfg.P2P.Encoding().EncodeWithMaxLength(stream, s.cfg.P2P.Metadata().InnerObject())
+	_, _, streamVersion, err := p2p.TopicDeconstructor(string(stream.Protocol()))
 	if err != nil {
 		return err
 	}
+	currMd := s.cfg.P2P.Metadata()
+	switch streamVersion {
+	case p2p.SchemaVersionV1:
+		// We have a v1 metadata object saved locally, so we
+		// convert it back to a v0 metadata object.
+		if currMd.MetadataObjV0() == nil {
+			md := currMd.MetadataObjV1()
+			currMd = wrapper.WrappedMetadataV0(&pb.MetaDataV0{
+				SeqNumber: md.SeqNumber,
+				Attnets:   md.Attnets,
+			})
+		}
+	case p2p.SchemaVersionV2:
+		// We have a v0 metadata object saved locally, so we
+		// convert it to a v1 metadata object.
+		if currMd.MetadataObjV1() == nil {
+			md := currMd.MetadataObjV0()
+			currMd = wrapper.WrappedMetadataV1(&pb.MetaDataV1{
+				SeqNumber: md.SeqNumber,
+				Attnets:   md.Attnets,
+				Syncnets:  bitfield.NewBitvector512(),
+			})
+		}
+	}
+	if _, err := s.cfg.P2P.Encoding().EncodeWithMaxLength(stream, currMd.InnerObject()); err != nil {
+		return err
+	}
 	closeStream(stream, log)
 	return nil
 }
@@ -39,7 +67,11 @@ func (s *Service) sendMetaDataRequest(ctx context.Context, id peer.ID) (interfac
 	ctx, cancel := context.WithTimeout(ctx, respTimeout)
 	defer cancel()
 
-	stream, err := s.cfg.P2P.Send(ctx, new(interface{}), p2p.RPCMetaDataTopicV1, id)
+	topic, err := rpcTopicForPeer(s.cfg.Chain, s.cfg.P2P, p2p.MetadataMessageName, id)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := s.cfg.P2P.Send(ctx, new(interface{}), topic, id)
 	if err != nil {
 		return nil, err
 	}
@@ -57,9 +89,24 @@ func (s *Service) sendMetaDataRequest(ctx context.Context, id peer.ID) (interfac
 	if err != nil {
 		return nil, err
 	}
-	msg := new(pb.MetaDataV0)
-	if err := s.cfg.P2P.Encoding().DecodeWithMaxLength(stream, msg); err != nil {
+	_, _, topicVersion, err := p2p.TopicDeconstructor(topic)
+	if err != nil {
 		return nil, err
 	}
-	return wrapper.WrappedMetadataV0(msg), nil
+	switch topicVersion {
+	case p2p.SchemaVersionV1:
+		msg := new(pb.MetaDataV0)
+		if err := s.cfg.P2P.Encoding().DecodeWithMaxLength(stream, msg); err != nil {
+			return nil, err
+		}
+		return wrapper.WrappedMetadataV0(msg), nil
+	case p2p.SchemaVersionV2:
+		msg := new(pb.MetaDataV1)
+		if err := s.cfg.P2P.Encoding().DecodeWithMaxLength(stream, msg); err != nil {
+			return nil, err
+		}
+		return wrapper.WrappedMetadataV1(msg), nil
+	default:
+		return nil, errors.Errorf("unsupported metadata topic version %s", topicVersion)
+	}
 }
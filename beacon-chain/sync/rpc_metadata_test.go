@@ -9,11 +9,15 @@ import (
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/protocol"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sszutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -42,7 +46,7 @@ func TestMetaDataRPCHandler_ReceivesMetadata(t *testing.T) {
 	}
 
 	// Setup streams
-	pcl := protocol.ID("/testing")
+	pcl := protocol.ID(p2p.RPCMetaDataTopicV1 + r.cfg.P2P.Encoding().ProtocolSuffix())
 	topic := string(pcl)
 	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(1, 1, false)
 	var wg sync.WaitGroup
@@ -84,16 +88,18 @@ func TestMetadataRPCHandler_SendsMetadata(t *testing.T) {
 	d := db.SetupDB(t)
 	r := &Service{
 		cfg: &Config{
-			DB:  d,
-			P2P: p1,
+			DB:    d,
+			P2P:   p1,
+			Chain: &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{}},
 		},
 		rateLimiter: newRateLimiter(p1),
 	}
 
 	r2 := &Service{
 		cfg: &Config{
-			DB:  d,
-			P2P: p2,
+			DB:    d,
+			P2P:   p2,
+			Chain: &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{}},
 		},
 		rateLimiter: newRateLimiter(p2),
 	}
@@ -127,3 +133,74 @@ func TestMetadataRPCHandler_SendsMetadata(t *testing.T) {
 		t.Error("Peer is disconnected despite receiving a valid ping")
 	}
 }
+
+func TestMetadataRPCHandler_SendsMetadataAltair(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	bCfg := params.BeaconConfig()
+	bCfg.AltairForkEpoch = 5
+	params.OverrideBeaconConfig(bCfg)
+
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	attnets := [8]byte{'A', 'B'}
+	p2.LocalMetadata = wrapper.WrappedMetadataV0(&pb.MetaDataV0{
+		SeqNumber: 2,
+		Attnets:   attnets[:],
+	})
+
+	// Set up a head state in the database with data we expect.
+	d := db.SetupDB(t)
+	currSlot := types.Slot(5) * params.BeaconConfig().SlotsPerEpoch
+	r := &Service{
+		cfg: &Config{
+			DB:    d,
+			P2P:   p1,
+			Chain: &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{}, Slot: &currSlot},
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+
+	r2 := &Service{
+		cfg: &Config{
+			DB:    d,
+			P2P:   p2,
+			Chain: &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{}, Slot: &currSlot},
+		},
+		rateLimiter: newRateLimiter(p2),
+	}
+
+	// Setup streams
+	pcl := protocol.ID(p2p.RPCMetaDataTopicV2 + r.cfg.P2P.Encoding().ProtocolSuffix())
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(1, 1, false)
+	r2.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(1, 1, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		assert.NoError(t, r2.metaDataHandler(context.Background(), new(interface{}), stream))
+	})
+
+	// Identify pushes the new protocol asynchronously, record it right away.
+	require.NoError(t, p1.BHost.Peerstore().AddProtocols(p2.BHost.ID(), topic))
+
+	metadata, err := r.sendMetaDataRequest(context.Background(), p2.BHost.ID())
+	assert.NoError(t, err)
+
+	// The locally stored v0 metadata is served as a v1 object with empty sync subnets.
+	wanted := &pb.MetaDataV1{
+		SeqNumber: 2,
+		Attnets:   attnets[:],
+		Syncnets:  bitfield.NewBitvector512(),
+	}
+	if !sszutil.DeepEqual(metadata.InnerObject(), wanted) {
+		t.Fatalf("MetadataV1 unequal, received %v but wanted %v", metadata, wanted)
+	}
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
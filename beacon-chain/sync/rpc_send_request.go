@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
@@ -23,11 +24,16 @@ var ErrInvalidFetchedData = errors.New("invalid data returned from peer")
 type BeaconBlockProcessor func(block interfaces.SignedBeaconBlock) error
 
 // SendBeaconBlocksByRangeRequest sends BeaconBlocksByRange and returns fetched blocks, if any.
+// The request is limited to the v1 protocol when no chain is provided.
 func SendBeaconBlocksByRangeRequest(
 	ctx context.Context, chain blockchain.ChainInfoFetcher, p2pProvider p2p.P2P, pid peer.ID,
 	req *pb.BeaconBlocksByRangeRequest, blockProcessor BeaconBlockProcessor,
 ) ([]interfaces.SignedBeaconBlock, error) {
-	stream, err := p2pProvider.Send(ctx, req, p2p.RPCBlocksByRangeTopicV1, pid)
+	topic, err := rpcTopicForPeer(chain, p2pProvider, p2p.BeaconBlocksByRangeMessageName, pid)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := p2pProvider.Send(ctx, req, topic, pid)
 	if err != nil {
 		return nil, err
 	}
@@ -81,11 +87,16 @@ func SendBeaconBlocksByRangeRequest(
 }
 
 // SendBeaconBlocksByRootRequest sends BeaconBlocksByRoot and returns fetched blocks, if any.
+// The request is limited to the v1 protocol when no chain is provided.
 func SendBeaconBlocksByRootRequest(
 	ctx context.Context, chain blockchain.ChainInfoFetcher, p2pProvider p2p.P2P, pid peer.ID,
 	req *p2ptypes.BeaconBlockByRootsReq, blockProcessor BeaconBlockProcessor,
 ) ([]interfaces.SignedBeaconBlock, error) {
-	stream, err := p2pProvider.Send(ctx, req, p2p.RPCBlocksByRootTopicV1, pid)
+	topic, err := rpcTopicForPeer(chain, p2pProvider, p2p.BeaconBlocksByRootsMessageName, pid)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := p2pProvider.Send(ctx, req, topic, pid)
 	if err != nil {
 		return nil, err
 	}
@@ -120,3 +131,33 @@ func SendBeaconBlocksByRootRequest(
 	}
 	return blocks, nil
 }
+
+// rpcTopicForPeer resolves the rpc topic used to request the given message from a peer.
+// The newest schema version allowed at the current epoch is only used once the peer
+// has advertised support for it, otherwise the request falls back to v1. Without a
+// chain to derive the current epoch from, v1 is always used.
+func rpcTopicForPeer(chain blockchain.ChainInfoFetcher, p2pProvider p2p.P2P, msg string, pid peer.ID) (string, error) {
+	baseTopic, err := p2p.BaseTopicFromMessage(msg)
+	if err != nil {
+		return "", err
+	}
+	if chain == nil {
+		return baseTopic, nil
+	}
+	topic, err := p2p.TopicFromMessage(msg, helpers.SlotToEpoch(chain.CurrentSlot()))
+	if err != nil {
+		return "", err
+	}
+	if topic == baseTopic || p2pProvider.Host() == nil {
+		return topic, nil
+	}
+	supported, err := p2pProvider.Host().Peerstore().SupportsProtocols(pid, topic+p2pProvider.Encoding().ProtocolSuffix())
+	if err != nil {
+		log.WithError(err).WithField("peer", pid).Debug("Could not check supported protocols of peer")
+		return baseTopic, nil
+	}
+	if len(supported) == 0 {
+		return baseTopic, nil
+	}
+	return topic, nil
+}
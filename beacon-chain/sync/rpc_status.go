@@ -309,6 +309,10 @@ func (s *Service) validateStatusMessage(ctx context.Context, msg *pb.Status) err
 		return nil
 	}
 	if !s.cfg.DB.IsFinalizedBlock(ctx, bytesutil.ToBytes32(msg.FinalizedRoot)) {
+		// A node synced from a checkpoint has no blocks to check finalized roots before it against.
+		if s.finalizedBeforeOrigin(ctx, msg.FinalizedEpoch) {
+			return nil
+		}
 		return p2ptypes.ErrInvalidFinalizedRoot
 	}
 	blk, err := s.cfg.DB.Block(ctx, bytesutil.ToBytes32(msg.FinalizedRoot))
@@ -345,3 +349,17 @@ func (s *Service) validateStatusMessage(ctx context.Context, msg *pb.Status) err
 	}
 	return p2ptypes.ErrInvalidEpoch
 }
+
+// finalizedBeforeOrigin returns true if the given finalized epoch precedes the epoch of the
+// checkpoint block the node synced from.
+func (s *Service) finalizedBeforeOrigin(ctx context.Context, epoch types.Epoch) bool {
+	originRoot, err := s.cfg.DB.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return false
+	}
+	originBlock, err := s.cfg.DB.Block(ctx, originRoot)
+	if err != nil || originBlock == nil || originBlock.IsNil() {
+		return false
+	}
+	return epoch < helpers.SlotToEpoch(originBlock.Block().Slot())
+}
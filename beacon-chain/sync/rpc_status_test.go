@@ -859,6 +859,66 @@ func TestStatusRPC_ValidGenesisMessage(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestStatusRPC_FinalizedBeforeOrigin(t *testing.T) {
+	ctx := context.Background()
+	db := testingDB.SetupDB(t)
+	originSlot := 5 * params.BeaconConfig().SlotsPerEpoch
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(originSlot))
+	// The checkpoint has to belong to the same chain as the embedded genesis state.
+	genesisState, err := db.GenesisState(ctx)
+	require.NoError(t, err)
+	require.NoError(t, st.SetGenesisValidatorRoot(genesisState.GenesisValidatorRoot()))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	origin := testutil.NewBeaconBlock()
+	origin.Block.Slot = originSlot
+	origin.Block.StateRoot = stateRoot[:]
+	require.NoError(t, db.SaveOrigin(ctx, st, wrapper.WrappedPhase0SignedBeaconBlock(origin)))
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
+	r := &Service{
+		cfg: &Config{
+			DB: db,
+			Chain: &mock.ChainService{
+				State:               st,
+				FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 5, Root: originRoot[:]},
+				Fork: &statepb.Fork{
+					PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+					CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+				},
+				Genesis:        time.Now().Add(-10 * epochDuration),
+				ValidatorsRoot: [32]byte{'A'},
+			},
+		},
+		ctx: ctx,
+	}
+	digest, err := r.forkDigest()
+	require.NoError(t, err)
+
+	// Finalized roots before the origin checkpoint cannot be checked.
+	unknownRoot := bytesutil.PadTo([]byte("unknown"), 32)
+	require.NoError(t, r.validateStatusMessage(ctx, &pb.Status{
+		ForkDigest:     digest[:],
+		FinalizedRoot:  unknownRoot,
+		FinalizedEpoch: 4,
+	}))
+	err = r.validateStatusMessage(ctx, &pb.Status{
+		ForkDigest:     digest[:],
+		FinalizedRoot:  unknownRoot,
+		FinalizedEpoch: 5,
+	})
+	assert.ErrorContains(t, p2ptypes.ErrInvalidFinalizedRoot.Error(), err)
+	require.NoError(t, r.validateStatusMessage(ctx, &pb.Status{
+		ForkDigest:     digest[:],
+		FinalizedRoot:  originRoot[:],
+		FinalizedEpoch: 5,
+	}))
+}
+
 func TestShouldResync(t *testing.T) {
 	type args struct {
 		genesis  time.Time
@@ -23,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -30,6 +31,8 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/abool"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
@@ -43,6 +46,8 @@ const seenBlockSize = 1000
 const seenAttSize = 10000
 const seenExitSize = 100
 const seenProposerSlashingSize = 100
+const seenSyncMsgSize = 1000
+const seenSyncContributionSize = 300
 const badBlockSize = 1000
 const syncMetricsInterval = 10 * time.Second
 
@@ -57,17 +62,20 @@ var (
 
 // Config to set up the regular sync service.
 type Config struct {
-	P2P               p2p.P2P
-	DB                db.NoHeadAccessDatabase
-	AttPool           attestations.Pool
-	ExitPool          voluntaryexits.PoolManager
-	SlashingPool      slashings.PoolManager
-	Chain             blockchainService
-	InitialSync       Checker
-	StateNotifier     statefeed.Notifier
-	BlockNotifier     blockfeed.Notifier
-	OperationNotifier operation.Notifier
-	StateGen          *stategen.State
+	P2P                     p2p.P2P
+	DB                      db.NoHeadAccessDatabase
+	AttPool                 attestations.Pool
+	ExitPool                voluntaryexits.PoolManager
+	SlashingPool            slashings.PoolManager
+	SyncCommsPool           synccommittee.Pool
+	Chain                   blockchainService
+	InitialSync             Checker
+	StateNotifier           statefeed.Notifier
+	BlockNotifier           blockfeed.Notifier
+	OperationNotifier       operation.Notifier
+	StateGen                *stategen.State
+	SlasherAttestationsFeed *event.Feed
+	SlasherBlockHeadersFeed *event.Feed
 }
 
 // This defines the interface for interacting with block chain service
@@ -106,8 +114,15 @@ type Service struct {
 	seenProposerSlashingCache *lru.Cache
 	seenAttesterSlashingLock  sync.RWMutex
 	seenAttesterSlashingCache map[uint64]bool
+	seenSyncMessageLock       sync.RWMutex
+	seenSyncMessageCache      *lru.Cache
+	seenSyncContributionLock  sync.RWMutex
+	seenSyncContributionCache *lru.Cache
 	badBlockCache             *lru.Cache
 	badBlockLock              sync.RWMutex
+	signatureChan             chan *signatureVerifier
+	slasherAttestationsQueue  chan *ethpb.IndexedAttestation
+	slasherBlockHeadersQueue  chan *ethpb.SignedBeaconBlockHeader
 }
 
 // NewService initializes new regular sync service.
@@ -125,9 +140,16 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		seenPendingBlocks:    make(map[[32]byte]bool),
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
 		rateLimiter:          rLimiter,
+		signatureChan:        make(chan *signatureVerifier, verifierLimit),
 	}
 
 	go r.registerHandlers()
+	go r.verifierRoutine()
+	if featureconfig.Get().EnableSlasher && cfg.SlasherAttestationsFeed != nil && cfg.SlasherBlockHeadersFeed != nil {
+		r.slasherAttestationsQueue = make(chan *ethpb.IndexedAttestation, slasherQueueSize)
+		r.slasherBlockHeadersQueue = make(chan *ethpb.SignedBeaconBlockHeader, slasherQueueSize)
+		go r.slasherFeedRoutine()
+	}
 
 	return r
 }
@@ -206,6 +228,14 @@ func (s *Service) initCaches() error {
 	if err != nil {
 		return err
 	}
+	syncMsgCache, err := lru.New(seenSyncMsgSize)
+	if err != nil {
+		return err
+	}
+	syncContributionCache, err := lru.New(seenSyncContributionSize)
+	if err != nil {
+		return err
+	}
 	badBlockCache, err := lru.New(badBlockSize)
 	if err != nil {
 		return err
@@ -215,6 +245,8 @@ func (s *Service) initCaches() error {
 	s.seenExitCache = exitCache
 	s.seenAttesterSlashingCache = make(map[uint64]bool)
 	s.seenProposerSlashingCache = proposerSlashingCache
+	s.seenSyncMessageCache = syncMsgCache
+	s.seenSyncContributionCache = syncContributionCache
 	s.badBlockCache = badBlockCache
 
 	return nil
@@ -0,0 +1,41 @@
+package sync
+
+import (
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// Number of gossip validated objects buffered for the slasher before new ones are dropped.
+const slasherQueueSize = 4096
+
+// Queues a gossip validated indexed attestation for the slasher service. The queue is bounded so
+// that a slow slasher can never hold back gossip validation, attestations are dropped when it is full.
+func (s *Service) queueSlasherAttestation(att *ethpb.IndexedAttestation) {
+	select {
+	case s.slasherAttestationsQueue <- att:
+	default:
+		log.Debug("Slasher attestation queue is full, dropping attestation")
+	}
+}
+
+// Queues a gossip validated block header for the slasher service, dropping it when the queue is full.
+func (s *Service) queueSlasherBlockHeader(header *ethpb.SignedBeaconBlockHeader) {
+	select {
+	case s.slasherBlockHeadersQueue <- header:
+	default:
+		log.Debug("Slasher block header queue is full, dropping block header")
+	}
+}
+
+// Forwards queued attestations and block headers to the slasher feeds until the service stops.
+func (s *Service) slasherFeedRoutine() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case att := <-s.slasherAttestationsQueue:
+			s.cfg.SlasherAttestationsFeed.Send(att)
+		case header := <-s.slasherBlockHeadersQueue:
+			s.cfg.SlasherBlockHeadersFeed.Send(header)
+		}
+	}
+}
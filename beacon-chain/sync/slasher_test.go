@@ -0,0 +1,55 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_SlasherFeedRoutine(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Service{
+		cfg: &Config{
+			SlasherAttestationsFeed: new(event.Feed),
+			SlasherBlockHeadersFeed: new(event.Feed),
+		},
+		ctx:                      ctx,
+		slasherAttestationsQueue: make(chan *ethpb.IndexedAttestation, 1),
+		slasherBlockHeadersQueue: make(chan *ethpb.SignedBeaconBlockHeader, 1),
+	}
+	attsChan := make(chan *ethpb.IndexedAttestation, 1)
+	attsSub := s.cfg.SlasherAttestationsFeed.Subscribe(attsChan)
+	defer attsSub.Unsubscribe()
+	headersChan := make(chan *ethpb.SignedBeaconBlockHeader, 1)
+	headersSub := s.cfg.SlasherBlockHeadersFeed.Subscribe(headersChan)
+	defer headersSub.Unsubscribe()
+
+	att := &ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}
+	header := &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 1}}
+	s.queueSlasherAttestation(att)
+	// The queue is full, so this attestation is dropped instead of blocking validation.
+	s.queueSlasherAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{2}})
+	s.queueSlasherBlockHeader(header)
+	go s.slasherFeedRoutine()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case received := <-attsChan:
+			require.DeepEqual(t, att, received)
+		case received := <-headersChan:
+			require.DeepEqual(t, header, received)
+		case <-time.After(time.Second):
+			t.Fatal("Did not receive slasher feed event")
+		}
+	}
+	select {
+	case <-attsChan:
+		t.Fatal("Dropped attestation was fed to the slasher")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
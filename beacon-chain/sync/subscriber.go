@@ -11,6 +11,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -67,18 +68,33 @@ func (s *Service) registerSubscribers() {
 		s.validateAttesterSlashing,
 		s.attesterSlashingSubscriber,
 	)
+	s.subscribe(
+		p2p.SyncContributionAndProofSubnetTopicFormat,
+		s.validateSyncContributionAndProof,
+		s.syncContributionAndProofSubscriber,
+	)
 	if flags.Get().SubscribeToAllSubnets {
 		s.subscribeStaticWithSubnets(
 			"/eth2/%x/beacon_attestation_%d",
 			s.validateCommitteeIndexBeaconAttestation,   /* validator */
 			s.committeeIndexBeaconAttestationSubscriber, /* message handler */
 		)
+		s.subscribeStaticWithSyncSubnets(
+			p2p.SyncCommitteeSubnetTopicFormat,
+			s.validateSyncCommitteeMessage,   /* validator */
+			s.syncCommitteeMessageSubscriber, /* message handler */
+		)
 	} else {
 		s.subscribeDynamicWithSubnets(
 			"/eth2/%x/beacon_attestation_%d",
 			s.validateCommitteeIndexBeaconAttestation,   /* validator */
 			s.committeeIndexBeaconAttestationSubscriber, /* message handler */
 		)
+		s.subscribeDynamicWithSyncSubnets(
+			p2p.SyncCommitteeSubnetTopicFormat,
+			s.validateSyncCommitteeMessage,   /* validator */
+			s.syncCommitteeMessageSubscriber, /* message handler */
+		)
 	}
 }
 
@@ -288,6 +304,97 @@ func (s *Service) subscribeDynamicWithSubnets(
 	}()
 }
 
+// subscribe to all the static sync committee subnets with the given topic. A given validator and
+// subscription handler is used to handle messages from the subnets.
+func (s *Service) subscribeStaticWithSyncSubnets(topic string, validator pubsub.ValidatorEx, handle subHandler) {
+	base := p2p.GossipTopicMappings[topic]
+	if base == nil {
+		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topic))
+	}
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+		s.subscribeWithBase(s.addDigestAndIndexToTopic(topic, i), validator, handle)
+	}
+	genesis := s.cfg.Chain.GenesisTime()
+	ticker := slotutil.NewSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
+
+	go func() {
+		for {
+			select {
+			case <-s.ctx.Done():
+				ticker.Done()
+				return
+			case <-ticker.C():
+				if s.chainStarted.IsSet() && s.cfg.InitialSync.Syncing() {
+					continue
+				}
+				// Check every slot that there are enough peers
+				for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+					s.lookupSyncSubnetPeers(s.addDigestAndIndexToTopic(topic, i), i)
+				}
+			}
+		}
+	}()
+}
+
+// subscribe to the sync committee subnets requested by our validators. The wanted subnets are
+// retrieved from the sync subnet cache every slot, and subnets which are no longer needed
+// are unsubscribed.
+func (s *Service) subscribeDynamicWithSyncSubnets(
+	topicFormat string,
+	validate pubsub.ValidatorEx,
+	handle subHandler,
+) {
+	base := p2p.GossipTopicMappings[topicFormat]
+	if base == nil {
+		log.Fatalf("%s is not mapped to any message in GossipTopicMappings", topicFormat)
+	}
+	digest, err := s.forkDigest()
+	if err != nil {
+		log.WithError(err).Fatal("Could not compute fork digest")
+	}
+	subscriptions := make(map[uint64]*pubsub.Subscription, params.BeaconConfig().SyncCommitteeSubnetCount)
+	genesis := s.cfg.Chain.GenesisTime()
+	ticker := slotutil.NewSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
+
+	go func() {
+		for {
+			select {
+			case <-s.ctx.Done():
+				ticker.Done()
+				return
+			case <-ticker.C():
+				if s.chainStarted.IsSet() && s.cfg.InitialSync.Syncing() {
+					continue
+				}
+				wantedSubs := cache.SyncSubnetIDs.GetAllSubnets()
+				// Resize as appropriate.
+				s.reValidateSubscriptions(subscriptions, wantedSubs, topicFormat, digest)
+
+				for _, idx := range wantedSubs {
+					subnetTopic := fmt.Sprintf(topicFormat, digest, idx)
+					// check if subscription exists and if not subscribe the relevant subnet.
+					if _, exists := subscriptions[idx]; !exists {
+						subscriptions[idx] = s.subscribeWithBase(subnetTopic, validate, handle)
+					}
+					s.lookupSyncSubnetPeers(subnetTopic, idx)
+				}
+			}
+		}
+	}()
+}
+
+// lookup peers for the sync committee subnet if we do not have enough of them.
+func (s *Service) lookupSyncSubnetPeers(subnetTopic string, idx uint64) {
+	if !s.validPeersExist(subnetTopic) {
+		log.Debugf("No peers found subscribed to sync gossip subnet with "+
+			"committee index %d. Searching network for peers subscribed to the subnet.", idx)
+		_, err := s.cfg.P2P.FindPeersWithSubnet(s.ctx, subnetTopic, idx, params.BeaconNetworkConfig().MinimumPeersInSubnet)
+		if err != nil {
+			log.WithError(err).Debug("Could not search for peers")
+		}
+	}
+}
+
 // revalidate that our currently connected subnets are valid.
 func (s *Service) reValidateSubscriptions(subscriptions map[uint64]*pubsub.Subscription,
 	wantedSubs []uint64, topicFormat string, digest [4]byte) {
@@ -300,7 +407,7 @@ func (s *Service) reValidateSubscriptions(subscriptions map[uint64]*pubsub.Subsc
 			}
 		}
 		if !wanted && v != nil {
-			v.Cancel()
+			s.cfg.P2P.CancelSubscription(v)
 			fullTopic := fmt.Sprintf(topicFormat, digest, k) + s.cfg.P2P.Encoding().ProtocolSuffix()
 			if err := s.cfg.P2P.PubSub().UnregisterTopicValidator(fullTopic); err != nil {
 				log.WithError(err).Error("Could not unregister topic validator")
@@ -0,0 +1,25 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// syncCommitteeMessageSubscriber forwards the incoming validated sync committee message to the
+// sync committee pool.
+func (s *Service) syncCommitteeMessageSubscriber(_ context.Context, msg proto.Message) error {
+	m, ok := msg.(*prysmv2.SyncCommitteeMessage)
+	if !ok {
+		return fmt.Errorf("message was not type *prysmv2.SyncCommitteeMessage, type=%T", msg)
+	}
+
+	if m.BlockRoot == nil {
+		return errors.New("nil block root")
+	}
+
+	return s.cfg.SyncCommsPool.SaveSyncCommitteeMessage(m)
+}
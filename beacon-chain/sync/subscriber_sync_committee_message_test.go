@@ -0,0 +1,40 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncCommitteeMessageSubscriber_CanSaveMessage(t *testing.T) {
+	r := &Service{
+		cfg: &Config{
+			SyncCommsPool: synccommittee.NewPool(),
+		},
+	}
+
+	m := &prysmv2.SyncCommitteeMessage{
+		Slot:           1,
+		BlockRoot:      make([]byte, 32),
+		ValidatorIndex: 2,
+		Signature:      make([]byte, 96),
+	}
+	require.NoError(t, r.syncCommitteeMessageSubscriber(context.Background(), m))
+	msgs, err := r.cfg.SyncCommsPool.SyncCommitteeMessages(1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*prysmv2.SyncCommitteeMessage{m}, msgs, "Did not save sync committee message")
+}
+
+func TestSyncCommitteeMessageSubscriber_WrongType(t *testing.T) {
+	r := &Service{
+		cfg: &Config{
+			SyncCommsPool: synccommittee.NewPool(),
+		},
+	}
+	err := r.syncCommitteeMessageSubscriber(context.Background(), &prysmv2.SignedContributionAndProof{})
+	assert.ErrorContains(t, "message was not type *prysmv2.SyncCommitteeMessage", err)
+}
@@ -0,0 +1,39 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// syncContributionAndProofSubscriber forwards the incoming validated sync contributions and proof to the
+// sync committee pool.
+func (s *Service) syncContributionAndProofSubscriber(_ context.Context, msg proto.Message) error {
+	sContr, ok := msg.(*prysmv2.SignedContributionAndProof)
+	if !ok {
+		return fmt.Errorf("message was not type *prysmv2.SignedContributionAndProof, type=%T", msg)
+	}
+
+	if sContr.Message == nil || sContr.Message.Contribution == nil {
+		return errors.New("nil contribution")
+	}
+
+	if err := s.cfg.SyncCommsPool.SaveSyncCommitteeContribution(sContr.Message.Contribution); err != nil {
+		return err
+	}
+
+	// Broadcast the contribution on a feed to notify other services in the beacon node
+	// of a received sync committee contribution.
+	s.cfg.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.SyncCommitteeContributionReceived,
+		Data: &operation.SyncCommitteeContributionReceivedData{
+			Contribution: sContr,
+		},
+	})
+	return nil
+}
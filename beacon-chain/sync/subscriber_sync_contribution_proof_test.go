@@ -0,0 +1,63 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncContributionAndProofSubscriber_CanSaveContribution(t *testing.T) {
+	r := &Service{
+		cfg: &Config{
+			SyncCommsPool:     synccommittee.NewPool(),
+			OperationNotifier: (&mock.ChainService{}).OperationNotifier(),
+		},
+	}
+	opChannel := make(chan *feed.Event, 1)
+	opSub := r.cfg.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	c := &prysmv2.SyncCommitteeContribution{
+		Slot:              1,
+		BlockRoot:         make([]byte, 32),
+		SubcommitteeIndex: 2,
+		AggregationBits:   bitfield.NewBitvector128(),
+		Signature:         make([]byte, 96),
+	}
+	m := &prysmv2.SignedContributionAndProof{
+		Message: &prysmv2.ContributionAndProof{
+			AggregatorIndex: 3,
+			Contribution:    c,
+			SelectionProof:  make([]byte, 96),
+		},
+		Signature: make([]byte, 96),
+	}
+	require.NoError(t, r.syncContributionAndProofSubscriber(context.Background(), m))
+	contributions, err := r.cfg.SyncCommsPool.SyncCommitteeContributions(1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*prysmv2.SyncCommitteeContribution{c}, contributions, "Did not save sync committee contribution")
+
+	ev := <-opChannel
+	assert.Equal(t, operation.SyncCommitteeContributionReceived, int(ev.Type))
+	data, ok := ev.Data.(*operation.SyncCommitteeContributionReceivedData)
+	require.Equal(t, true, ok, "Unexpected event data type %T", ev.Data)
+	assert.DeepSSZEqual(t, m, data.Contribution)
+}
+
+func TestSyncContributionAndProofSubscriber_NilContribution(t *testing.T) {
+	r := &Service{
+		cfg: &Config{
+			SyncCommsPool: synccommittee.NewPool(),
+		},
+	}
+	err := r.syncContributionAndProofSubscriber(context.Background(), &prysmv2.SignedContributionAndProof{})
+	assert.ErrorContains(t, "nil contribution", err)
+}
@@ -269,6 +269,29 @@ func TestStaticSubnets(t *testing.T) {
 	cancel()
 }
 
+func TestStaticSyncSubnets(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	r := Service{
+		ctx: ctx,
+		cfg: &Config{
+			Chain: &mockChain.ChainService{
+				Genesis:        time.Now(),
+				ValidatorsRoot: [32]byte{'A'},
+			},
+			P2P: p,
+		},
+		chainStarted: abool.New(),
+	}
+	r.subscribeStaticWithSyncSubnets(p2p.SyncCommitteeSubnetTopicFormat, r.noopValidator, func(_ context.Context, msg proto.Message) error {
+		// no-op
+		return nil
+	})
+	topics := r.cfg.P2P.PubSub().GetTopics()
+	assert.Equal(t, params.BeaconConfig().SyncCommitteeSubnetCount, uint64(len(topics)))
+	cancel()
+}
+
 func Test_wrapAndReportValidation(t *testing.T) {
 	type args struct {
 		topic        string
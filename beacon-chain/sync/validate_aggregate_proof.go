@@ -17,6 +17,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
@@ -107,6 +108,16 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 
 	s.setAggregatorIndexEpochSeen(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex)
 
+	if featureconfig.Get().EnableSlasher {
+		// The pre state was computed during validation and is served from the checkpoint state cache.
+		preState, err := s.cfg.Chain.AttestationPreState(ctx, m.Message.Aggregate)
+		if err != nil {
+			log.WithError(err).Debug("Could not retrieve pre state for slasher")
+		} else {
+			s.feedSlasherAttestation(ctx, m.Message.Aggregate, preState)
+		}
+	}
+
 	msg.ValidatorData = m
 
 	return pubsub.ValidationAccept
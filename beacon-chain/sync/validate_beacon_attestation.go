@@ -16,7 +16,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -134,6 +136,10 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 
 	s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
 
+	if featureconfig.Get().EnableSlasher {
+		s.feedSlasherAttestation(ctx, att, preState)
+	}
+
 	msg.ValidatorData = att
 
 	return pubsub.ValidationAccept
@@ -202,6 +208,22 @@ func (s *Service) validateUnaggregatedAttWithState(ctx context.Context, a *eth.A
 	return pubsub.ValidationAccept
 }
 
+// Converts the validated attestation to its indexed form using the committee of its pre state and queues
+// it for the slasher service. Errors are only logged, as slashing detection must never affect gossip validation.
+func (s *Service) feedSlasherAttestation(ctx context.Context, att *eth.Attestation, preState state.ReadOnlyBeaconState) {
+	committee, err := helpers.BeaconCommitteeFromState(preState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		log.WithError(err).Debug("Could not get attestation committee for slasher")
+		return
+	}
+	indexedAtt, err := attestationutil.ConvertToIndexed(ctx, att, committee)
+	if err != nil {
+		log.WithError(err).Debug("Could not convert to indexed attestation for slasher")
+		return
+	}
+	s.queueSlasherAttestation(indexedAtt)
+}
+
 // Returns true if the attestation was already seen for the participating validator for the slot.
 func (s *Service) hasSeenCommitteeIndicesSlot(slot types.Slot, committeeID types.CommitteeIndex, aggregateBits []byte) bool {
 	s.seenAttestationLock.RLock()
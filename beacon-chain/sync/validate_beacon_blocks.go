@@ -17,7 +17,9 @@ import (
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -160,6 +162,15 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		log.WithError(err).WithField("blockSlot", blk.Block().Slot()).Warn("Rejected block")
 		return pubsub.ValidationReject
 	}
+	if featureconfig.Get().EnableSlasher {
+		// Only feed block headers which passed validation to the slasher.
+		blockHeader, err := blockutil.SignedBeaconBlockHeaderFromBlockInterface(blk)
+		if err != nil {
+			log.WithError(err).WithField("blockSlot", blk.Block().Slot()).Warn("Could not extract block header")
+		} else {
+			s.queueSlasherBlockHeader(blockHeader)
+		}
+	}
 	// Record attribute of valid block.
 	span.AddAttributes(trace.Int64Attribute("slotInEpoch", int64(blk.Block().Slot()%params.BeaconConfig().SlotsPerEpoch)))
 	msg.ValidatorData = rblk // Used in downstream subscriber
@@ -174,6 +185,16 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		"blockSlot":          blk.Block().Slot(),
 		"sinceSlotStartTime": receivedTime.Sub(startTime),
 	}).Debug("Received block")
+
+	// Broadcast the gossip validated block on a feed to notify other services in the beacon node
+	// of a block which is about to be imported.
+	s.cfg.BlockNotifier.BlockFeed().Send(&feed.Event{
+		Type: blockfeed.GossipValidatedBlock,
+		Data: &blockfeed.GossipValidatedBlockData{
+			BlockRoot:   blockRoot,
+			SignedBlock: blk,
+		},
+	})
 	return pubsub.ValidationAccept
 }
 
@@ -14,6 +14,8 @@ import (
 	gcache "github.com/patrickmn/go-cache"
 	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -259,9 +261,22 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 			Topic: &topic,
 		},
 	}
+	blockChannel := make(chan *feed.Event, 2)
+	blockSub := r.cfg.BlockNotifier.BlockFeed().Subscribe(blockChannel)
+	defer blockSub.Unsubscribe()
 	result := r.validateBeaconBlockPubSub(ctx, "", m) == pubsub.ValidationAccept
 	assert.Equal(t, true, result)
 	assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+
+	// The block is announced on receipt, and again once it has passed gossip validation.
+	assert.Equal(t, feed.EventType(blockfeed.ReceivedBlock), (<-blockChannel).Type)
+	ev := <-blockChannel
+	assert.Equal(t, feed.EventType(blockfeed.GossipValidatedBlock), ev.Type)
+	data, ok := ev.Data.(*blockfeed.GossipValidatedBlockData)
+	require.Equal(t, true, ok, "Unexpected event data type %T", ev.Data)
+	wantedRoot, err := msg.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantedRoot, data.BlockRoot)
 }
 
 func TestValidateBeaconBlockPubSub_WithLookahead(t *testing.T) {
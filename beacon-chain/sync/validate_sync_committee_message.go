@@ -0,0 +1,216 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+)
+
+// validateSyncCommitteeMessage verifies the sync committee message received over gossip
+// before it is forwarded to the network and saved to the sync committee pool.
+//
+// Validation
+//   - The message's slot is for the current slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
+//   - The subnet_id is valid for the given validator, i.e. subnet_id in compute_subnets_for_sync_committee(state, sync_committee_message.validator_index).
+//   - There has been no other valid sync committee message for the declared slot for the validator referenced by
+//     sync_committee_message.validator_index on this subnet.
+//   - The signature is valid for the message beacon_block_root for the validator referenced by validator_index.
+func (s *Service) validateSyncCommitteeMessage(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	if pid == s.cfg.P2P.PeerID() {
+		return pubsub.ValidationAccept
+	}
+	// Sync committee messages refer to the head of the chain, so we'll skip
+	// validating or processing them until fully synced.
+	if s.cfg.InitialSync.Syncing() {
+		return pubsub.ValidationIgnore
+	}
+	ctx, span := trace.StartSpan(ctx, "sync.validateSyncCommitteeMessage")
+	defer span.End()
+
+	if msg.Topic == nil {
+		return pubsub.ValidationReject
+	}
+
+	// Override topic for decoding.
+	originalTopic := msg.Topic
+	format := p2p.GossipTypeMapping[reflect.TypeOf(&prysmv2.SyncCommitteeMessage{})]
+	msg.Topic = &format
+
+	raw, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		log.WithError(err).Debug("Could not decode message")
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	// Restore topic.
+	msg.Topic = originalTopic
+
+	m, ok := raw.(*prysmv2.SyncCommitteeMessage)
+	if !ok {
+		return pubsub.ValidationReject
+	}
+	if err := validateNilSyncCommitteeMessage(m); err != nil {
+		return pubsub.ValidationReject
+	}
+
+	// The message's slot is for the current slot with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance.
+	if err := altair.ValidateSyncMessageTime(m.Slot, s.cfg.Chain.GenesisTime(), params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+
+	// Reject a message if it references an invalid block.
+	if s.hasBadBlock(bytesutil.ToBytes32(m.BlockRoot)) {
+		return pubsub.ValidationReject
+	}
+
+	headState, err := s.cfg.Chain.HeadState(ctx)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if headState.Version() == version.Phase0 {
+		return pubsub.ValidationIgnore
+	}
+
+	subnet, validationRes := s.validateSyncCommitteeMessageTopic(ctx, m, headState, *originalTopic)
+	if validationRes != pubsub.ValidationAccept {
+		return validationRes
+	}
+
+	// Verify this is the first message received for the validator for the slot on the subnet.
+	if s.hasSeenSyncMessageIndexSlot(m.Slot, m.ValidatorIndex, subnet) {
+		return pubsub.ValidationIgnore
+	}
+
+	sigSet, err := syncMessageSigSet(headState, m)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	if validationRes := s.validateWithBatchVerifier(ctx, "sync committee message", sigSet); validationRes != pubsub.ValidationAccept {
+		return validationRes
+	}
+
+	s.setSeenSyncMessageIndexSlot(m.Slot, m.ValidatorIndex, subnet)
+
+	msg.ValidatorData = m
+
+	return pubsub.ValidationAccept
+}
+
+// This validates the sync committee message was received on one of the subnets the
+// validator is assigned to, returning the subnet the message was received on.
+func (s *Service) validateSyncCommitteeMessageTopic(
+	ctx context.Context,
+	m *prysmv2.SyncCommitteeMessage,
+	bs state.BeaconState,
+	t string,
+) (uint64, pubsub.ValidationResult) {
+	_, span := trace.StartSpan(ctx, "sync.validateSyncCommitteeMessageTopic")
+	defer span.End()
+
+	subnets, err := altair.SubnetsForSyncCommittee(bs, m.ValidatorIndex)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return 0, pubsub.ValidationReject
+	}
+	if len(subnets) == 0 {
+		// Validator is not part of the sync committee.
+		return 0, pubsub.ValidationReject
+	}
+	format := p2p.GossipTypeMapping[reflect.TypeOf(&prysmv2.SyncCommitteeMessage{})]
+	digest, err := s.forkDigest()
+	if err != nil {
+		log.WithError(err).Error("Could not compute fork digest")
+		traceutil.AnnotateError(span, err)
+		return 0, pubsub.ValidationIgnore
+	}
+	// The subnet index is the last element of the topic, so the trailing slash
+	// guards against a prefix match of a larger subnet index.
+	for _, subnet := range subnets {
+		if strings.HasPrefix(t, fmt.Sprintf(format, digest, subnet)+"/") {
+			return subnet, pubsub.ValidationAccept
+		}
+	}
+	return 0, pubsub.ValidationReject
+}
+
+// Returns true if the node has received a sync committee message for the validator with index
+// and slot on the subnet.
+func (s *Service) hasSeenSyncMessageIndexSlot(slot types.Slot, valIndex types.ValidatorIndex, subnet uint64) bool {
+	s.seenSyncMessageLock.RLock()
+	defer s.seenSyncMessageLock.RUnlock()
+	b := append(bytesutil.Bytes32(uint64(slot)), bytesutil.Bytes32(uint64(valIndex))...)
+	b = append(b, bytesutil.Bytes32(subnet)...)
+	_, seen := s.seenSyncMessageCache.Get(string(b))
+	return seen
+}
+
+// Set sync committee message validator index, slot and subnet as seen.
+func (s *Service) setSeenSyncMessageIndexSlot(slot types.Slot, valIndex types.ValidatorIndex, subnet uint64) {
+	s.seenSyncMessageLock.Lock()
+	defer s.seenSyncMessageLock.Unlock()
+	b := append(bytesutil.Bytes32(uint64(slot)), bytesutil.Bytes32(uint64(valIndex))...)
+	b = append(b, bytesutil.Bytes32(subnet)...)
+	s.seenSyncMessageCache.Add(string(b), true)
+}
+
+// This returns the signature set of the sync committee message which can be used to batch verify.
+func syncMessageSigSet(bs state.ReadOnlyBeaconState, m *prysmv2.SyncCommitteeMessage) (*bls.SignatureSet, error) {
+	v, err := bs.ValidatorAtIndexReadOnly(m.ValidatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := v.PublicKey()
+	publicKey, err := bls.PublicKeyFromBytes(pubkey[:])
+	if err != nil {
+		return nil, err
+	}
+	d, err := helpers.Domain(bs.Fork(), helpers.SlotToEpoch(m.Slot), params.BeaconConfig().DomainSyncCommittee, bs.GenesisValidatorRoot())
+	if err != nil {
+		return nil, err
+	}
+	rawBytes := types.SSZBytes(m.BlockRoot)
+	root, err := helpers.ComputeSigningRoot(&rawBytes, d)
+	if err != nil {
+		return nil, err
+	}
+	return &bls.SignatureSet{
+		Signatures: [][]byte{m.Signature},
+		PublicKeys: []bls.PublicKey{publicKey},
+		Messages:   [][32]byte{root},
+	}, nil
+}
+
+// Ensures the sync committee message has all of its fields populated
+// with their expected lengths.
+func validateNilSyncCommitteeMessage(m *prysmv2.SyncCommitteeMessage) error {
+	if m == nil {
+		return errors.New("nil sync committee message")
+	}
+	if len(m.BlockRoot) != 32 {
+		return errors.New("invalid block root length")
+	}
+	if len(m.Signature) != params.BeaconConfig().BLSSignatureLength {
+		return errors.New("invalid signature length")
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_ValidateSyncCommitteeMessage(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+	beaconState, keys := deterministicAltairState(t, 64)
+	blockRoot := bytesutil.PadTo([]byte("block-root"), 32)
+
+	// Pick the first member of the current sync committee.
+	committee, err := beaconState.CurrentSyncCommittee()
+	require.NoError(t, err)
+	valIdx, ok := beaconState.ValidatorIndexByPubkey(bytesutil.ToBytes48(committee.Pubkeys[0]))
+	require.Equal(t, true, ok)
+	subnets, err := altair.SubnetsForSyncCommittee(beaconState, valIdx)
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(subnets))
+
+	d, err := helpers.Domain(beaconState.Fork(), 0, params.BeaconConfig().DomainSyncCommittee, beaconState.GenesisValidatorRoot())
+	require.NoError(t, err)
+	rawBytes := types.SSZBytes(blockRoot)
+	sigRoot, err := helpers.ComputeSigningRoot(&rawBytes, d)
+	require.NoError(t, err)
+	validMsg := func() *prysmv2.SyncCommitteeMessage {
+		return &prysmv2.SyncCommitteeMessage{
+			Slot:           0,
+			BlockRoot:      blockRoot,
+			ValidatorIndex: valIdx,
+			Signature:      keys[valIdx].Sign(sigRoot[:]).Marshal(),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		syncing     bool
+		msg         func() *prysmv2.SyncCommitteeMessage
+		badBlock    bool
+		wrongSubnet bool
+		seen        bool
+		want        pubsub.ValidationResult
+	}{
+		{
+			name:    "Is syncing",
+			syncing: true,
+			msg:     validMsg,
+			want:    pubsub.ValidationIgnore,
+		},
+		{
+			name:     "Bad block root",
+			msg:      validMsg,
+			badBlock: true,
+			want:     pubsub.ValidationReject,
+		},
+		{
+			name: "Future slot",
+			msg: func() *prysmv2.SyncCommitteeMessage {
+				m := validMsg()
+				m.Slot = 10
+				return m
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name:        "Wrong subnet",
+			msg:         validMsg,
+			wrongSubnet: true,
+			want:        pubsub.ValidationReject,
+		},
+		{
+			name: "Invalid signature",
+			msg: func() *prysmv2.SyncCommitteeMessage {
+				m := validMsg()
+				m.Signature = keys[valIdx].Sign([]byte("wrong")).Marshal()
+				return m
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "Already seen",
+			msg:  validMsg,
+			seen: true,
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Valid message",
+			msg:  validMsg,
+			want: pubsub.ValidationAccept,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := p2ptest.NewTestP2P(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			r := &Service{
+				ctx:           ctx,
+				signatureChan: make(chan *signatureVerifier, verifierLimit),
+				cfg: &Config{
+					P2P:         p,
+					InitialSync: &mockSync.Sync{IsSyncing: tt.syncing},
+					Chain: &mock.ChainService{
+						Genesis:        time.Now(),
+						State:          beaconState,
+						ValidatorsRoot: [32]byte{'A'},
+					},
+				},
+			}
+			require.NoError(t, r.initCaches())
+			go r.verifierRoutine()
+
+			subnet := subnets[0]
+			if tt.wrongSubnet {
+				// No validator is assigned to a subnet outside of the subnet count.
+				subnet = params.BeaconConfig().SyncCommitteeSubnetCount
+			}
+			m := tt.msg()
+			if tt.badBlock {
+				r.setBadBlock(context.Background(), bytesutil.ToBytes32(m.BlockRoot))
+			}
+			if tt.seen {
+				r.setSeenSyncMessageIndexSlot(m.Slot, m.ValidatorIndex, subnet)
+			}
+			digest, err := r.forkDigest()
+			require.NoError(t, err)
+			format := p2p.GossipTypeMapping[reflect.TypeOf(&prysmv2.SyncCommitteeMessage{})]
+			topic := fmt.Sprintf(format, digest, subnet) + p.Encoding().ProtocolSuffix()
+
+			buf := new(bytes.Buffer)
+			_, err = p.Encoding().EncodeGossip(buf, m)
+			require.NoError(t, err)
+			msg := &pubsub.Message{
+				Message: &pubsubpb.Message{
+					Data:  buf.Bytes(),
+					Topic: &topic,
+				},
+			}
+			assert.Equal(t, tt.want, r.validateSyncCommitteeMessage(context.Background(), "foobar", msg))
+			if tt.want == pubsub.ValidationAccept {
+				assert.DeepEqual(t, m, msg.ValidatorData)
+				assert.Equal(t, true, r.hasSeenSyncMessageIndexSlot(m.Slot, m.ValidatorIndex, subnet))
+			}
+		})
+	}
+}
+
+// deterministicAltairState returns an Altair state upgraded from the deterministic
+// genesis state, along with the secret keys of its validators.
+func deterministicAltairState(t *testing.T, numValidators uint64) (state.BeaconState, []bls.SecretKey) {
+	st, keys := testutil.DeterministicGenesisState(t, numValidators)
+	altairState, err := altair.UpgradeToAltair(context.Background(), st)
+	require.NoError(t, err)
+	return altairState, keys
+}
@@ -0,0 +1,271 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+)
+
+// validateSyncContributionAndProof verifies the signed contribution and proof received over gossip
+// before it is forwarded to the network and saved to the sync committee pool.
+//
+// Validation
+//   - The contribution's slot is for the current slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
+//   - The subcommittee index is in the allowed range, i.e. contribution.subcommittee_index < SYNC_COMMITTEE_SUBNET_COUNT.
+//   - The contribution has participants.
+//   - The selection proof selects the validator as an aggregator for the slot.
+//   - The aggregator's validator index is in the declared subcommittee of the current sync committee.
+//   - This is the first valid contribution received for the aggregator with index for the slot and subcommittee index.
+//   - The selection proof, the aggregator signature and the aggregate signature are valid.
+func (s *Service) validateSyncContributionAndProof(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	if pid == s.cfg.P2P.PeerID() {
+		return pubsub.ValidationAccept
+	}
+	// Sync committee contributions refer to the head of the chain, so we'll skip
+	// validating or processing them until fully synced.
+	if s.cfg.InitialSync.Syncing() {
+		return pubsub.ValidationIgnore
+	}
+	ctx, span := trace.StartSpan(ctx, "sync.validateSyncContributionAndProof")
+	defer span.End()
+
+	raw, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		log.WithError(err).Debug("Could not decode message")
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	m, ok := raw.(*prysmv2.SignedContributionAndProof)
+	if !ok {
+		return pubsub.ValidationReject
+	}
+	if err := validateNilSyncContribution(m); err != nil {
+		return pubsub.ValidationReject
+	}
+	c := m.Message.Contribution
+
+	// The contribution's slot is for the current slot with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance.
+	if err := altair.ValidateSyncMessageTime(c.Slot, s.cfg.Chain.GenesisTime(), params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if c.SubcommitteeIndex >= params.BeaconConfig().SyncCommitteeSubnetCount {
+		return pubsub.ValidationReject
+	}
+	if c.AggregationBits.Count() == 0 {
+		return pubsub.ValidationReject
+	}
+	isAggregator, err := altair.IsSyncCommitteeAggregator(m.Message.SelectionProof)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	if !isAggregator {
+		return pubsub.ValidationReject
+	}
+
+	// Reject a contribution if it references an invalid block.
+	if s.hasBadBlock(bytesutil.ToBytes32(c.BlockRoot)) {
+		return pubsub.ValidationReject
+	}
+
+	// Verify this is the first contribution received from the aggregator for the slot and subcommittee.
+	if s.hasSeenSyncContributionIndexSlot(c.Slot, m.Message.AggregatorIndex, types.CommitteeIndex(c.SubcommitteeIndex)) {
+		return pubsub.ValidationIgnore
+	}
+
+	headState, err := s.cfg.Chain.HeadState(ctx)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if headState.Version() == version.Phase0 {
+		return pubsub.ValidationIgnore
+	}
+
+	committee, err := altair.SyncCommitteeForSlot(headState)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	subCommitteePubkeys, err := altair.SyncSubCommitteePubkeys(committee, types.CommitteeIndex(c.SubcommitteeIndex))
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	aggregator, err := headState.ValidatorAtIndexReadOnly(m.Message.AggregatorIndex)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	aggregatorPubkey := aggregator.PublicKey()
+	var isMember bool
+	for _, pk := range subCommitteePubkeys {
+		if bytes.Equal(aggregatorPubkey[:], pk) {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return pubsub.ValidationReject
+	}
+
+	selectionSet, err := syncSelectionProofSigSet(headState, m.Message, aggregatorPubkey[:])
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	aggregatorSet, err := syncContributionAndProofSigSet(headState, m, aggregatorPubkey[:])
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	contributionSet, err := syncContributionSigSet(headState, c, subCommitteePubkeys)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	set := bls.NewSet()
+	set.Join(selectionSet).Join(aggregatorSet).Join(contributionSet)
+	if validationRes := s.validateWithBatchVerifier(ctx, "sync contribution and proof", set); validationRes != pubsub.ValidationAccept {
+		return validationRes
+	}
+
+	s.setSyncContributionIndexSlotSeen(c.Slot, m.Message.AggregatorIndex, types.CommitteeIndex(c.SubcommitteeIndex))
+
+	msg.ValidatorData = m
+
+	return pubsub.ValidationAccept
+}
+
+// Returns true if the node has received a sync contribution from the aggregator with index,
+// slot and subcommittee index.
+func (s *Service) hasSeenSyncContributionIndexSlot(slot types.Slot, aggregatorIndex types.ValidatorIndex, subComIdx types.CommitteeIndex) bool {
+	s.seenSyncContributionLock.RLock()
+	defer s.seenSyncContributionLock.RUnlock()
+	b := append(bytesutil.Bytes32(uint64(aggregatorIndex)), bytesutil.Bytes32(uint64(slot))...)
+	b = append(b, bytesutil.Bytes32(uint64(subComIdx))...)
+	_, seen := s.seenSyncContributionCache.Get(string(b))
+	return seen
+}
+
+// Set sync contribution's aggregator index, slot and subcommittee index as seen.
+func (s *Service) setSyncContributionIndexSlotSeen(slot types.Slot, aggregatorIndex types.ValidatorIndex, subComIdx types.CommitteeIndex) {
+	s.seenSyncContributionLock.Lock()
+	defer s.seenSyncContributionLock.Unlock()
+	b := append(bytesutil.Bytes32(uint64(aggregatorIndex)), bytesutil.Bytes32(uint64(slot))...)
+	b = append(b, bytesutil.Bytes32(uint64(subComIdx))...)
+	s.seenSyncContributionCache.Add(string(b), true)
+}
+
+// This returns the signature set of the aggregator's selection proof which can be used to batch verify.
+func syncSelectionProofSigSet(bs state.ReadOnlyBeaconState, m *prysmv2.ContributionAndProof, pubkey []byte) (*bls.SignatureSet, error) {
+	publicKey, err := bls.PublicKeyFromBytes(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	slot := m.Contribution.Slot
+	d, err := helpers.Domain(bs.Fork(), helpers.SlotToEpoch(slot), params.BeaconConfig().DomainSyncCommitteeSelectionProof, bs.GenesisValidatorRoot())
+	if err != nil {
+		return nil, err
+	}
+	selectionData := &statepb.SyncAggregatorSelectionData{Slot: slot, SubcommitteeIndex: m.Contribution.SubcommitteeIndex}
+	root, err := helpers.ComputeSigningRoot(selectionData, d)
+	if err != nil {
+		return nil, err
+	}
+	return &bls.SignatureSet{
+		Signatures: [][]byte{m.SelectionProof},
+		PublicKeys: []bls.PublicKey{publicKey},
+		Messages:   [][32]byte{root},
+	}, nil
+}
+
+// This returns the signature set of the aggregator's signature over the contribution and proof
+// which can be used to batch verify.
+func syncContributionAndProofSigSet(bs state.ReadOnlyBeaconState, m *prysmv2.SignedContributionAndProof, pubkey []byte) (*bls.SignatureSet, error) {
+	publicKey, err := bls.PublicKeyFromBytes(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	epoch := helpers.SlotToEpoch(m.Message.Contribution.Slot)
+	d, err := helpers.Domain(bs.Fork(), epoch, params.BeaconConfig().DomainContributionAndProof, bs.GenesisValidatorRoot())
+	if err != nil {
+		return nil, err
+	}
+	root, err := helpers.ComputeSigningRoot(m.Message, d)
+	if err != nil {
+		return nil, err
+	}
+	return &bls.SignatureSet{
+		Signatures: [][]byte{m.Signature},
+		PublicKeys: []bls.PublicKey{publicKey},
+		Messages:   [][32]byte{root},
+	}, nil
+}
+
+// This returns the signature set of the contribution's aggregate signature over the block root,
+// signed by the participating subcommittee members, which can be used to batch verify.
+func syncContributionSigSet(bs state.ReadOnlyBeaconState, c *prysmv2.SyncCommitteeContribution, subCommitteePubkeys [][]byte) (*bls.SignatureSet, error) {
+	var participants [][]byte
+	for i, pk := range subCommitteePubkeys {
+		if c.AggregationBits.BitAt(uint64(i)) {
+			participants = append(participants, pk)
+		}
+	}
+	if len(participants) == 0 {
+		return nil, errors.New("no participants in sync contribution")
+	}
+	aggPubkey, err := bls.AggregatePublicKeys(participants)
+	if err != nil {
+		return nil, err
+	}
+	d, err := helpers.Domain(bs.Fork(), helpers.SlotToEpoch(c.Slot), params.BeaconConfig().DomainSyncCommittee, bs.GenesisValidatorRoot())
+	if err != nil {
+		return nil, err
+	}
+	rawBytes := types.SSZBytes(c.BlockRoot)
+	root, err := helpers.ComputeSigningRoot(&rawBytes, d)
+	if err != nil {
+		return nil, err
+	}
+	return &bls.SignatureSet{
+		Signatures: [][]byte{c.Signature},
+		PublicKeys: []bls.PublicKey{aggPubkey},
+		Messages:   [][32]byte{root},
+	}, nil
+}
+
+// Ensures the signed contribution and proof has all of its fields populated
+// with their expected lengths.
+func validateNilSyncContribution(m *prysmv2.SignedContributionAndProof) error {
+	if m == nil || m.Message == nil || m.Message.Contribution == nil {
+		return errors.New("nil sync contribution and proof")
+	}
+	sigLen := params.BeaconConfig().BLSSignatureLength
+	if len(m.Signature) != sigLen || len(m.Message.SelectionProof) != sigLen || len(m.Message.Contribution.Signature) != sigLen {
+		return errors.New("invalid signature length")
+	}
+	if len(m.Message.Contribution.BlockRoot) != 32 {
+		return errors.New("invalid block root length")
+	}
+	if m.Message.Contribution.AggregationBits == nil {
+		return errors.New("nil aggregation bits")
+	}
+	return nil
+}
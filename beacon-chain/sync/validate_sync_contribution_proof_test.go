@@ -0,0 +1,196 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_ValidateSyncContributionAndProof(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+	beaconState, keys := deterministicAltairState(t, 64)
+	blockRoot := bytesutil.PadTo([]byte("block-root"), 32)
+	fork := beaconState.Fork()
+	genRoot := beaconState.GenesisValidatorRoot()
+
+	committee, err := altair.SyncCommitteeForSlot(beaconState)
+	require.NoError(t, err)
+
+	// Find a subcommittee member whose selection proof makes it an aggregator.
+	selectionDomain, err := helpers.Domain(fork, 0, params.BeaconConfig().DomainSyncCommitteeSelectionProof, genRoot)
+	require.NoError(t, err)
+	var aggIdx types.ValidatorIndex
+	var subComIdx, position uint64
+	var selectionProof []byte
+	found := false
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount && !found; i++ {
+		pubkeys, err := altair.SyncSubCommitteePubkeys(committee, types.CommitteeIndex(i))
+		require.NoError(t, err)
+		selectionRoot, err := helpers.ComputeSigningRoot(&statepb.SyncAggregatorSelectionData{Slot: 0, SubcommitteeIndex: i}, selectionDomain)
+		require.NoError(t, err)
+		for j, pk := range pubkeys {
+			idx, ok := beaconState.ValidatorIndexByPubkey(bytesutil.ToBytes48(pk))
+			require.Equal(t, true, ok)
+			proof := keys[idx].Sign(selectionRoot[:]).Marshal()
+			isAggregator, err := altair.IsSyncCommitteeAggregator(proof)
+			require.NoError(t, err)
+			if isAggregator {
+				aggIdx, subComIdx, position, selectionProof = idx, i, uint64(j), proof
+				found = true
+				break
+			}
+		}
+	}
+	require.Equal(t, true, found, "Could not find a sync committee aggregator")
+
+	syncDomain, err := helpers.Domain(fork, 0, params.BeaconConfig().DomainSyncCommittee, genRoot)
+	require.NoError(t, err)
+	rawBytes := types.SSZBytes(blockRoot)
+	blockSigRoot, err := helpers.ComputeSigningRoot(&rawBytes, syncDomain)
+	require.NoError(t, err)
+	proofDomain, err := helpers.Domain(fork, 0, params.BeaconConfig().DomainContributionAndProof, genRoot)
+	require.NoError(t, err)
+
+	// sign builds a signed contribution and proof after applying the mutation to the unsigned message.
+	sign := func(mutate func(*prysmv2.ContributionAndProof)) *prysmv2.SignedContributionAndProof {
+		bits := bitfield.NewBitvector128()
+		bits.SetBitAt(position, true)
+		m := &prysmv2.ContributionAndProof{
+			AggregatorIndex: aggIdx,
+			Contribution: &prysmv2.SyncCommitteeContribution{
+				Slot:              0,
+				BlockRoot:         blockRoot,
+				SubcommitteeIndex: subComIdx,
+				AggregationBits:   bits,
+				Signature:         keys[aggIdx].Sign(blockSigRoot[:]).Marshal(),
+			},
+			SelectionProof: selectionProof,
+		}
+		if mutate != nil {
+			mutate(m)
+		}
+		root, err := helpers.ComputeSigningRoot(m, proofDomain)
+		require.NoError(t, err)
+		return &prysmv2.SignedContributionAndProof{Message: m, Signature: keys[aggIdx].Sign(root[:]).Marshal()}
+	}
+
+	tests := []struct {
+		name    string
+		syncing bool
+		msg     *prysmv2.SignedContributionAndProof
+		seen    bool
+		want    pubsub.ValidationResult
+	}{
+		{
+			name:    "Is syncing",
+			syncing: true,
+			msg:     sign(nil),
+			want:    pubsub.ValidationIgnore,
+		},
+		{
+			name: "Future slot",
+			msg: sign(func(m *prysmv2.ContributionAndProof) {
+				m.Contribution.Slot = 10
+			}),
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Invalid subcommittee index",
+			msg: sign(func(m *prysmv2.ContributionAndProof) {
+				m.Contribution.SubcommitteeIndex = params.BeaconConfig().SyncCommitteeSubnetCount
+			}),
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "No participants",
+			msg: sign(func(m *prysmv2.ContributionAndProof) {
+				m.Contribution.AggregationBits = bitfield.NewBitvector128()
+			}),
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "Invalid aggregate signature",
+			msg: sign(func(m *prysmv2.ContributionAndProof) {
+				m.Contribution.Signature = keys[aggIdx].Sign([]byte("wrong")).Marshal()
+			}),
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "Already seen",
+			msg:  sign(nil),
+			seen: true,
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Valid contribution",
+			msg:  sign(nil),
+			want: pubsub.ValidationAccept,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := p2ptest.NewTestP2P(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			r := &Service{
+				ctx:           ctx,
+				signatureChan: make(chan *signatureVerifier, verifierLimit),
+				cfg: &Config{
+					P2P:         p,
+					InitialSync: &mockSync.Sync{IsSyncing: tt.syncing},
+					Chain: &mock.ChainService{
+						Genesis:        time.Now(),
+						State:          beaconState,
+						ValidatorsRoot: [32]byte{'A'},
+					},
+				},
+			}
+			require.NoError(t, r.initCaches())
+			go r.verifierRoutine()
+
+			c := tt.msg.Message.Contribution
+			if tt.seen {
+				r.setSyncContributionIndexSlotSeen(c.Slot, tt.msg.Message.AggregatorIndex, types.CommitteeIndex(c.SubcommitteeIndex))
+			}
+			digest, err := r.forkDigest()
+			require.NoError(t, err)
+			format := p2p.GossipTypeMapping[reflect.TypeOf(tt.msg)]
+			topic := fmt.Sprintf(format, digest) + p.Encoding().ProtocolSuffix()
+
+			buf := new(bytes.Buffer)
+			_, err = p.Encoding().EncodeGossip(buf, tt.msg)
+			require.NoError(t, err)
+			msg := &pubsub.Message{
+				Message: &pubsubpb.Message{
+					Data:  buf.Bytes(),
+					Topic: &topic,
+				},
+			}
+			assert.Equal(t, tt.want, r.validateSyncContributionAndProof(context.Background(), "foobar", msg))
+			if tt.want == pubsub.ValidationAccept {
+				assert.DeepEqual(t, tt.msg, msg.ValidatorData)
+			}
+		})
+	}
+}
@@ -31,5 +31,50 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "export-deposit-snapshot",
+			Description: `exports the snapshot of the finalized deposits of a database, to initialize the deposit trie of another node`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.DepositSnapshotOutputFileFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.ExportDepositSnapshot(cliCtx); err != nil {
+					log.Fatalf("Could not export deposit snapshot: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "export",
+			Description: `exports the finalized blocks and states of a database to an archive file, to be imported into the database of another node`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.ArchiveOutputFileFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.ExportArchive(cliCtx); err != nil {
+					log.Fatalf("Could not export archive: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "import",
+			Description: `initializes a new database from an archive file of finalized blocks and states`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.ArchiveSourceFileFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.ImportArchive(cliCtx); err != nil {
+					log.Fatalf("Could not import archive: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -19,6 +19,18 @@ var (
 		Name:  "fallback-web3provider",
 		Usage: "A mainchain web3 provider string http endpoint. This is our fallback web3 provider, this flag may be used multiple times.",
 	}
+	// ExecutionEngineEndpoint provides the engine API endpoint of an execution engine.
+	ExecutionEngineEndpoint = &cli.StringFlag{
+		Name:  "execution-endpoint",
+		Usage: "An http endpoint serving the engine API of an execution engine, the requests are authenticated with --jwt-secret.",
+		Value: "",
+	}
+	// JWTSecretFlag defines the file holding the secret shared with the execution engine.
+	JWTSecretFlag = &cli.StringFlag{
+		Name:  "jwt-secret",
+		Usage: "Path to a file holding the hex encoded 32 bytes secret used to authenticate the requests to the execution engine.",
+		Value: "",
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = &cli.StringFlag{
 		Name:  "deposit-contract",
@@ -115,6 +127,15 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// EpochsPerStateSnapshot specifies the number of epochs between full states saved in the cold section of DB, when
+	// the state of every finalized epoch is saved as a difference from the previous one.
+	EpochsPerStateSnapshot = &cli.IntFlag{
+		Name: "epochs-per-state-snapshot",
+		Usage: "Saves the state of every finalized epoch as a compact difference from the previous epoch, with a full " +
+			"state every given number of epochs, so that any historical state is at most an epoch of blocks away. " +
+			"0 disables state differences.",
+		Value: 0,
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -153,6 +174,17 @@ var (
 		Name:  "historical-slasher-node",
 		Usage: "Enables required flags for serving historical data to a slasher client. Results in additional storage usage",
 	}
+	// MonitorIndices defines the indices of the validators followed by the validator monitor.
+	MonitorIndices = &cli.IntSliceFlag{
+		Name: "monitor-indices",
+		Usage: "Validator indices to monitor. The beacon node logs and exports metrics for the proposals, " +
+			"attestations, balance changes, slashings and exits of these validators in every processed block and epoch.",
+	}
+	// MonitorPublicKeys defines the public keys of the validators followed by the validator monitor.
+	MonitorPublicKeys = &cli.StringSliceFlag{
+		Name:  "monitor-pubkeys",
+		Usage: "Hex encoded public keys of validators to monitor, in addition to --monitor-indices.",
+	}
 	// ChainID defines a flag to set the chain id. If none is set, it derives this value from NetworkConfig
 	ChainID = &cli.Uint64Flag{
 		Name:  "chain-id",
@@ -182,4 +214,27 @@ var (
 		Usage: "Load a genesis state from ssz file. Testnet genesis files can be found in the " +
 			"eth2-clients/eth2-testnets repository on github.",
 	}
+	// DepositSnapshotPath defines a flag to initialize the deposit trie from a deposit snapshot file.
+	DepositSnapshotPath = &cli.StringFlag{
+		Name: "deposit-snapshot",
+		Usage: "Initializes the deposit trie of a new node from a deposit snapshot file, as exported by the " +
+			"`db export-deposit-snapshot` command, so that only the later deposit logs are requested from the eth1 node.",
+	}
+	// CheckpointStatePath defines a flag to start the beacon chain from a finalized state file.
+	CheckpointStatePath = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "Starts a new node from the given ssz encoded finalized state instead of from genesis. " +
+			"Requires --checkpoint-block to be set to the finalized block the state belongs to.",
+	}
+	// CheckpointBlockPath defines a flag to start the beacon chain from a finalized block file.
+	CheckpointBlockPath = &cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "Starts a new node from the given ssz encoded finalized block instead of from genesis. Requires --checkpoint-state.",
+	}
+	// CheckpointSyncURL defines a flag to start the beacon chain from the finalized checkpoint of a trusted beacon node.
+	CheckpointSyncURL = &cli.StringFlag{
+		Name: "checkpoint-sync-url",
+		Usage: "URL of the beacon API of a trusted beacon node, e.g. http://localhost:3500. A new node downloads " +
+			"the finalized state and block of that node and syncs forward from them instead of from genesis.",
+	}
 )
@@ -106,21 +106,30 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GPRCGatewayCorsDomain,
 			flags.HTTPWeb3ProviderFlag,
 			flags.FallbackWeb3ProviderFlag,
+			flags.ExecutionEngineEndpoint,
+			flags.JWTSecretFlag,
 			flags.SetGCPercent,
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.EpochsPerStateSnapshot,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
+			flags.MonitorIndices,
+			flags.MonitorPublicKeys,
 			flags.ChainID,
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
 			flags.GenesisStatePath,
+			flags.DepositSnapshotPath,
+			flags.CheckpointStatePath,
+			flags.CheckpointBlockPath,
+			flags.CheckpointSyncURL,
 		},
 	},
 	{
@@ -135,6 +144,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
 			cmd.StaticPeers,
+			cmd.TrustedPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
 		},
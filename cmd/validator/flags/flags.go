@@ -38,6 +38,25 @@ var (
 		Usage: "Beacon node RPC gateway provider endpoint",
 		Value: "127.0.0.1:3500",
 	}
+	// BeaconRESTApiProviderFlag defines a beacon node standard REST API endpoint.
+	BeaconRESTApiProviderFlag = &cli.StringFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node standard REST API provider endpoint, e.g. http://127.0.0.1:3500. When set, the validator " +
+			"client performs its duties through the standard beacon API instead of the Prysm gRPC API, " +
+			"which allows it to connect to non-Prysm beacon nodes",
+	}
+	// FallbackBeaconRESTApiProviderFlag defines fallback beacon node standard REST API endpoints.
+	FallbackBeaconRESTApiProviderFlag = &cli.StringSliceFlag{
+		Name: "fallback-beacon-rest-api-provider",
+		Usage: "Fallback beacon node standard REST API provider endpoint, used in order whenever the beacon node " +
+			"set with --beacon-rest-api-provider is unavailable. This flag may be used multiple times.",
+	}
+	// BeaconRESTApiTimeoutFlag defines the timeout of requests to the beacon node standard REST API.
+	BeaconRESTApiTimeoutFlag = &cli.DurationFlag{
+		Name:  "beacon-rest-api-timeout",
+		Usage: "Timeout of requests to the beacon node standard REST API",
+		Value: 12 * time.Second,
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -267,10 +286,23 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// Web3SignerURLFlag defines the base URL of a remote signer speaking the Web3Signer HTTP interface.
+	Web3SignerURLFlag = &cli.StringFlag{
+		Name:  "web3signer-url",
+		Usage: "Base URL of a remote signer speaking the Web3Signer HTTP interface, such as http://localhost:9000",
+		Value: "",
+	}
+	// Web3SignerGenesisValidatorsRootFlag defines the genesis validators root of the chain
+	// signed for through a web3signer keymanager.
+	Web3SignerGenesisValidatorsRootFlag = &cli.StringFlag{
+		Name:  "web3signer-genesis-validators-root",
+		Usage: "Hex encoded genesis validators root of the chain, sent to the Web3Signer remote signer with each request",
+		Value: "",
+	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either imported, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either imported, derived, remote, or web3signer, specified during wallet creation",
 		Value: "",
 	}
 	// SkipDepositConfirmationFlag skips the y/n confirmation prompt for sending a deposit to the deposit contract.
@@ -5,6 +5,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	ethpb_alpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -95,6 +96,55 @@ func V1Alpha1AggregateAttAndProofToV1(v1alpha1Att *ethpb_alpha.AggregateAttestat
 	}
 }
 
+// V2SignedContributionAndProofToV1 converts a prysm v2 signed sync committee contribution and proof to v1.
+func V2SignedContributionAndProofToV1(v2Contribution *prysmv2.SignedContributionAndProof) *ethpb.SignedContributionAndProof {
+	if v2Contribution == nil {
+		return &ethpb.SignedContributionAndProof{}
+	}
+	v1 := &ethpb.SignedContributionAndProof{
+		Signature: v2Contribution.Signature,
+	}
+	if msg := v2Contribution.Message; msg != nil {
+		v1.Message = &ethpb.ContributionAndProof{
+			AggregatorIndex: msg.AggregatorIndex,
+			SelectionProof:  msg.SelectionProof,
+		}
+		if c := msg.Contribution; c != nil {
+			v1.Message.Contribution = &ethpb.SyncCommitteeContribution{
+				Slot:              c.Slot,
+				BeaconBlockRoot:   c.BlockRoot,
+				SubcommitteeIndex: c.SubcommitteeIndex,
+				AggregationBits:   c.AggregationBits,
+				Signature:         c.Signature,
+			}
+		}
+	}
+	return v1
+}
+
+// V1AggregateAttAndProofToV1Alpha1 converts a v1 aggregate attestation and proof to v1alpha1.
+func V1AggregateAttAndProofToV1Alpha1(v1Att *ethpb.AggregateAttestationAndProof) *ethpb_alpha.AggregateAttestationAndProof {
+	if v1Att == nil {
+		return &ethpb_alpha.AggregateAttestationAndProof{}
+	}
+	return &ethpb_alpha.AggregateAttestationAndProof{
+		AggregatorIndex: v1Att.AggregatorIndex,
+		Aggregate:       V1AttToV1Alpha1(v1Att.Aggregate),
+		SelectionProof:  v1Att.SelectionProof,
+	}
+}
+
+// V1SignedAggregateAttAndProofToV1Alpha1 converts a v1 signed aggregate attestation and proof to v1alpha1.
+func V1SignedAggregateAttAndProofToV1Alpha1(v1Att *ethpb.SignedAggregateAttestationAndProof) *ethpb_alpha.SignedAggregateAttestationAndProof {
+	if v1Att == nil {
+		return &ethpb_alpha.SignedAggregateAttestationAndProof{}
+	}
+	return &ethpb_alpha.SignedAggregateAttestationAndProof{
+		Message:   V1AggregateAttAndProofToV1Alpha1(v1Att.Message),
+		Signature: v1Att.Signature,
+	}
+}
+
 // V1Alpha1IndexedAttToV1 converts a v1alpha1 indexed attestation to v1.
 func V1Alpha1IndexedAttToV1(v1alpha1Att *ethpb_alpha.IndexedAttestation) *ethpb.IndexedAttestation {
 	if v1alpha1Att == nil {
@@ -8,6 +8,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb_alpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -72,6 +73,67 @@ func Test_V1Alpha1AggregateAttAndProofToV1(t *testing.T) {
 	assert.DeepEqual(t, v1.SelectionProof, proof[:])
 }
 
+func Test_V2SignedContributionAndProofToV1(t *testing.T) {
+	proof := [96]byte{2}
+	v2 := &prysmv2.SignedContributionAndProof{
+		Message: &prysmv2.ContributionAndProof{
+			AggregatorIndex: 1,
+			Contribution: &prysmv2.SyncCommitteeContribution{
+				Slot:              5,
+				BlockRoot:         beaconBlockRoot,
+				SubcommitteeIndex: 2,
+				AggregationBits:   bitfield.NewBitvector128(),
+				Signature:         signature,
+			},
+			SelectionProof: proof[:],
+		},
+		Signature: signature,
+	}
+	v1 := V2SignedContributionAndProofToV1(v2)
+	assert.Equal(t, types.ValidatorIndex(1), v1.Message.AggregatorIndex)
+	assert.DeepEqual(t, proof[:], v1.Message.SelectionProof)
+	assert.Equal(t, types.Slot(5), v1.Message.Contribution.Slot)
+	assert.DeepEqual(t, beaconBlockRoot, v1.Message.Contribution.BeaconBlockRoot)
+	assert.Equal(t, uint64(2), v1.Message.Contribution.SubcommitteeIndex)
+	assert.DeepEqual(t, bitfield.NewBitvector128(), v1.Message.Contribution.AggregationBits)
+	assert.DeepEqual(t, signature, v1.Message.Contribution.Signature)
+	assert.DeepEqual(t, signature, v1.Signature)
+}
+
+func Test_V1SignedAggregateAttAndProofToV1Alpha1(t *testing.T) {
+	v1Att := &ethpb.SignedAggregateAttestationAndProof{
+		Message: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: 1,
+			Aggregate: &ethpb.Attestation{
+				AggregationBits: aggregationBits,
+				Data: &ethpb.AttestationData{
+					Slot:            slot,
+					Index:           committeeIndex,
+					BeaconBlockRoot: beaconBlockRoot,
+					Source: &ethpb.Checkpoint{
+						Epoch: epoch,
+						Root:  sourceRoot,
+					},
+					Target: &ethpb.Checkpoint{
+						Epoch: epoch,
+						Root:  targetRoot,
+					},
+				},
+				Signature: signature,
+			},
+			SelectionProof: signature,
+		},
+		Signature: signature,
+	}
+
+	alphaAtt := V1SignedAggregateAttAndProofToV1Alpha1(v1Att)
+	alphaRoot, err := alphaAtt.HashTreeRoot()
+	require.NoError(t, err)
+	v1Root, err := v1Att.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, v1Root, alphaRoot)
+}
+
 func Test_V1Alpha1BlockToV1BlockHeader(t *testing.T) {
 	alphaBlock := testutil.HydrateSignedBeaconBlock(&ethpb_alpha.SignedBeaconBlock{})
 	alphaBlock.Block.Slot = slot
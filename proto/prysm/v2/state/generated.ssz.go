@@ -2221,7 +2221,7 @@ func (s *SyncCommittee) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 				err = ssz.ErrBytesLength
 				return
 			}
-			hh.Append(i)
+			hh.PutBytes(i)
 		}
 		hh.Merkleize(subIndx)
 	}
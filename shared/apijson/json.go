@@ -0,0 +1,230 @@
+// Package apijson encodes and decodes Ethereum API protobuf messages in the JSON format
+// of the standard beacon API, which is shared by other Ethereum HTTP APIs such as remote signers.
+package apijson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// renamedFields maps Ethereum API protobuf fields to their names in the standard
+// beacon API JSON schema, for the few fields where the two differ.
+var renamedFields = map[protoreflect.FullName]string{
+	"ethereum.eth.v1.SignedBeaconBlock.block":                "message",
+	"ethereum.eth.v1alpha1.ProposerSlashing.header_1":        "signed_header_1",
+	"ethereum.eth.v1alpha1.ProposerSlashing.header_2":        "signed_header_2",
+	"ethereum.eth.v1alpha1.SignedBeaconBlockHeader.header":   "message",
+	"ethereum.eth.v1alpha1.SignedVoluntaryExit.exit":         "message",
+	"ethereum.eth.v1alpha1.Deposit.Data.public_key":          "pubkey",
+	"ethereum.prysm.v2.SignedBeaconBlockAltair.block":        "message",
+	"ethereum.prysm.v2.SyncCommitteeMessage.block_root":      "beacon_block_root",
+	"ethereum.prysm.v2.SyncCommitteeContribution.block_root": "beacon_block_root",
+}
+
+// Marshal encodes an Ethereum API protobuf message using the standard beacon API
+// JSON conventions: integers are encoded as decimal strings and byte arrays as 0x-prefixed
+// hex strings, while enums are encoded as their lower case names.
+func Marshal(m proto.Message) ([]byte, error) {
+	v, err := messageToJSON(m.ProtoReflect())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// MarshalArray encodes Ethereum API protobuf messages as a standard beacon API JSON array.
+func MarshalArray(ms ...proto.Message) ([]byte, error) {
+	items := make([]interface{}, len(ms))
+	for i, m := range ms {
+		v, err := messageToJSON(m.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		items[i] = v
+	}
+	return json.Marshal(items)
+}
+
+// Unmarshal decodes standard beacon API JSON into an Ethereum API protobuf message.
+func Unmarshal(data []byte, m proto.Message) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	return jsonToMessage(v, m.ProtoReflect())
+}
+
+func jsonName(fd protoreflect.FieldDescriptor) string {
+	if name, ok := renamedFields[fd.FullName()]; ok {
+		return name
+	}
+	return string(fd.Name())
+}
+
+func messageToJSON(m protoreflect.Message) (map[string]interface{}, error) {
+	fields := m.Descriptor().Fields()
+	res := make(map[string]interface{}, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() {
+			list := m.Get(fd).List()
+			items := make([]interface{}, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				item, err := valueToJSON(fd, list.Get(j))
+				if err != nil {
+					return nil, err
+				}
+				items[j] = item
+			}
+			res[jsonName(fd)] = items
+			continue
+		}
+		if fd.Kind() == protoreflect.MessageKind && !m.Has(fd) {
+			continue
+		}
+		v, err := valueToJSON(fd, m.Get(fd))
+		if err != nil {
+			return nil, err
+		}
+		res[jsonName(fd)] = v
+	}
+	return res, nil
+}
+
+func valueToJSON(fd protoreflect.FieldDescriptor, v protoreflect.Value) (interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool(), nil
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.StringKind:
+		return v.String(), nil
+	case protoreflect.BytesKind:
+		return hexutil.Encode(v.Bytes()), nil
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByNumber(v.Enum())
+		if ev == nil {
+			return nil, fmt.Errorf("unknown enum value %d for field %s", v.Enum(), fd.FullName())
+		}
+		return strings.ToLower(string(ev.Name())), nil
+	case protoreflect.MessageKind:
+		return messageToJSON(v.Message())
+	default:
+		return nil, fmt.Errorf("unsupported field kind %s for field %s", fd.Kind(), fd.FullName())
+	}
+}
+
+func jsonToMessage(v interface{}, m protoreflect.Message) error {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected JSON object for %s", m.Descriptor().FullName())
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		raw, ok := obj[jsonName(fd)]
+		if !ok || raw == nil {
+			continue
+		}
+		if fd.IsList() {
+			items, ok := raw.([]interface{})
+			if !ok {
+				return fmt.Errorf("expected JSON array for field %s", fd.FullName())
+			}
+			list := m.Mutable(fd).List()
+			for _, item := range items {
+				if fd.Kind() == protoreflect.MessageKind {
+					elem := list.NewElement()
+					if err := jsonToMessage(item, elem.Message()); err != nil {
+						return err
+					}
+					list.Append(elem)
+					continue
+				}
+				val, err := jsonToValue(fd, item)
+				if err != nil {
+					return err
+				}
+				list.Append(val)
+			}
+			continue
+		}
+		if fd.Kind() == protoreflect.MessageKind {
+			if err := jsonToMessage(raw, m.Mutable(fd).Message()); err != nil {
+				return err
+			}
+			continue
+		}
+		val, err := jsonToValue(fd, raw)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, val)
+	}
+	return nil
+}
+
+func jsonToValue(fd protoreflect.FieldDescriptor, v interface{}) (protoreflect.Value, error) {
+	if fd.Kind() == protoreflect.BoolKind {
+		b, ok := v.(bool)
+		if !ok {
+			return protoreflect.Value{}, fmt.Errorf("expected JSON boolean for field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfBool(b), nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return protoreflect.Value{}, fmt.Errorf("expected JSON string for field %s", fd.FullName())
+	}
+	switch fd.Kind() {
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "could not parse field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "could not parse field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfUint64(n), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "could not parse field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "could not parse field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "could not decode field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfBytes(b), nil
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByName(protoreflect.Name(strings.ToUpper(s)))
+		if ev == nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown enum value %q for field %s", s, fd.FullName())
+		}
+		return protoreflect.ValueOfEnum(ev.Number()), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s for field %s", fd.Kind(), fd.FullName())
+	}
+}
@@ -0,0 +1,91 @@
+package apijson
+
+import (
+	"encoding/json"
+	"testing"
+
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestMarshal_StandardEncoding(t *testing.T) {
+	enc, err := Marshal(&ethpbv1.ValidatorContainer{
+		Index:   3,
+		Balance: 32000000000,
+		Status:  ethpbv1.ValidatorStatus_ACTIVE_ONGOING,
+		Validator: &ethpbv1.Validator{
+			Pubkey:  []byte{0x01, 0x02},
+			Slashed: true,
+		},
+	})
+	require.NoError(t, err)
+	var v map[string]interface{}
+	require.NoError(t, json.Unmarshal(enc, &v))
+	assert.Equal(t, "3", v["index"])
+	assert.Equal(t, "32000000000", v["balance"])
+	assert.Equal(t, "active_ongoing", v["status"])
+	validator, ok := v["validator"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, "0x0102", validator["pubkey"])
+	assert.Equal(t, true, validator["slashed"])
+}
+
+func TestMarshal_RenamedFields(t *testing.T) {
+	blk := testutil.HydrateV1SignedBeaconBlock(&ethpbv1.SignedBeaconBlock{})
+	enc, err := Marshal(blk)
+	require.NoError(t, err)
+	var v map[string]interface{}
+	require.NoError(t, json.Unmarshal(enc, &v))
+	_, ok := v["message"]
+	assert.Equal(t, true, ok)
+	_, ok = v["block"]
+	assert.Equal(t, false, ok)
+}
+
+func TestUnmarshal_RoundTrip(t *testing.T) {
+	blk := testutil.HydrateV1SignedBeaconBlock(&ethpbv1.SignedBeaconBlock{
+		Block: &ethpbv1.BeaconBlock{Slot: 12, ProposerIndex: 4},
+	})
+	enc, err := Marshal(blk)
+	require.NoError(t, err)
+	decoded := &ethpbv1.SignedBeaconBlock{}
+	require.NoError(t, Unmarshal(enc, decoded))
+	assert.DeepSSZEqual(t, blk, decoded)
+}
+
+func TestUnmarshal_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{
+			name: "integer is not a string",
+			json: `{"index":3}`,
+			want: "expected JSON string",
+		},
+		{
+			name: "invalid integer",
+			json: `{"index":"three"}`,
+			want: "could not parse field",
+		},
+		{
+			name: "invalid hex",
+			json: `{"validator":{"pubkey":"0102"}}`,
+			want: "could not decode field",
+		},
+		{
+			name: "unknown enum value",
+			json: `{"status":"retired"}`,
+			want: "unknown enum value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Unmarshal([]byte(tt.json), &ethpbv1.ValidatorContainer{})
+			assert.ErrorContains(t, tt.want, err)
+		})
+	}
+}
@@ -94,6 +94,12 @@ var (
 		Name:  "peer",
 		Usage: "Connect with this peer. This flag may be used multiple times.",
 	}
+	// TrustedPeers specifies a set of peers which are never pruned and are redialed when disconnected.
+	TrustedPeers = &cli.StringSliceFlag{
+		Name: "trusted-peer",
+		Usage: "Connect with this peer and keep it connected: a trusted peer is never pruned, is " +
+			"exempt from the inbound peer limit and is redialed when disconnected. This flag may be used multiple times.",
+	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
 		Name:  "bootstrap-node",
@@ -236,6 +242,26 @@ var (
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// DepositSnapshotOutputFileFlag specifies the filepath to which the deposit snapshot of a
+	// beacon node database is exported.
+	DepositSnapshotOutputFileFlag = &cli.StringFlag{
+		Name:  "deposit-snapshot-output-file",
+		Usage: "Filepath to which the deposit snapshot of the database is exported",
+		Value: "deposit_snapshot",
+	}
+	// ArchiveOutputFileFlag specifies the filepath to which the finalized blocks and states of a
+	// beacon node database are exported.
+	ArchiveOutputFileFlag = &cli.StringFlag{
+		Name:  "archive-output-file",
+		Usage: "Filepath to which the finalized blocks and states of the database are exported",
+		Value: "beacon_chain.archive",
+	}
+	// ArchiveSourceFileFlag specifies the filepath to the archive of finalized blocks and states
+	// which is imported into a new beacon node database.
+	ArchiveSourceFileFlag = &cli.StringFlag{
+		Name:  "archive-source-file",
+		Usage: "Filepath to the archive of finalized blocks and states which is imported into a new database",
+	}
 	// BoltMMapInitialSizeFlag specifies the initial size in bytes of boltdb's mmap syscall.
 	BoltMMapInitialSizeFlag = &cli.IntFlag{
 		Name:  "bolt-mmap-initial-size",
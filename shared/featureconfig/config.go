@@ -51,12 +51,14 @@ type Flags struct {
 	ProposerAttsSelectionUsingMaxCover bool // ProposerAttsSelectionUsingMaxCover enables max-cover algorithm when selecting attestations for proposing.
 	EnableOptimizedBalanceUpdate       bool // EnableOptimizedBalanceUpdate uses an updated method of performing balance updates.
 	EnableDoppelGanger                 bool // EnableDoppelGanger enables doppelganger protection on startup for the validator.
+	EnableDepositPruning               bool // EnableDepositPruning removes the finalized deposits covered by the deposit snapshot from the deposit cache.
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
 
 	// Slasher toggles.
 	DisableLookback           bool // DisableLookback updates slasher to not use the lookback and update validator histories until epoch 0.
 	DisableBroadcastSlashings bool // DisableBroadcastSlashings disables p2p broadcasting of proposer and attester slashings.
+	EnableSlasher             bool // EnableSlasher enables the slasher service within the beacon node.
 
 	// Cache toggles.
 	EnableSSZCache           bool // EnableSSZCache see https://github.com/prysmaticlabs/prysm/pull/4558.
@@ -199,6 +201,14 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		logDisabled(disableOptimizedBalanceUpdate)
 		cfg.EnableOptimizedBalanceUpdate = false
 	}
+	if ctx.Bool(enableSlasherFlag.Name) {
+		logEnabled(enableSlasherFlag)
+		cfg.EnableSlasher = true
+	}
+	if ctx.Bool(enableDepositPruning.Name) {
+		logEnabled(enableDepositPruning)
+		cfg.EnableDepositPruning = true
+	}
 	Init(cfg)
 }
 
@@ -122,6 +122,15 @@ var (
 		Name:  "disable-optimized-balance-update",
 		Usage: "Disable the optimized method of updating validator balances.",
 	}
+	enableSlasherFlag = &cli.BoolFlag{
+		Name:  "slasher",
+		Usage: "Enables a slasher in the beacon node for detecting slashable offenses on the network",
+	}
+	enableDepositPruning = &cli.BoolFlag{
+		Name: "enable-deposit-pruning",
+		Usage: "Enables the pruning of finalized deposits from the deposit cache, only the deposit snapshot " +
+			"is kept for them.",
+	}
 	enableDoppelGangerProtection = &cli.BoolFlag{
 		Name: "enable-doppelganger",
 		Usage: "Enables the validator to perform a doppelganger check. (Warning): This is not " +
@@ -187,6 +196,8 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	disableUpdateHeadTimely,
 	disableProposerAttsSelectionUsingMaxCover,
 	disableOptimizedBalanceUpdate,
+	enableSlasherFlag,
+	enableDepositPruning,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
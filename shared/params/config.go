@@ -58,6 +58,8 @@ type BeaconChainConfig struct {
 	Eth1FollowDistance               uint64      `yaml:"ETH1_FOLLOW_DISTANCE" spec:"true"`                // Eth1FollowDistance is the number of eth1.0 blocks to wait before considering a new deposit for voting. This only applies after the chain as been started.
 	SafeSlotsToUpdateJustified       types.Slot  `yaml:"SAFE_SLOTS_TO_UPDATE_JUSTIFIED" spec:"true"`      // SafeSlotsToUpdateJustified is the minimal slots needed to update justified check point.
 	SecondsPerETH1Block              uint64      `yaml:"SECONDS_PER_ETH1_BLOCK" spec:"true"`              // SecondsPerETH1Block is the approximate time for a single eth1 block to be produced.
+	IntervalsPerSlot                 uint64      `yaml:"INTERVALS_PER_SLOT"`                              // IntervalsPerSlot defines the number of fork choice intervals in a slot, the first of which ends at the attestation deadline.
+	ProposerScoreBoost               uint64      `yaml:"PROPOSER_SCORE_BOOST"`                            // ProposerScoreBoost defines the percentage of a committee's weight added to a timely block in fork choice.
 
 	// Ethereum PoW parameters.
 	DepositChainID         uint64 `yaml:"DEPOSIT_CHAIN_ID" spec:"true"`         // DepositChainID of the eth1 network. This used for replay protection.
@@ -114,6 +116,7 @@ type BeaconChainConfig struct {
 	DefaultPageSize             int           // DefaultPageSize defines the default page size for RPC server request.
 	MaxPeersToSync              int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
 	SlotsPerArchivedPoint       types.Slot    // SlotsPerArchivedPoint defines the number of slots per one archived point.
+	EpochsPerStateSnapshot      types.Epoch   // EpochsPerStateSnapshot defines the number of epochs between full finalized states when saving a state difference every epoch, 0 disables state differences.
 	GenesisCountdownInterval    time.Duration // How often to log the countdown until the genesis time is reached.
 	BeaconStateFieldCount       int           // BeaconStateFieldCount defines how many fields are in beacon state.
 	BeaconStateAltairFieldCount int           // BeaconStateAltairFieldCount defines how many fields are in beacon state hard fork 1.
@@ -0,0 +1,101 @@
+package trieutil
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// FinalizedHashes returns the hashes of the complete subtrees which cover the first count
+// items of the trie, ordered from the largest subtree to the smallest. Together with the
+// number of items, they are enough to rebuild a trie which can be extended with the items
+// that follow, as done by GenerateTrieFromFinalizedHashes.
+func (m *SparseMerkleTrie) FinalizedHashes(count uint64) ([][]byte, error) {
+	if count > uint64(m.NumOfItems()) {
+		return nil, fmt.Errorf("cannot finalize %d items in a trie with %d items", count, m.NumOfItems())
+	}
+	hashes := make([][]byte, 0, bits.OnesCount64(count))
+	for i := int(m.depth); i >= 0; i-- {
+		if (count>>uint(i))&1 == 0 {
+			continue
+		}
+		index := (count >> uint(i)) - 1
+		if index >= uint64(len(m.branches[i])) {
+			return nil, fmt.Errorf("missing node %d at layer %d of the trie", index, i)
+		}
+		hashes = append(hashes, bytesutil.SafeCopyBytes(m.branches[i][index]))
+	}
+	return hashes, nil
+}
+
+// GenerateTrieFromFinalizedHashes rebuilds a Merkle trie of count items from the hashes of
+// the complete subtrees covering them, as returned by FinalizedHashes. The finalized items
+// themselves are unknown, so the returned trie only provides correct proofs for the items
+// inserted after them, while its root covers all items.
+func GenerateTrieFromFinalizedHashes(finalized [][]byte, count, depth uint64) (*SparseMerkleTrie, error) {
+	if count == 0 {
+		if len(finalized) != 0 {
+			return nil, errors.New("finalized hashes provided for an empty trie")
+		}
+		return NewTrie(depth)
+	}
+	if depth >= 64 || count > uint64(1)<<depth {
+		return nil, fmt.Errorf("%d items do not fit in a trie of depth %d", count, depth)
+	}
+	if len(finalized) != bits.OnesCount64(count) {
+		return nil, fmt.Errorf("wanted %d finalized hashes for %d items, received %d", bits.OnesCount64(count), count, len(finalized))
+	}
+
+	// Assign each finalized hash to the layer of its subtree, the largest subtree comes first.
+	known := make([][]byte, depth+1)
+	next := 0
+	for i := int(depth); i >= 0; i-- {
+		if (count>>uint(i))&1 == 1 {
+			h := bytesutil.ToBytes32(finalized[next])
+			known[i] = h[:]
+			next++
+		}
+	}
+
+	layers := make([][][]byte, depth+1)
+	for i := uint64(0); i <= depth; i++ {
+		complete := count >> i
+		size := complete
+		// A partial node covers both finalized items and empty leaves.
+		if count%(uint64(1)<<i) != 0 {
+			size++
+		}
+		// The nodes fully covered by finalized subtrees are never read, except for the
+		// last one which is the sibling of the nodes covering the next items.
+		placeholder := make([]byte, 32)
+		layer := make([][]byte, size)
+		for j := uint64(0); j < complete; j++ {
+			layer[j] = placeholder
+		}
+		if complete&1 == 1 {
+			layer[complete-1] = known[i]
+		}
+		if size > complete {
+			below := layers[i-1]
+			left := below[2*complete]
+			right := ZeroHashes[i-1][:]
+			if 2*complete+1 < uint64(len(below)) {
+				right = below[2*complete+1]
+			}
+			h := hashutil.Hash(append(append([]byte{}, left...), right...))
+			layer[complete] = h[:]
+		}
+		layers[i] = layer
+	}
+
+	items := make([][]byte, count)
+	copy(items, layers[0])
+	return &SparseMerkleTrie{
+		branches:      layers,
+		originalItems: items,
+		depth:         uint(depth),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package trieutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func trieItems(t *testing.T, from, to int) [][]byte {
+	items := make([][]byte, 0, to-from)
+	for i := from; i < to; i++ {
+		h := hashutil.Hash([]byte(fmt.Sprintf("item %d", i)))
+		items = append(items, h[:])
+	}
+	return items
+}
+
+func TestGenerateTrieFromFinalizedHashes(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	for _, count := range []int{0, 1, 2, 3, 4, 5, 7, 8, 13, 16, 31} {
+		t.Run(fmt.Sprintf("%d finalized items", count), func(t *testing.T) {
+			full, err := NewTrie(depth)
+			require.NoError(t, err)
+			for i, item := range trieItems(t, 0, count) {
+				full.Insert(item, i)
+			}
+			finalized, err := full.FinalizedHashes(uint64(count))
+			require.NoError(t, err)
+			snapshot, err := GenerateTrieFromFinalizedHashes(finalized, uint64(count), depth)
+			require.NoError(t, err)
+			assert.Equal(t, full.NumOfItems(), snapshot.NumOfItems())
+			assert.Equal(t, full.HashTreeRoot(), snapshot.HashTreeRoot())
+
+			// Both tries must agree on the roots and proofs of the items inserted afterwards.
+			for i, item := range trieItems(t, count, count+10) {
+				index := count + i
+				full.Insert(item, index)
+				snapshot.Insert(item, index)
+				assert.Equal(t, full.HashTreeRoot(), snapshot.HashTreeRoot())
+				wanted, err := full.MerkleProof(index)
+				require.NoError(t, err)
+				received, err := snapshot.MerkleProof(index)
+				require.NoError(t, err)
+				assert.DeepEqual(t, wanted, received)
+			}
+
+			// Finalizing more items of the rebuilt trie gives the same hashes as for the full trie.
+			wanted, err := full.FinalizedHashes(uint64(count + 5))
+			require.NoError(t, err)
+			received, err := snapshot.FinalizedHashes(uint64(count + 5))
+			require.NoError(t, err)
+			assert.DeepEqual(t, wanted, received)
+		})
+	}
+}
+
+func TestFinalizedHashes_TooManyItems(t *testing.T) {
+	m, err := GenerateTrieFromItems(trieItems(t, 0, 3), params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	_, err = m.FinalizedHashes(4)
+	assert.ErrorContains(t, "cannot finalize 4 items in a trie with 3 items", err)
+}
+
+func TestGenerateTrieFromFinalizedHashes_InvalidHashes(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	_, err := GenerateTrieFromFinalizedHashes(trieItems(t, 0, 1), 3, depth)
+	assert.ErrorContains(t, "wanted 2 finalized hashes for 3 items, received 1", err)
+	_, err = GenerateTrieFromFinalizedHashes(trieItems(t, 0, 1), 0, depth)
+	assert.ErrorContains(t, "finalized hashes provided for an empty trie", err)
+	_, err = GenerateTrieFromFinalizedHashes(trieItems(t, 0, 1), 8, 2)
+	assert.ErrorContains(t, "8 items do not fit in a trie of depth 2", err)
+}
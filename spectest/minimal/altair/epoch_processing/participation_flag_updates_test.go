@@ -0,0 +1,11 @@
+package epoch_processing
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/spectest/shared/altair/epoch_processing"
+)
+
+func TestMinimal_Altair_EpochProcessing_ParticipationFlag(t *testing.T) {
+	epoch_processing.RunParticipationFlagUpdatesTests(t, "minimal")
+}
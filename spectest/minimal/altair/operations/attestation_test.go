@@ -0,0 +1,11 @@
+package operations
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/spectest/shared/altair/operations"
+)
+
+func TestMinimal_Altair_Operations_Attestation(t *testing.T) {
+	operations.RunAttestationTest(t, "minimal")
+}
@@ -0,0 +1,11 @@
+package operations
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/spectest/shared/altair/operations"
+)
+
+func TestMinimal_Altair_Operations_VoluntaryExit(t *testing.T) {
+	operations.RunVoluntaryExitTest(t, "minimal")
+}
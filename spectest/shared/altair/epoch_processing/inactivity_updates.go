@@ -0,0 +1,39 @@
+package epoch_processing
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/spectest/utils"
+)
+
+// RunInactivityUpdatesTest executes "epoch_processing/inactivity_updates" tests.
+func RunInactivityUpdatesTest(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "altair", "epoch_processing/inactivity_updates/pyspec_tests")
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			RunEpochOperationTest(t, folderPath, processInactivityUpdates)
+		})
+	}
+}
+
+func processInactivityUpdates(t *testing.T, st state.BeaconState) (state.BeaconState, error) {
+	ctx := context.Background()
+	vp, bp, err := altair.InitializeEpochValidators(ctx, st)
+	require.NoError(t, err)
+	vp, bp, err = altair.ProcessEpochParticipation(ctx, st, bp, vp)
+	require.NoError(t, err)
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
+	require.NoError(t, err)
+	st, _, err = altair.ProcessInactivityScores(ctx, st, vp)
+	require.NoError(t, err, "Could not process inactivity updates")
+	return st, nil
+}
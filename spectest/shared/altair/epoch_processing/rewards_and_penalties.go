@@ -0,0 +1,36 @@
+package epoch_processing
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/spectest/utils"
+)
+
+// RunRewardsAndPenaltiesTests executes "epoch_processing/rewards_and_penalties" tests.
+func RunRewardsAndPenaltiesTests(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "altair", "epoch_processing/rewards_and_penalties/pyspec_tests")
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			RunEpochOperationTest(t, folderPath, processRewardsAndPenaltiesPrecomputeWrapper)
+		})
+	}
+}
+
+func processRewardsAndPenaltiesPrecomputeWrapper(t *testing.T, st state.BeaconState) (state.BeaconState, error) {
+	ctx := context.Background()
+	vp, bp, err := altair.InitializeEpochValidators(ctx, st)
+	require.NoError(t, err)
+	vp, bp, err = altair.ProcessEpochParticipation(ctx, st, bp, vp)
+	require.NoError(t, err)
+	st, err = altair.ProcessRewardsAndPenaltiesPrecompute(st, bp, vp)
+	require.NoError(t, err, "Could not process reward")
+	return st, nil
+}
@@ -0,0 +1,59 @@
+package fork
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/spectest/utils"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/d4l3k/messagediff.v1"
+)
+
+// RunUpgradeToAltair is a helper function that runs Altair's fork spec tests.
+// It unmarshals a pre and post state to check `UpgradeToAltair` comply with spec implementation.
+func RunUpgradeToAltair(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "altair", "fork/fork/pyspec_tests")
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			preStateFile, err := testutil.BazelFileBytes(path.Join(folderPath, "pre.ssz_snappy"))
+			require.NoError(t, err)
+			preStateSSZ, err := snappy.Decode(nil /* dst */, preStateFile)
+			require.NoError(t, err, "Failed to decompress")
+			preStateBase := &statepb.BeaconState{}
+			if err := preStateBase.UnmarshalSSZ(preStateSSZ); err != nil {
+				t.Fatalf("Failed to unmarshal: %v", err)
+			}
+			preState, err := v1.InitializeFromProto(preStateBase)
+			require.NoError(t, err)
+			postState, err := altair.UpgradeToAltair(context.Background(), preState)
+			require.NoError(t, err)
+			postStateFromFunction, ok := postState.InnerStateUnsafe().(*statepb.BeaconStateAltair)
+			require.Equal(t, true, ok, "Post state is not an Altair state")
+
+			postStateFile, err := testutil.BazelFileBytes(path.Join(folderPath, "post.ssz_snappy"))
+			require.NoError(t, err)
+			postStateSSZ, err := snappy.Decode(nil /* dst */, postStateFile)
+			require.NoError(t, err, "Failed to decompress")
+			postStateFromFile := &statepb.BeaconStateAltair{}
+			if err := postStateFromFile.UnmarshalSSZ(postStateSSZ); err != nil {
+				t.Fatalf("Failed to unmarshal: %v", err)
+			}
+
+			if !proto.Equal(postStateFromFile, postStateFromFunction) {
+				diff, _ := messagediff.PrettyDiff(postStateFromFile, postStateFromFunction)
+				t.Log(diff)
+				t.Fatal("Post state does not match expected")
+			}
+		})
+	}
+}
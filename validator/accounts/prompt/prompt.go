@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -141,6 +142,38 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	baseURL := cliCtx.String(flags.Web3SignerURLFlag.Name)
+	gvr := cliCtx.String(flags.Web3SignerGenesisValidatorsRootFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if baseURL == "" {
+		baseURL, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Remote signer URL (such as http://localhost:9000)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if gvr == "" {
+		gvr, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Genesis validators root of the chain (such as 0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	newCfg := &web3signer.KeymanagerOpts{
+		BaseURL:               strings.TrimRight(baseURL, "\r\n"),
+		GenesisValidatorsRoot: strings.TrimRight(gvr, "\r\n"),
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
@@ -0,0 +1,195 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/apijson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// blockRootJson is the "data" payload of the /eth/v1/beacon/blocks/{block_id}/root endpoint.
+type blockRootJson struct {
+	Root string `json:"root"`
+}
+
+// syncCommitteeDutyJson is a single duty returned by the /eth/v1/validator/duties/sync/{epoch} endpoint.
+type syncCommitteeDutyJson struct {
+	Pubkey                        string   `json:"pubkey"`
+	ValidatorIndex                string   `json:"validator_index"`
+	ValidatorSyncCommitteeIndices []string `json:"validator_sync_committee_indices"`
+}
+
+type beaconApiAltairValidatorClient struct {
+	handler *restHandler
+}
+
+// NewAltairValidatorClient creates a validator client which performs the duties introduced by
+// the Altair fork through the standard beacon API of the beacon nodes at the given hosts, in
+// order of preference.
+func NewAltairValidatorClient(hosts []string, timeout time.Duration) validatorpb.BeaconNodeValidatorAltairClient {
+	return &beaconApiAltairValidatorClient{
+		handler: newRestHandler(hosts, timeout),
+	}
+}
+
+// GetBlock requests an unsigned Altair block for the given slot from the beacon node.
+func (c *beaconApiAltairValidatorClient) GetBlock(ctx context.Context, in *ethpb.BlockRequest, _ ...grpc.CallOption) (*validatorpb.BeaconBlockAltair, error) {
+	produced, err := produceBlock(ctx, c.handler, in)
+	if err != nil {
+		return nil, err
+	}
+	if produced.Version != altairVersion {
+		return nil, status.Errorf(codes.Unimplemented, "beacon node produced a %s block, which is not an Altair block", produced.Version)
+	}
+	blk := &validatorpb.BeaconBlockAltair{}
+	if err := apijson.Unmarshal(produced.Data, blk); err != nil {
+		return nil, errors.Wrap(err, "could not decode block")
+	}
+	return blk, nil
+}
+
+// ProposeBlock publishes a signed Altair block through the beacon node.
+func (c *beaconApiAltairValidatorClient) ProposeBlock(ctx context.Context, in *validatorpb.SignedBeaconBlockAltair, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	body, err := apijson.Marshal(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode block")
+	}
+	if err := c.handler.post(ctx, "/eth/v1/beacon/blocks", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish block")
+	}
+	root, err := in.Block.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block root")
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// GetSyncMessageBlockRoot fetches the head block root, which sync committee members sign.
+func (c *beaconApiAltairValidatorClient) GetSyncMessageBlockRoot(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*validatorpb.SyncMessageBlockRootResponse, error) {
+	root, err := c.headBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &validatorpb.SyncMessageBlockRootResponse{Root: root}, nil
+}
+
+// SubmitSyncMessage submits a sync committee message to the sync committee pool of the beacon node.
+func (c *beaconApiAltairValidatorClient) SubmitSyncMessage(ctx context.Context, in *validatorpb.SyncCommitteeMessage, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	body, err := apijson.MarshalArray(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode sync committee message")
+	}
+	if err := c.handler.post(ctx, "/eth/v1/beacon/pool/sync_committees", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit sync committee message")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSyncSubcommitteeIndex fetches the positions of the validator in the sync committee of the
+// period the requested slot belongs to. Validators outside of the committee have no positions.
+func (c *beaconApiAltairValidatorClient) GetSyncSubcommitteeIndex(ctx context.Context, in *validatorpb.SyncSubcommitteeIndexRequest, _ ...grpc.CallOption) (*validatorpb.SyncSubcommitteeIndexResponse, error) {
+	v, err := getValidator(ctx, c.handler, in.PublicKey)
+	if status.Code(err) == codes.NotFound {
+		return &validatorpb.SyncSubcommitteeIndexResponse{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator")
+	}
+	index := fmt.Sprintf("%d", v.Index)
+	body, err := json.Marshal([]string{index})
+	if err != nil {
+		return nil, err
+	}
+	res := &dataJson{}
+	epoch := helpers.SlotToEpoch(in.Slot)
+	if err := c.handler.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/sync/%d", epoch), body, res); err != nil {
+		return nil, errors.Wrapf(err, "could not get sync committee duties for epoch %d", epoch)
+	}
+	var duties []*syncCommitteeDutyJson
+	if err := json.Unmarshal(res.Data, &duties); err != nil {
+		return nil, errors.Wrap(err, "could not decode sync committee duties")
+	}
+	indices := make([]uint64, 0)
+	for _, d := range duties {
+		if d.ValidatorIndex != index {
+			continue
+		}
+		for _, idx := range d.ValidatorSyncCommitteeIndices {
+			i, err := strconv.ParseUint(idx, 10, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not parse sync committee index")
+			}
+			indices = append(indices, i)
+		}
+	}
+	return &validatorpb.SyncSubcommitteeIndexResponse{Indices: indices}, nil
+}
+
+// GetSyncCommitteeContribution fetches the aggregate of the sync committee messages of the given
+// subcommittee for the head block at the requested slot.
+func (c *beaconApiAltairValidatorClient) GetSyncCommitteeContribution(ctx context.Context, in *validatorpb.SyncCommitteeContributionRequest, _ ...grpc.CallOption) (*validatorpb.SyncCommitteeContribution, error) {
+	root, err := c.headBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("slot", fmt.Sprintf("%d", in.Slot))
+	query.Set("subcommittee_index", fmt.Sprintf("%d", in.SubnetId))
+	query.Set("beacon_block_root", hexutil.Encode(root))
+	data, err := c.handler.getData(ctx, "/eth/v1/validator/sync_committee_contribution?"+query.Encode())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not produce sync committee contribution")
+	}
+	contribution := &validatorpb.SyncCommitteeContribution{}
+	if err := apijson.Unmarshal(data, contribution); err != nil {
+		return nil, errors.Wrap(err, "could not decode sync committee contribution")
+	}
+	return contribution, nil
+}
+
+// SubmitSignedContributionAndProof publishes a signed sync committee contribution and proof
+// through the beacon node.
+func (c *beaconApiAltairValidatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *validatorpb.SignedContributionAndProof, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	body, err := apijson.MarshalArray(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode contribution and proof")
+	}
+	if err := c.handler.post(ctx, "/eth/v1/validator/contribution_and_proofs", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit contribution and proof")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// StreamBlocks is not supported, blocks are streamed by the beacon chain client instead.
+func (c *beaconApiAltairValidatorClient) StreamBlocks(_ context.Context, _ *ethpb.StreamBlocksRequest, _ ...grpc.CallOption) (validatorpb.BeaconNodeValidatorAltair_StreamBlocksClient, error) {
+	return nil, status.Error(codes.Unimplemented, "streaming Altair blocks is not supported by the beacon API client")
+}
+
+func (c *beaconApiAltairValidatorClient) headBlockRoot(ctx context.Context) ([]byte, error) {
+	data, err := c.handler.getData(ctx, "/eth/v1/beacon/blocks/head/root")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head block root")
+	}
+	r := &blockRootJson{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, errors.Wrap(err, "could not decode head block root")
+	}
+	root, err := hexutil.Decode(r.Root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode head block root")
+	}
+	return root, nil
+}
@@ -0,0 +1,136 @@
+package beaconapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/apijson"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestBeaconApiAltairValidatorClient_GetBlock(t *testing.T) {
+	blk := testutil.HydrateBeaconBlockAltair(&validatorpb.BeaconBlockAltair{Slot: 10, ProposerIndex: 3})
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v2/validator/blocks/10": func(w http.ResponseWriter, _ *http.Request) {
+			writeVersionedProtoData(t, w, altairVersion, blk)
+		},
+	})
+	c := NewAltairValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.GetBlock(context.Background(), &ethpb.BlockRequest{Slot: 10, RandaoReveal: make([]byte, 96)})
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, resp)
+}
+
+func TestBeaconApiAltairValidatorClient_GetBlock_Phase0(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v2/validator/blocks/10": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"version":"phase0","data":{"slot":"10"}}`)
+		},
+	})
+	c := NewAltairValidatorClient([]string{srv.URL}, time.Second)
+	_, err := c.GetBlock(context.Background(), &ethpb.BlockRequest{Slot: 10, RandaoReveal: make([]byte, 96)})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestBeaconApiAltairValidatorClient_ProposeBlock(t *testing.T) {
+	blk := testutil.HydrateSignedBeaconBlockAltair(&validatorpb.SignedBeaconBlockAltair{})
+	var published *validatorpb.SignedBeaconBlockAltair
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/blocks": func(w http.ResponseWriter, r *http.Request) {
+			body := readBody(t, r)
+			assert.Equal(t, true, strings.Contains(string(body), `"message":`))
+			published = &validatorpb.SignedBeaconBlockAltair{}
+			require.NoError(t, apijson.Unmarshal(body, published))
+		},
+	})
+	c := NewAltairValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.ProposeBlock(context.Background(), blk)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, published)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.BlockRoot)
+}
+
+func TestBeaconApiAltairValidatorClient_SubmitSyncMessage(t *testing.T) {
+	root, err := hexutil.Decode(testRoot)
+	require.NoError(t, err)
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/blocks/head/root": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"root":"`+testRoot+`"}}`)
+		},
+		"/eth/v1/beacon/pool/sync_committees": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, true, strings.Contains(string(readBody(t, r)), `"beacon_block_root":"`+testRoot+`"`))
+		},
+	})
+	c := NewAltairValidatorClient([]string{srv.URL}, time.Second)
+	blockRoot, err := c.GetSyncMessageBlockRoot(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, root, blockRoot.Root)
+	_, err = c.SubmitSyncMessage(context.Background(), &validatorpb.SyncCommitteeMessage{
+		Slot:           10,
+		BlockRoot:      blockRoot.Root,
+		ValidatorIndex: 3,
+		Signature:      make([]byte, 96),
+	})
+	require.NoError(t, err)
+}
+
+func TestBeaconApiAltairValidatorClient_GetSyncSubcommitteeIndex(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators/" + testPubKey1: func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"index":"3","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"`+testPubKey1+`"}}}`)
+		},
+		"/eth/v1/beacon/states/head/validators/" + testPubKey2: func(w http.ResponseWriter, _ *http.Request) {
+			writeNotFound(t, w)
+		},
+		"/eth/v1/validator/duties/sync/1": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, `["3"]`, string(readBody(t, r)))
+			writeJSON(t, w, `{"data":[{"pubkey":"`+testPubKey1+`","validator_index":"3","validator_sync_committee_indices":["7","130"]}]}`)
+		},
+	})
+	pk1, err := hexutil.Decode(testPubKey1)
+	require.NoError(t, err)
+	pk2, err := hexutil.Decode(testPubKey2)
+	require.NoError(t, err)
+	c := NewAltairValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.GetSyncSubcommitteeIndex(context.Background(), &validatorpb.SyncSubcommitteeIndexRequest{PublicKey: pk1, Slot: 40})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{7, 130}, resp.Indices)
+
+	resp, err = c.GetSyncSubcommitteeIndex(context.Background(), &validatorpb.SyncSubcommitteeIndexRequest{PublicKey: pk2, Slot: 40})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(resp.Indices))
+}
+
+func TestBeaconApiAltairValidatorClient_GetSyncCommitteeContribution(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/blocks/head/root": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"root":"`+testRoot+`"}}`)
+		},
+		"/eth/v1/validator/sync_committee_contribution": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "10", r.URL.Query().Get("slot"))
+			assert.Equal(t, "2", r.URL.Query().Get("subcommittee_index"))
+			assert.Equal(t, testRoot, r.URL.Query().Get("beacon_block_root"))
+			writeJSON(t, w, fmt.Sprintf(`{"data":{"slot":"10","beacon_block_root":"%s","subcommittee_index":"2","aggregation_bits":"0x01000000000000000000000000000000","signature":"%#x"}}`, testRoot, make([]byte, 96)))
+		},
+	})
+	c := NewAltairValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.GetSyncCommitteeContribution(context.Background(), &validatorpb.SyncCommitteeContributionRequest{Slot: 10, SubnetId: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), resp.SubcommitteeIndex)
+	assert.Equal(t, testRoot, hexutil.Encode(resp.BlockRoot))
+}
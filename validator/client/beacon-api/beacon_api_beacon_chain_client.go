@@ -0,0 +1,141 @@
+package beaconapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/apijson"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// blockTopic is the event stream topic of blocks imported by the beacon node.
+const blockTopic = "block"
+
+type beaconApiBeaconChainClient struct {
+	handler *restHandler
+}
+
+// NewBeaconChainClient creates a beacon chain client which queries the standard beacon API
+// of the beacon nodes at the given hosts, in order of preference.
+func NewBeaconChainClient(hosts []string, timeout time.Duration) iface.BeaconChainClient {
+	return &beaconApiBeaconChainClient{
+		handler: newRestHandler(hosts, timeout),
+	}
+}
+
+// GetChainHead fetches the head block and the finality checkpoints of the head state.
+func (c *beaconApiBeaconChainClient) GetChainHead(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	header := &ethpbv1.BlockHeaderResponse{}
+	if err := getProto(ctx, c.handler, "/eth/v1/beacon/headers/head", header); err != nil {
+		return nil, errors.Wrap(err, "could not get head block header")
+	}
+	if header.Data == nil || header.Data.Header == nil || header.Data.Header.Message == nil {
+		return nil, errors.New("beacon node returned no head block header")
+	}
+	finality := &ethpbv1.StateFinalityCheckpointResponse{}
+	if err := getProto(ctx, c.handler, "/eth/v1/beacon/states/head/finality_checkpoints", finality); err != nil {
+		return nil, errors.Wrap(err, "could not get finality checkpoints")
+	}
+	checkpoints := finality.Data
+	if checkpoints == nil || checkpoints.Finalized == nil || checkpoints.CurrentJustified == nil || checkpoints.PreviousJustified == nil {
+		return nil, errors.New("beacon node returned no finality checkpoints")
+	}
+	finalizedSlot, err := helpers.StartSlot(checkpoints.Finalized.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	justifiedSlot, err := helpers.StartSlot(checkpoints.CurrentJustified.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	prevJustifiedSlot, err := helpers.StartSlot(checkpoints.PreviousJustified.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	headSlot := header.Data.Header.Message.Slot
+	return &ethpb.ChainHead{
+		HeadSlot:                   headSlot,
+		HeadEpoch:                  helpers.SlotToEpoch(headSlot),
+		HeadBlockRoot:              header.Data.Root,
+		FinalizedSlot:              finalizedSlot,
+		FinalizedEpoch:             checkpoints.Finalized.Epoch,
+		FinalizedBlockRoot:         checkpoints.Finalized.Root,
+		JustifiedSlot:              justifiedSlot,
+		JustifiedEpoch:             checkpoints.CurrentJustified.Epoch,
+		JustifiedBlockRoot:         checkpoints.CurrentJustified.Root,
+		PreviousJustifiedSlot:      prevJustifiedSlot,
+		PreviousJustifiedEpoch:     checkpoints.PreviousJustified.Epoch,
+		PreviousJustifiedBlockRoot: checkpoints.PreviousJustified.Root,
+	}, nil
+}
+
+// StreamBlocks subscribes to the block events of the beacon node and returns a stream of the
+// imported blocks. The beacon node only emits block events for blocks it has verified, so
+// VerifiedOnly is always honored.
+func (c *beaconApiBeaconChainClient) StreamBlocks(ctx context.Context, _ *ethpb.StreamBlocksRequest, _ ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error) {
+	events, err := c.handler.subscribeEvents(ctx, blockTopic)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to block events")
+	}
+	go func() {
+		<-ctx.Done()
+		if err := events.close(); err != nil {
+			log.WithError(err).Debug("Could not close block event stream")
+		}
+	}()
+	return &blockStream{
+		clientStream: clientStream{ctx: ctx},
+		handler:      c.handler,
+		events:       events,
+	}, nil
+}
+
+// GetValidatorPerformance is not supported, as the standard beacon API has no equivalent endpoint.
+func (c *beaconApiBeaconChainClient) GetValidatorPerformance(_ context.Context, _ *ethpb.ValidatorPerformanceRequest, _ ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "validator performance is not supported by the beacon API client")
+}
+
+// blockStream receives block events and fetches the corresponding blocks.
+type blockStream struct {
+	clientStream
+	handler *restHandler
+	events  *eventReader
+}
+
+// Recv blocks until the next block event is received and returns the announced block.
+func (s *blockStream) Recv() (*ethpb.SignedBeaconBlock, error) {
+	for {
+		e, err := s.events.next()
+		if err != nil {
+			return nil, err
+		}
+		if e.name != blockTopic {
+			continue
+		}
+		blockEvent := &ethpbv1.EventBlock{}
+		if err := apijson.Unmarshal(e.data, blockEvent); err != nil {
+			return nil, errors.Wrap(err, "could not decode block event")
+		}
+		resp := &ethpbv1.BlockResponse{}
+		if err := getProto(s.ctx, s.handler, "/eth/v1/beacon/blocks/"+hexutil.Encode(blockEvent.Block), resp); err != nil {
+			return nil, errors.Wrapf(err, "could not get block %#x", blockEvent.Block)
+		}
+		if resp.Data == nil {
+			return nil, errors.Errorf("beacon node returned no block %#x", blockEvent.Block)
+		}
+		return migration.V1ToV1Alpha1SignedBlock(&ethpbv1.SignedBeaconBlock{
+			Block:     resp.Data.Message,
+			Signature: resp.Data.Signature,
+		})
+	}
+}
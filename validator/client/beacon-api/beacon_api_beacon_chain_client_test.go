@@ -0,0 +1,75 @@
+package beaconapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestBeaconApiBeaconChainClient_GetChainHead(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/headers/head": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"root":"`+testRoot+`","canonical":true,"header":{"message":{"slot":"100","proposer_index":"1","parent_root":"`+testRoot+`","state_root":"`+testRoot+`","body_root":"`+testRoot+`"},"signature":"0x"}}}`)
+		},
+		"/eth/v1/beacon/states/head/finality_checkpoints": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"previous_justified":{"epoch":"1","root":"`+testRoot+`"},"current_justified":{"epoch":"2","root":"`+testRoot+`"},"finalized":{"epoch":"1","root":"`+testRoot+`"}}}`)
+		},
+	})
+	c := NewBeaconChainClient([]string{srv.URL}, time.Second)
+	head, err := c.GetChainHead(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(100), head.HeadSlot)
+	assert.Equal(t, helpers.SlotToEpoch(100), head.HeadEpoch)
+	assert.Equal(t, testRoot, fmt.Sprintf("%#x", head.HeadBlockRoot))
+	assert.Equal(t, types.Epoch(1), head.FinalizedEpoch)
+	finalizedSlot, err := helpers.StartSlot(1)
+	require.NoError(t, err)
+	assert.Equal(t, finalizedSlot, head.FinalizedSlot)
+	assert.Equal(t, types.Epoch(2), head.JustifiedEpoch)
+	assert.Equal(t, types.Epoch(1), head.PreviousJustifiedEpoch)
+}
+
+func TestBeaconApiBeaconChainClient_StreamBlocks(t *testing.T) {
+	blk := testutil.HydrateV1BeaconBlock(&ethpbv1.BeaconBlock{Slot: 7})
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, blockTopic, r.URL.Query().Get("topics"))
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, err := fmt.Fprint(w, ": keep-alive\n\nevent: head\ndata: {}\n\nevent: block\ndata: {\"slot\":\"7\",\"block\":\""+testRoot+"\"}\n\n")
+			require.NoError(t, err)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		},
+		"/eth/v1/beacon/blocks/" + testRoot: func(w http.ResponseWriter, _ *http.Request) {
+			writeProtoData(t, w, &ethpbv1.BeaconBlockContainer{Message: blk, Signature: make([]byte, 96)})
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := NewBeaconChainClient([]string{srv.URL}, time.Second)
+	stream, err := c.StreamBlocks(ctx, &ethpb.StreamBlocksRequest{VerifiedOnly: true})
+	require.NoError(t, err)
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(7), res.Block.Slot)
+	assert.DeepEqual(t, make([]byte, 96), res.Signature)
+}
+
+func TestBeaconApiBeaconChainClient_GetValidatorPerformance(t *testing.T) {
+	c := NewBeaconChainClient([]string{"http://127.0.0.1:0"}, time.Second)
+	_, err := c.GetValidatorPerformance(context.Background(), &ethpb.ValidatorPerformanceRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
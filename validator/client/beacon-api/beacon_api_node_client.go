@@ -0,0 +1,78 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// syncingJson is the "data" payload of the /eth/v1/node/syncing endpoint.
+type syncingJson struct {
+	HeadSlot     string `json:"head_slot"`
+	SyncDistance string `json:"sync_distance"`
+	IsSyncing    bool   `json:"is_syncing"`
+}
+
+// depositContractJson is the "data" payload of the /eth/v1/config/deposit_contract endpoint.
+type depositContractJson struct {
+	ChainId string `json:"chain_id"`
+	Address string `json:"address"`
+}
+
+type beaconApiNodeClient struct {
+	handler *restHandler
+}
+
+// NewNodeClient creates a node client which queries the standard beacon API of the beacon
+// nodes at the given hosts, in order of preference.
+func NewNodeClient(hosts []string, timeout time.Duration) iface.NodeClient {
+	return &beaconApiNodeClient{
+		handler: newRestHandler(hosts, timeout),
+	}
+}
+
+// GetSyncStatus fetches whether the beacon node is currently syncing.
+func (c *beaconApiNodeClient) GetSyncStatus(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	data, err := c.handler.getData(ctx, "/eth/v1/node/syncing")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync status")
+	}
+	s := &syncingJson{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, errors.Wrap(err, "could not decode sync status")
+	}
+	return &ethpb.SyncStatus{Syncing: s.IsSyncing}, nil
+}
+
+// GetGenesis fetches the genesis information and the deposit contract of the beacon chain.
+func (c *beaconApiNodeClient) GetGenesis(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	g, err := getGenesis(ctx, c.handler)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	data, err := c.handler.getData(ctx, "/eth/v1/config/deposit_contract")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get deposit contract")
+	}
+	depositContract := &depositContractJson{}
+	if err := json.Unmarshal(data, depositContract); err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit contract")
+	}
+	address, err := hexutil.Decode(depositContract.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit contract address")
+	}
+	return &ethpb.Genesis{
+		GenesisTime:            timestamppb.New(time.Unix(int64(g.time), 0)),
+		DepositContractAddress: address,
+		GenesisValidatorsRoot:  g.validatorsRoot,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package beaconapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestBeaconApiNodeClient_GetSyncStatus(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/node/syncing": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"head_slot":"10","sync_distance":"5","is_syncing":true}}`)
+		},
+	})
+	c := NewNodeClient([]string{srv.URL}, time.Second)
+	resp, err := c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Syncing)
+}
+
+func TestBeaconApiNodeClient_GetGenesis(t *testing.T) {
+	address := "0x00000000219ab540356cbb839cbe05303d7705fa"
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": genesisHandler(t),
+		"/eth/v1/config/deposit_contract": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"chain_id":"1","address":"`+address+`"}}`)
+		},
+	})
+	c := NewNodeClient([]string{srv.URL}, time.Second)
+	resp, err := c.GetGenesis(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1606824023), resp.GenesisTime.Seconds)
+	assert.Equal(t, address, fmt.Sprintf("%#x", resp.DepositContractAddress))
+	assert.Equal(t, testRoot, fmt.Sprintf("%#x", resp.GenesisValidatorsRoot))
+}
+
+func TestBeaconApiNodeClient_Unavailable(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/node/syncing": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			writeJSON(t, w, `{"code":503,"message":"beacon node is starting"}`)
+		},
+	})
+	c := NewNodeClient([]string{srv.URL}, time.Second)
+	_, err := c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	assert.ErrorContains(t, "beacon node is starting", err)
+
+	srv.Close()
+	_, err = c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	assert.Equal(t, codes.Unavailable, status.Code(errors.Cause(err)))
+}
+
+func TestBeaconApiNodeClient_FailsOver(t *testing.T) {
+	primaryCalls := 0
+	primary := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/node/syncing": func(w http.ResponseWriter, _ *http.Request) {
+			primaryCalls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			writeJSON(t, w, `{"code":503,"message":"beacon node is starting"}`)
+		},
+	})
+	fallbackCalls := 0
+	fallback := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/node/syncing": func(w http.ResponseWriter, _ *http.Request) {
+			fallbackCalls++
+			writeJSON(t, w, `{"data":{"head_slot":"10","sync_distance":"0","is_syncing":false}}`)
+		},
+	})
+	c := NewNodeClient([]string{primary.URL, fallback.URL}, time.Second)
+	resp, err := c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, resp.Syncing)
+	assert.Equal(t, 1, primaryCalls)
+	assert.Equal(t, 1, fallbackCalls)
+
+	// The fallback stays active for the following requests.
+	_, err = c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, primaryCalls)
+	assert.Equal(t, 2, fallbackCalls)
+
+	// Every endpoint being unavailable is reported as such.
+	fallback.Close()
+	_, err = c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	assert.Equal(t, codes.Unavailable, status.Code(errors.Cause(err)))
+	assert.Equal(t, 2, primaryCalls)
+}
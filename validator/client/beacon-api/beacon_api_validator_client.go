@@ -0,0 +1,694 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/apijson"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// nonexistentIndex is the validator index reported for validators unknown to the beacon node.
+const nonexistentIndex = types.ValidatorIndex(^uint64(0))
+
+// Fork version names used by the standard beacon API to tag versioned responses.
+const (
+	phase0Version = "phase0"
+	altairVersion = "altair"
+)
+
+// livenessJson is a single entry returned by the /eth/v1/validator/liveness/{epoch} endpoint.
+type livenessJson struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
+
+// produceBlockJson is the response of the /eth/v2/validator/blocks/{slot} endpoint.
+type produceBlockJson struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type beaconApiValidatorClient struct {
+	handler      *restHandler
+	pollInterval time.Duration
+	// attesterDuties holds the attester duties of the last GetDuties call, grouped by slot and
+	// committee index. The standard beacon API expects committee subscriptions per validator,
+	// while the validator client only subscribes per slot and committee.
+	attesterDuties     map[types.Slot]map[types.CommitteeIndex][]*ethpbv1.AttesterDuty
+	attesterDutiesLock sync.RWMutex
+}
+
+// NewValidatorClient creates a validator client which performs its duties through the
+// standard beacon API of the beacon nodes at the given hosts, in order of preference.
+func NewValidatorClient(hosts []string, timeout time.Duration) iface.ValidatorClient {
+	return &beaconApiValidatorClient{
+		handler:        newRestHandler(hosts, timeout),
+		pollInterval:   time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+		attesterDuties: make(map[types.Slot]map[types.CommitteeIndex][]*ethpbv1.AttesterDuty),
+	}
+}
+
+// GetDuties fetches the attester and proposer duties of the requested validators for the
+// requested epoch and the next one.
+func (c *beaconApiValidatorClient) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	validators, err := getValidators(ctx, c.handler, pubKeyIDs(in.PublicKeys))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validators")
+	}
+	byPubKey := make(map[[48]byte]*ethpbv1.ValidatorContainer, len(validators))
+	indices := make([]types.ValidatorIndex, 0, len(validators))
+	for _, v := range validators {
+		if v.Validator == nil {
+			continue
+		}
+		byPubKey[bytesutil.ToBytes48(v.Validator.Pubkey)] = v
+		indices = append(indices, v.Index)
+	}
+
+	currentAttesterDuties, err := c.getAttesterDuties(ctx, in.Epoch, indices)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get attester duties for epoch %d", in.Epoch)
+	}
+	nextAttesterDuties, err := c.getAttesterDuties(ctx, in.Epoch+1, indices)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get attester duties for epoch %d", in.Epoch+1)
+	}
+	// Proposer duties are only guaranteed to be available for the current epoch.
+	proposerSlots := make(map[types.ValidatorIndex][]types.Slot)
+	if len(indices) > 0 {
+		proposerDuties := &ethpbv1.ProposerDutiesResponse{}
+		if err := getProto(ctx, c.handler, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", in.Epoch), proposerDuties); err != nil {
+			return nil, errors.Wrapf(err, "could not get proposer duties for epoch %d", in.Epoch)
+		}
+		for _, d := range proposerDuties.Data {
+			proposerSlots[d.ValidatorIndex] = append(proposerSlots[d.ValidatorIndex], d.Slot)
+		}
+	}
+
+	committees := make(map[[2]uint64][]types.ValidatorIndex)
+	currentDuties, err := c.buildDuties(ctx, in.PublicKeys, byPubKey, currentAttesterDuties, proposerSlots, committees)
+	if err != nil {
+		return nil, err
+	}
+	nextDuties, err := c.buildDuties(ctx, in.PublicKeys, byPubKey, nextAttesterDuties, nil, committees)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cacheAttesterDuties(append(currentAttesterDuties, nextAttesterDuties...))
+	return &ethpb.DutiesResponse{
+		Duties:             currentDuties,
+		CurrentEpochDuties: currentDuties,
+		NextEpochDuties:    nextDuties,
+	}, nil
+}
+
+// DomainData computes the signature domain for the given epoch and domain type, using the
+// fork of the head state.
+func (c *beaconApiValidatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest, _ ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	g, err := getGenesis(ctx, c.handler)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	fork := &ethpbv1.StateForkResponse{}
+	if err := getProto(ctx, c.handler, "/eth/v1/beacon/states/head/fork", fork); err != nil {
+		return nil, errors.Wrap(err, "could not get head fork")
+	}
+	if fork.Data == nil {
+		return nil, errors.New("beacon node returned no fork")
+	}
+	domain, err := helpers.Domain(&statepb.Fork{
+		PreviousVersion: fork.Data.PreviousVersion,
+		CurrentVersion:  fork.Data.CurrentVersion,
+		Epoch:           fork.Data.Epoch,
+	}, in.Epoch, bytesutil.ToBytes4(in.Domain), g.validatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.DomainResponse{SignatureDomain: domain}, nil
+}
+
+// WaitForChainStart returns a stream which polls the beacon node until it knows about genesis.
+func (c *beaconApiValidatorClient) WaitForChainStart(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &waitForChainStartStream{
+		clientStream: clientStream{ctx: ctx},
+		handler:      c.handler,
+		pollInterval: c.pollInterval,
+	}, nil
+}
+
+// WaitForActivation returns a stream which reports the statuses of the requested validators
+// immediately, and polls for them once per slot afterwards.
+func (c *beaconApiValidatorClient) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &waitForActivationStream{
+		clientStream: clientStream{ctx: ctx},
+		handler:      c.handler,
+		pollInterval: c.pollInterval,
+		pubKeys:      in.PublicKeys,
+	}, nil
+}
+
+// ValidatorIndex fetches the index of the validator with the given public key.
+func (c *beaconApiValidatorClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, _ ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	v, err := c.getValidator(ctx, in.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.ValidatorIndexResponse{Index: v.Index}, nil
+}
+
+// ValidatorStatus fetches the status of the validator with the given public key.
+func (c *beaconApiValidatorClient) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.ValidatorStatusResponse, error) {
+	v, err := c.getValidator(ctx, in.PublicKey)
+	if status.Code(err) == codes.NotFound {
+		return &ethpb.ValidatorStatusResponse{Status: ethpb.ValidatorStatus_UNKNOWN_STATUS}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return validatorStatusResponse(v), nil
+}
+
+// MultipleValidatorStatus fetches the statuses of the validators with the given public keys
+// or indices. Validators unknown to the beacon node are omitted from the response.
+func (c *beaconApiValidatorClient) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+	ids := pubKeyIDs(in.PublicKeys)
+	for _, idx := range in.Indices {
+		ids = append(ids, strconv.FormatInt(idx, 10))
+	}
+	validators, err := getValidators(ctx, c.handler, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validators")
+	}
+	res := &ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: make([][]byte, 0, len(validators)),
+		Statuses:   make([]*ethpb.ValidatorStatusResponse, 0, len(validators)),
+		Indices:    make([]types.ValidatorIndex, 0, len(validators)),
+	}
+	for _, v := range validators {
+		if v.Validator == nil {
+			continue
+		}
+		res.PublicKeys = append(res.PublicKeys, v.Validator.Pubkey)
+		res.Statuses = append(res.Statuses, validatorStatusResponse(v))
+		res.Indices = append(res.Indices, v.Index)
+	}
+	return res, nil
+}
+
+// GetBlock requests an unsigned block for the given slot from the beacon node. Only phase 0
+// blocks can be returned, Altair blocks are produced through the Altair validator client.
+func (c *beaconApiValidatorClient) GetBlock(ctx context.Context, in *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	produced, err := produceBlock(ctx, c.handler, in)
+	if err != nil {
+		return nil, err
+	}
+	if produced.Version != phase0Version {
+		return nil, status.Errorf(codes.Unimplemented, "beacon node produced a %s block, which is not a phase 0 block", produced.Version)
+	}
+	blk := &ethpbv1.BeaconBlock{}
+	if err := apijson.Unmarshal(produced.Data, blk); err != nil {
+		return nil, errors.Wrap(err, "could not decode block")
+	}
+	signed, err := migration.V1ToV1Alpha1SignedBlock(&ethpbv1.SignedBeaconBlock{Block: blk})
+	if err != nil {
+		return nil, err
+	}
+	return signed.Block, nil
+}
+
+// ProposeBlock publishes a signed block through the beacon node.
+func (c *beaconApiValidatorClient) ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	blk, err := migration.V1Alpha1ToV1SignedBlock(in)
+	if err != nil {
+		return nil, err
+	}
+	body, err := apijson.Marshal(blk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode block")
+	}
+	if err := c.handler.post(ctx, "/eth/v1/beacon/blocks", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish block")
+	}
+	root, err := in.Block.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block root")
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// GetAttestationData requests the attestation data for the given slot and committee.
+func (c *beaconApiValidatorClient) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	query := url.Values{}
+	query.Set("slot", fmt.Sprintf("%d", in.Slot))
+	query.Set("committee_index", fmt.Sprintf("%d", in.CommitteeIndex))
+	resp := &ethpbv1.ProduceAttestationDataResponse{}
+	if err := getProto(ctx, c.handler, "/eth/v1/validator/attestation_data?"+query.Encode(), resp); err != nil {
+		return nil, errors.Wrap(err, "could not produce attestation data")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon node returned no attestation data")
+	}
+	return migration.V1AttDataToV1Alpha1(resp.Data), nil
+}
+
+// ProposeAttestation submits a signed attestation to the attestation pool of the beacon node.
+func (c *beaconApiValidatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	body, err := apijson.MarshalArray(migration.V1Alpha1AttestationToV1(in))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode attestation")
+	}
+	if err := c.handler.post(ctx, "/eth/v1/beacon/pool/attestations", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit attestation")
+	}
+	root, err := in.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	return &ethpb.AttestResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubmitAggregateSelectionProof fetches the aggregate attestation for the given slot and
+// committee, and wraps it into an aggregate and proof of the requesting validator.
+func (c *beaconApiValidatorClient) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, _ ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error) {
+	v, err := c.getValidator(ctx, in.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get aggregator index")
+	}
+	data, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: in.Slot, CommitteeIndex: in.CommitteeIndex})
+	if err != nil {
+		return nil, err
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	query := url.Values{}
+	query.Set("attestation_data_root", hexutil.Encode(root[:]))
+	query.Set("slot", fmt.Sprintf("%d", in.Slot))
+	resp := &ethpbv1.AggregateAttestationResponse{}
+	if err := getProto(ctx, c.handler, "/eth/v1/validator/aggregate_attestation?"+query.Encode(), resp); err != nil {
+		return nil, errors.Wrap(err, "could not get aggregate attestation")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon node returned no aggregate attestation")
+	}
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: v.Index,
+			Aggregate:       migration.V1AttToV1Alpha1(resp.Data),
+			SelectionProof:  in.SlotSignature,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof publishes a signed aggregate and proof through the beacon node.
+func (c *beaconApiValidatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, _ ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error) {
+	signed := in.SignedAggregateAndProof
+	if signed == nil || signed.Message == nil || signed.Message.Aggregate == nil {
+		return nil, errors.New("signed aggregate and proof is empty")
+	}
+	body, err := apijson.MarshalArray(&ethpbv1.SignedAggregateAttestationAndProof{
+		Message:   migration.V1Alpha1AggregateAttAndProofToV1(signed.Message),
+		Signature: signed.Signature,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode aggregate and proof")
+	}
+	if err := c.handler.post(ctx, "/eth/v1/validator/aggregate_and_proofs", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit aggregate and proof")
+	}
+	root, err := signed.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// ProposeExit submits a signed voluntary exit to the exit pool of the beacon node.
+func (c *beaconApiValidatorClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	body, err := apijson.Marshal(migration.V1Alpha1ExitToV1(in))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode voluntary exit")
+	}
+	if err := c.handler.post(ctx, "/eth/v1/beacon/pool/voluntary_exits", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit voluntary exit")
+	}
+	root, err := in.Exit.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute exit root")
+	}
+	return &ethpb.ProposeExitResponse{ExitRoot: root[:]}, nil
+}
+
+// SubscribeCommitteeSubnets subscribes the beacon node to the attestation subnets of the given
+// committees, on behalf of every validator with an attester duty in them.
+func (c *beaconApiValidatorClient) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if len(in.Slots) != len(in.CommitteeIds) || len(in.Slots) != len(in.IsAggregator) {
+		return nil, errors.New("slots, committee ids and aggregator flags must have the same length")
+	}
+	c.attesterDutiesLock.RLock()
+	subscriptions := make([]*ethpbv1.BeaconCommitteeSubscribe, 0, len(in.Slots))
+	for i, slot := range in.Slots {
+		for _, d := range c.attesterDuties[slot][in.CommitteeIds[i]] {
+			subscriptions = append(subscriptions, &ethpbv1.BeaconCommitteeSubscribe{
+				ValidatorIndex:   d.ValidatorIndex,
+				CommitteeIndex:   d.CommitteeIndex,
+				CommitteesAtSlot: d.CommitteesAtSlot,
+				Slot:             d.Slot,
+				IsAggregator:     in.IsAggregator[i],
+			})
+		}
+	}
+	c.attesterDutiesLock.RUnlock()
+	if len(subscriptions) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+
+	msgs := make([]proto.Message, len(subscriptions))
+	for i, s := range subscriptions {
+		msgs[i] = s
+	}
+	body, err := apijson.MarshalArray(msgs...)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode committee subscriptions")
+	}
+	if err := c.handler.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to committee subnets")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CheckDoppelGanger checks whether the requested validators were live in the current or the
+// previous epoch according to the liveness endpoint of the beacon node. Validators which signed
+// attestations themselves in that time frame cannot be checked, and are reported as having no
+// duplicate. Validators unknown to the beacon node are omitted from the response.
+func (c *beaconApiValidatorClient) CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest, _ ...grpc.CallOption) (*ethpb.DoppelGangerResponse, error) {
+	resp := &ethpb.DoppelGangerResponse{
+		Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{},
+	}
+	if in == nil || len(in.ValidatorRequests) == 0 {
+		return resp, nil
+	}
+	g, err := getGenesis(ctx, c.handler)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	currEpoch := helpers.SlotToEpoch(helpers.CurrentSlot(g.time))
+	prevEpoch, err := currEpoch.SafeSub(1)
+	if err != nil {
+		prevEpoch = currEpoch
+	}
+
+	pubKeys := make([][]byte, len(in.ValidatorRequests))
+	for i, req := range in.ValidatorRequests {
+		pubKeys[i] = req.PublicKey
+	}
+	validators, err := getValidators(ctx, c.handler, pubKeyIDs(pubKeys))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validators")
+	}
+	indices := make(map[[48]byte]types.ValidatorIndex, len(validators))
+	for _, v := range validators {
+		if v.Validator != nil {
+			indices[bytesutil.ToBytes48(v.Validator.Pubkey)] = v.Index
+		}
+	}
+
+	toCheck := make([]types.ValidatorIndex, 0, len(in.ValidatorRequests))
+	for _, req := range in.ValidatorRequests {
+		idx, ok := indices[bytesutil.ToBytes48(req.PublicKey)]
+		// Our own recent attestations would make the validator appear live.
+		if ok && req.Epoch < prevEpoch {
+			toCheck = append(toCheck, idx)
+		}
+	}
+	live := make(map[types.ValidatorIndex]bool)
+	for _, epoch := range []types.Epoch{prevEpoch, currEpoch} {
+		if err := c.getLiveness(ctx, epoch, toCheck, live); err != nil {
+			return nil, errors.Wrapf(err, "could not get liveness for epoch %d", epoch)
+		}
+	}
+
+	for _, req := range in.ValidatorRequests {
+		idx, ok := indices[bytesutil.ToBytes48(req.PublicKey)]
+		if !ok {
+			continue
+		}
+		resp.Responses = append(resp.Responses, &ethpb.DoppelGangerResponse_ValidatorResponse{
+			PublicKey:       req.PublicKey,
+			DuplicateExists: req.Epoch < prevEpoch && live[idx],
+		})
+	}
+	return resp, nil
+}
+
+// getLiveness marks the given validators which were live in the given epoch.
+func (c *beaconApiValidatorClient) getLiveness(
+	ctx context.Context,
+	epoch types.Epoch,
+	indices []types.ValidatorIndex,
+	live map[types.ValidatorIndex]bool,
+) error {
+	if len(indices) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(indexIDs(indices))
+	if err != nil {
+		return err
+	}
+	res := &dataJson{}
+	if err := c.handler.post(ctx, fmt.Sprintf("/eth/v1/validator/liveness/%d", epoch), body, res); err != nil {
+		return err
+	}
+	var liveness []*livenessJson
+	if err := json.Unmarshal(res.Data, &liveness); err != nil {
+		return errors.Wrap(err, "could not decode liveness")
+	}
+	for _, l := range liveness {
+		idx, err := strconv.ParseUint(l.Index, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "could not parse validator index")
+		}
+		if l.IsLive {
+			live[types.ValidatorIndex(idx)] = true
+		}
+	}
+	return nil
+}
+
+func (c *beaconApiValidatorClient) getValidator(ctx context.Context, pubKey []byte) (*ethpbv1.ValidatorContainer, error) {
+	return getValidator(ctx, c.handler, pubKey)
+}
+
+func (c *beaconApiValidatorClient) getAttesterDuties(ctx context.Context, epoch types.Epoch, indices []types.ValidatorIndex) ([]*ethpbv1.AttesterDuty, error) {
+	if len(indices) == 0 {
+		return nil, nil
+	}
+	body, err := json.Marshal(indexIDs(indices))
+	if err != nil {
+		return nil, err
+	}
+	var raw json.RawMessage
+	if err := c.handler.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch), body, &raw); err != nil {
+		return nil, err
+	}
+	resp := &ethpbv1.AttesterDutiesResponse{}
+	if err := apijson.Unmarshal(raw, resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode attester duties")
+	}
+	return resp.Data, nil
+}
+
+// getCommittee fetches the validators of the given committee, caching them in committees.
+func (c *beaconApiValidatorClient) getCommittee(
+	ctx context.Context,
+	slot types.Slot,
+	committeeIndex types.CommitteeIndex,
+	committees map[[2]uint64][]types.ValidatorIndex,
+) ([]types.ValidatorIndex, error) {
+	key := [2]uint64{uint64(slot), uint64(committeeIndex)}
+	if committee, ok := committees[key]; ok {
+		return committee, nil
+	}
+	query := url.Values{}
+	query.Set("epoch", fmt.Sprintf("%d", helpers.SlotToEpoch(slot)))
+	query.Set("index", fmt.Sprintf("%d", committeeIndex))
+	query.Set("slot", fmt.Sprintf("%d", slot))
+	resp := &ethpbv1.StateCommitteesResponse{}
+	if err := getProto(ctx, c.handler, "/eth/v1/beacon/states/head/committees?"+query.Encode(), resp); err != nil {
+		return nil, errors.Wrapf(err, "could not get committee %d at slot %d", committeeIndex, slot)
+	}
+	for _, committee := range resp.Data {
+		if committee.Slot == slot && committee.Index == committeeIndex {
+			committees[key] = committee.Validators
+			return committee.Validators, nil
+		}
+	}
+	return nil, fmt.Errorf("beacon node returned no committee %d at slot %d", committeeIndex, slot)
+}
+
+// buildDuties converts standard beacon API duties into a duty for every requested public key.
+func (c *beaconApiValidatorClient) buildDuties(
+	ctx context.Context,
+	pubKeys [][]byte,
+	validators map[[48]byte]*ethpbv1.ValidatorContainer,
+	attesterDuties []*ethpbv1.AttesterDuty,
+	proposerSlots map[types.ValidatorIndex][]types.Slot,
+	committees map[[2]uint64][]types.ValidatorIndex,
+) ([]*ethpb.DutiesResponse_Duty, error) {
+	attesterDutyByIndex := make(map[types.ValidatorIndex]*ethpbv1.AttesterDuty, len(attesterDuties))
+	for _, d := range attesterDuties {
+		attesterDutyByIndex[d.ValidatorIndex] = d
+	}
+	duties := make([]*ethpb.DutiesResponse_Duty, 0, len(pubKeys))
+	for _, pk := range pubKeys {
+		v, ok := validators[bytesutil.ToBytes48(pk)]
+		if !ok {
+			duties = append(duties, &ethpb.DutiesResponse_Duty{
+				PublicKey:      pk,
+				Status:         ethpb.ValidatorStatus_UNKNOWN_STATUS,
+				ValidatorIndex: nonexistentIndex,
+			})
+			continue
+		}
+		duty := &ethpb.DutiesResponse_Duty{
+			PublicKey:      pk,
+			Status:         validatorStatus(v.Status),
+			ValidatorIndex: v.Index,
+			ProposerSlots:  proposerSlots[v.Index],
+		}
+		if d, ok := attesterDutyByIndex[v.Index]; ok {
+			committee, err := c.getCommittee(ctx, d.Slot, d.CommitteeIndex, committees)
+			if err != nil {
+				return nil, err
+			}
+			duty.AttesterSlot = d.Slot
+			duty.CommitteeIndex = d.CommitteeIndex
+			duty.Committee = committee
+		}
+		duties = append(duties, duty)
+	}
+	return duties, nil
+}
+
+func (c *beaconApiValidatorClient) cacheAttesterDuties(duties []*ethpbv1.AttesterDuty) {
+	attesterDuties := make(map[types.Slot]map[types.CommitteeIndex][]*ethpbv1.AttesterDuty)
+	for _, d := range duties {
+		if _, ok := attesterDuties[d.Slot]; !ok {
+			attesterDuties[d.Slot] = make(map[types.CommitteeIndex][]*ethpbv1.AttesterDuty)
+		}
+		attesterDuties[d.Slot][d.CommitteeIndex] = append(attesterDuties[d.Slot][d.CommitteeIndex], d)
+	}
+	c.attesterDutiesLock.Lock()
+	c.attesterDuties = attesterDuties
+	c.attesterDutiesLock.Unlock()
+}
+
+// waitForChainStartStream polls the beacon node until it knows about genesis.
+type waitForChainStartStream struct {
+	clientStream
+	handler      *restHandler
+	pollInterval time.Duration
+	started      bool
+}
+
+// Recv blocks until the beacon node knows about genesis. Once chain start has been
+// received, the stream is done.
+func (s *waitForChainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	if s.started {
+		return nil, io.EOF
+	}
+	for {
+		g, err := getGenesis(s.ctx, s.handler)
+		if err == nil {
+			s.started = true
+			return &ethpb.ChainStartResponse{
+				Started:               true,
+				GenesisTime:           g.time,
+				GenesisValidatorsRoot: g.validatorsRoot,
+			}, nil
+		}
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		log.Debug("Beacon node has not started the chain yet, waiting")
+		select {
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// waitForActivationStream polls the beacon node for the statuses of a set of validators.
+type waitForActivationStream struct {
+	clientStream
+	handler      *restHandler
+	pollInterval time.Duration
+	pubKeys      [][]byte
+	polled       bool
+}
+
+// Recv returns the statuses of the validators, waiting for the poll interval on every call
+// but the first.
+func (s *waitForActivationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	if s.polled {
+		select {
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+	}
+	s.polled = true
+	validators, err := getValidators(s.ctx, s.handler, pubKeyIDs(s.pubKeys))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validators")
+	}
+	byPubKey := make(map[[48]byte]*ethpbv1.ValidatorContainer, len(validators))
+	for _, v := range validators {
+		if v.Validator != nil {
+			byPubKey[bytesutil.ToBytes48(v.Validator.Pubkey)] = v
+		}
+	}
+	statuses := make([]*ethpb.ValidatorActivationResponse_Status, len(s.pubKeys))
+	for i, pk := range s.pubKeys {
+		v, ok := byPubKey[bytesutil.ToBytes48(pk)]
+		if !ok {
+			statuses[i] = &ethpb.ValidatorActivationResponse_Status{
+				PublicKey: pk,
+				Status:    &ethpb.ValidatorStatusResponse{Status: ethpb.ValidatorStatus_UNKNOWN_STATUS},
+				Index:     nonexistentIndex,
+			}
+			continue
+		}
+		statuses[i] = &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: pk,
+			Status:    validatorStatusResponse(v),
+			Index:     v.Index,
+		}
+	}
+	return &ethpb.ValidatorActivationResponse{Statuses: statuses}, nil
+}
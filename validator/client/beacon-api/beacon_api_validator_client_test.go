@@ -0,0 +1,436 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/apijson"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	testPubKey1 = "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"
+	testPubKey2 = "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"
+	testRoot    = "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"
+)
+
+// newTestServer starts a stand-in beacon node serving the given standard beacon API handlers.
+func newTestServer(t *testing.T, handlers map[string]http.HandlerFunc) *httptest.Server {
+	mux := http.NewServeMux()
+	for path, handler := range handlers {
+		mux.HandleFunc(path, handler)
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, err := w.Write([]byte(body))
+	require.NoError(t, err)
+}
+
+func writeProtoData(t *testing.T, w http.ResponseWriter, m proto.Message) {
+	data, err := apijson.Marshal(m)
+	require.NoError(t, err)
+	writeJSON(t, w, fmt.Sprintf(`{"data":%s}`, data))
+}
+
+func writeVersionedProtoData(t *testing.T, w http.ResponseWriter, version string, m proto.Message) {
+	data, err := apijson.Marshal(m)
+	require.NoError(t, err)
+	writeJSON(t, w, fmt.Sprintf(`{"version":"%s","data":%s}`, version, data))
+}
+
+func writeNotFound(t *testing.T, w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNotFound)
+	writeJSON(t, w, `{"code":404,"message":"not found"}`)
+}
+
+func readBody(t *testing.T, r *http.Request) []byte {
+	body, err := ioutil.ReadAll(r.Body)
+	require.NoError(t, err)
+	return body
+}
+
+func genesisHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, `{"data":{"genesis_time":"1606824023","genesis_validators_root":"`+testRoot+`","genesis_fork_version":"0x00000000"}}`)
+	}
+}
+
+func TestBeaconApiValidatorClient_GetDuties(t *testing.T) {
+	var attesterDutiesRequests []string
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, testPubKey1+","+testPubKey2, r.URL.Query().Get("id"))
+			writeJSON(t, w, `{"data":[{"index":"3","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"`+testPubKey1+`","withdrawal_credentials":"`+testRoot+`","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}]}`)
+		},
+		"/eth/v1/validator/duties/attester/": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, `["3"]`, string(readBody(t, r)))
+			attesterDutiesRequests = append(attesterDutiesRequests, r.URL.Path)
+			slot := "33"
+			if r.URL.Path == "/eth/v1/validator/duties/attester/2" {
+				slot = "65"
+			}
+			writeJSON(t, w, `{"dependent_root":"`+testRoot+`","data":[{"pubkey":"`+testPubKey1+`","validator_index":"3","committee_index":"1","committee_length":"2","committees_at_slot":"4","validator_committee_index":"0","slot":"`+slot+`"}]}`)
+		},
+		"/eth/v1/validator/duties/proposer/1": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"dependent_root":"`+testRoot+`","data":[{"pubkey":"`+testPubKey1+`","validator_index":"3","slot":"40"},{"pubkey":"`+testPubKey2+`","validator_index":"9","slot":"41"}]}`)
+		},
+		"/eth/v1/beacon/states/head/committees": func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			assert.Equal(t, "1", q.Get("index"))
+			writeJSON(t, w, `{"data":[{"index":"1","slot":"`+q.Get("slot")+`","validators":["3","7"]}]}`)
+		},
+	})
+
+	pk1, err := hexutil.Decode(testPubKey1)
+	require.NoError(t, err)
+	pk2, err := hexutil.Decode(testPubKey2)
+	require.NoError(t, err)
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{
+		Epoch:      1,
+		PublicKeys: [][]byte{pk1, pk2},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"/eth/v1/validator/duties/attester/1", "/eth/v1/validator/duties/attester/2"}, attesterDutiesRequests)
+
+	require.Equal(t, 2, len(resp.CurrentEpochDuties))
+	assert.DeepEqual(t, resp.CurrentEpochDuties, resp.Duties)
+	duty := resp.CurrentEpochDuties[0]
+	assert.DeepEqual(t, pk1, duty.PublicKey)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, duty.Status)
+	assert.Equal(t, types.ValidatorIndex(3), duty.ValidatorIndex)
+	assert.Equal(t, types.Slot(33), duty.AttesterSlot)
+	assert.Equal(t, types.CommitteeIndex(1), duty.CommitteeIndex)
+	assert.DeepEqual(t, []types.ValidatorIndex{3, 7}, duty.Committee)
+	assert.DeepEqual(t, []types.Slot{40}, duty.ProposerSlots)
+	unknown := resp.CurrentEpochDuties[1]
+	assert.DeepEqual(t, pk2, unknown.PublicKey)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, unknown.Status)
+	assert.Equal(t, nonexistentIndex, unknown.ValidatorIndex)
+
+	require.Equal(t, 2, len(resp.NextEpochDuties))
+	assert.Equal(t, types.Slot(65), resp.NextEpochDuties[0].AttesterSlot)
+	assert.Equal(t, 0, len(resp.NextEpochDuties[0].ProposerSlots))
+}
+
+func TestBeaconApiValidatorClient_SubscribeCommitteeSubnets(t *testing.T) {
+	var subscriptions []map[string]interface{}
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/beacon_committee_subscriptions": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.Unmarshal(readBody(t, r), &subscriptions))
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+	c, ok := NewValidatorClient([]string{srv.URL}, time.Second).(*beaconApiValidatorClient)
+	require.Equal(t, true, ok)
+	c.cacheAttesterDuties([]*ethpbv1.AttesterDuty{
+		{ValidatorIndex: 3, CommitteeIndex: 1, CommitteesAtSlot: 4, Slot: 33},
+		{ValidatorIndex: 5, CommitteeIndex: 1, CommitteesAtSlot: 4, Slot: 33},
+		{ValidatorIndex: 6, CommitteeIndex: 2, CommitteesAtSlot: 4, Slot: 34},
+	})
+
+	_, err := c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{33, 34},
+		CommitteeIds: []types.CommitteeIndex{1, 2},
+		IsAggregator: []bool{true, false},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(subscriptions))
+	assert.DeepEqual(t, map[string]interface{}{
+		"validator_index":    "3",
+		"committee_index":    "1",
+		"committees_at_slot": "4",
+		"slot":               "33",
+		"is_aggregator":      true,
+	}, subscriptions[0])
+	assert.Equal(t, "5", subscriptions[1]["validator_index"])
+	assert.Equal(t, "6", subscriptions[2]["validator_index"])
+	assert.Equal(t, false, subscriptions[2]["is_aggregator"])
+
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots: []types.Slot{33},
+	})
+	assert.ErrorContains(t, "must have the same length", err)
+}
+
+func TestBeaconApiValidatorClient_DomainData(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": genesisHandler(t),
+		"/eth/v1/beacon/states/head/fork": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"previous_version":"0x00000000","current_version":"0x01000000","epoch":"10"}}`)
+		},
+	})
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.DomainData(context.Background(), &ethpb.DomainRequest{
+		Epoch:  12,
+		Domain: params.BeaconConfig().DomainBeaconAttester[:],
+	})
+	require.NoError(t, err)
+
+	root, err := hexutil.Decode(testRoot)
+	require.NoError(t, err)
+	want, err := helpers.Domain(&statepb.Fork{
+		PreviousVersion: []byte{0, 0, 0, 0},
+		CurrentVersion:  []byte{1, 0, 0, 0},
+		Epoch:           10,
+	}, 12, params.BeaconConfig().DomainBeaconAttester, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, resp.SignatureDomain)
+}
+
+func TestBeaconApiValidatorClient_WaitForChainStart(t *testing.T) {
+	calls := 0
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				writeNotFound(t, w)
+				return
+			}
+			genesisHandler(t)(w, r)
+		},
+	})
+	c, ok := NewValidatorClient([]string{srv.URL}, time.Second).(*beaconApiValidatorClient)
+	require.Equal(t, true, ok)
+	c.pollInterval = time.Millisecond
+
+	stream, err := c.WaitForChainStart(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, true, resp.Started)
+	assert.Equal(t, uint64(1606824023), resp.GenesisTime)
+	assert.Equal(t, testRoot, fmt.Sprintf("%#x", resp.GenesisValidatorsRoot))
+}
+
+func TestBeaconApiValidatorClient_ValidatorStatus(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators/" + testPubKey1: func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"index":"3","balance":"32000000000","status":"pending_queued","validator":{"pubkey":"`+testPubKey1+`","activation_epoch":"20"}}}`)
+		},
+		"/eth/v1/beacon/states/head/validators/" + testPubKey2: func(w http.ResponseWriter, _ *http.Request) {
+			writeNotFound(t, w)
+		},
+	})
+	pk1, err := hexutil.Decode(testPubKey1)
+	require.NoError(t, err)
+	pk2, err := hexutil.Decode(testPubKey2)
+	require.NoError(t, err)
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+
+	resp, err := c.ValidatorStatus(context.Background(), &ethpb.ValidatorStatusRequest{PublicKey: pk1})
+	require.NoError(t, err)
+	assert.Equal(t, ethpb.ValidatorStatus_PENDING, resp.Status)
+	assert.Equal(t, types.Epoch(20), resp.ActivationEpoch)
+
+	resp, err = c.ValidatorStatus(context.Background(), &ethpb.ValidatorStatusRequest{PublicKey: pk2})
+	require.NoError(t, err)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Status)
+
+	_, err = c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: pk2})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBeaconApiValidatorClient_GetBlock(t *testing.T) {
+	v1Block := testutil.HydrateV1BeaconBlock(&ethpbv1.BeaconBlock{Slot: 10, ProposerIndex: 3})
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v2/validator/blocks/10": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, fmt.Sprintf("%#x", make([]byte, 96)), r.URL.Query().Get("randao_reveal"))
+			assert.Equal(t, fmt.Sprintf("%#x", bytesutil.PadTo([]byte("graffiti"), 32)), r.URL.Query().Get("graffiti"))
+			writeVersionedProtoData(t, w, phase0Version, v1Block)
+		},
+	})
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	blk, err := c.GetBlock(context.Background(), &ethpb.BlockRequest{
+		Slot:         10,
+		RandaoReveal: make([]byte, 96),
+		Graffiti:     bytesutil.PadTo([]byte("graffiti"), 32),
+	})
+	require.NoError(t, err)
+	want, err := migration.V1ToV1Alpha1SignedBlock(&ethpbv1.SignedBeaconBlock{Block: v1Block})
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want.Block, blk)
+}
+
+func TestBeaconApiValidatorClient_GetBlock_Altair(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v2/validator/blocks/10": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"version":"altair","data":{"slot":"10"}}`)
+		},
+	})
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	_, err := c.GetBlock(context.Background(), &ethpb.BlockRequest{Slot: 10, RandaoReveal: make([]byte, 96)})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestBeaconApiValidatorClient_ProposeBlock(t *testing.T) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 10
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/blocks": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			received := &ethpbv1.SignedBeaconBlock{}
+			require.NoError(t, apijson.Unmarshal(readBody(t, r), received))
+			assert.Equal(t, types.Slot(10), received.Block.Slot)
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.ProposeBlock(context.Background(), blk)
+	require.NoError(t, err)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.BlockRoot)
+}
+
+func TestBeaconApiValidatorClient_ProposeBlock_Rejected(t *testing.T) {
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/blocks": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(t, w, `{"code":400,"message":"invalid block"}`)
+		},
+	})
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	_, err := c.ProposeBlock(context.Background(), testutil.NewBeaconBlock())
+	assert.ErrorContains(t, "invalid block", err)
+}
+
+func TestBeaconApiValidatorClient_GetAttestationData(t *testing.T) {
+	data := testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 5, CommitteeIndex: 2})
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/attestation_data": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "5", r.URL.Query().Get("slot"))
+			assert.Equal(t, "2", r.URL.Query().Get("committee_index"))
+			writeProtoData(t, w, migration.V1Alpha1AttDataToV1(data))
+		},
+	})
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 5, CommitteeIndex: 2})
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, data, resp)
+}
+
+func TestBeaconApiValidatorClient_ProposeAttestation(t *testing.T) {
+	att := testutil.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 5}})
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/pool/attestations": func(w http.ResponseWriter, r *http.Request) {
+			var atts []json.RawMessage
+			require.NoError(t, json.Unmarshal(readBody(t, r), &atts))
+			require.Equal(t, 1, len(atts))
+			received := &ethpbv1.Attestation{}
+			require.NoError(t, apijson.Unmarshal(atts[0], received))
+			assert.DeepSSZEqual(t, migration.V1Alpha1AttestationToV1(att), received)
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.ProposeAttestation(context.Background(), att)
+	require.NoError(t, err)
+	root, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.AttestationDataRoot)
+}
+
+func TestBeaconApiValidatorClient_SubmitAggregateSelectionProof(t *testing.T) {
+	data := testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 5, CommitteeIndex: 2})
+	dataRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	aggregate := testutil.HydrateAttestation(&ethpb.Attestation{Data: data})
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators/" + testPubKey1: func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":{"index":"3","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"`+testPubKey1+`"}}}`)
+		},
+		"/eth/v1/validator/attestation_data": func(w http.ResponseWriter, _ *http.Request) {
+			writeProtoData(t, w, migration.V1Alpha1AttDataToV1(data))
+		},
+		"/eth/v1/validator/aggregate_attestation": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, fmt.Sprintf("%#x", dataRoot), r.URL.Query().Get("attestation_data_root"))
+			assert.Equal(t, "5", r.URL.Query().Get("slot"))
+			writeProtoData(t, w, migration.V1Alpha1AttestationToV1(aggregate))
+		},
+	})
+	pk, err := hexutil.Decode(testPubKey1)
+	require.NoError(t, err)
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.SubmitAggregateSelectionProof(context.Background(), &ethpb.AggregateSelectionRequest{
+		Slot:           5,
+		CommitteeIndex: 2,
+		PublicKey:      pk,
+		SlotSignature:  make([]byte, 96),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.ValidatorIndex(3), resp.AggregateAndProof.AggregatorIndex)
+	assert.DeepEqual(t, make([]byte, 96), resp.AggregateAndProof.SelectionProof)
+	assert.DeepSSZEqual(t, aggregate, resp.AggregateAndProof.Aggregate)
+}
+
+func TestBeaconApiValidatorClient_CheckDoppelGanger(t *testing.T) {
+	var livenessRequests []string
+	srv := newTestServer(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": genesisHandler(t),
+		"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(t, w, `{"data":[`+
+				`{"index":"3","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"`+testPubKey1+`"}},`+
+				`{"index":"9","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"`+testPubKey2+`"}}]}`)
+		},
+		"/eth/v1/validator/liveness/": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, `["3"]`, string(readBody(t, r)))
+			livenessRequests = append(livenessRequests, r.URL.Path)
+			writeJSON(t, w, `{"data":[{"index":"3","is_live":true}]}`)
+		},
+	})
+
+	pk1, err := hexutil.Decode(testPubKey1)
+	require.NoError(t, err)
+	pk2, err := hexutil.Decode(testPubKey2)
+	require.NoError(t, err)
+	currEpoch := helpers.SlotToEpoch(helpers.CurrentSlot(1606824023))
+	c := NewValidatorClient([]string{srv.URL}, time.Second)
+	resp, err := c.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{
+		ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{
+			{PublicKey: pk1, Epoch: 0, SignedRoot: make([]byte, 32)},
+			// The second validator attested recently, so its liveness is its own.
+			{PublicKey: pk2, Epoch: currEpoch, SignedRoot: make([]byte, 32)},
+			{PublicKey: make([]byte, 48), Epoch: 0, SignedRoot: make([]byte, 32)},
+		},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{
+		fmt.Sprintf("/eth/v1/validator/liveness/%d", currEpoch-1),
+		fmt.Sprintf("/eth/v1/validator/liveness/%d", currEpoch),
+	}, livenessRequests)
+	require.Equal(t, 2, len(resp.Responses))
+	assert.DeepEqual(t, pk1, resp.Responses[0].PublicKey)
+	assert.Equal(t, true, resp.Responses[0].DuplicateExists)
+	assert.DeepEqual(t, pk2, resp.Responses[1].PublicKey)
+	assert.Equal(t, false, resp.Responses[1].DuplicateExists)
+}
@@ -0,0 +1,179 @@
+package beaconapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultRequestTimeout is the timeout applied to each standard beacon API request.
+const defaultRequestTimeout = 12 * time.Second
+
+// errorJson is the JSON error body returned by the standard beacon API.
+type errorJson struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// dataJson wraps the "data" payload returned by most standard beacon API endpoints.
+type dataJson struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// restHandler performs JSON requests against a standard beacon API host. When several hosts
+// are configured, requests fail over to the next host whenever the active one is unavailable.
+type restHandler struct {
+	client     *http.Client
+	hosts      []string
+	active     int
+	activeLock sync.RWMutex
+}
+
+func newRestHandler(hosts []string, timeout time.Duration) *restHandler {
+	if timeout == 0 {
+		timeout = defaultRequestTimeout
+	}
+	trimmed := make([]string, len(hosts))
+	for i, host := range hosts {
+		trimmed[i] = strings.TrimSuffix(host, "/")
+	}
+	return &restHandler{
+		client: &http.Client{Timeout: timeout},
+		hosts:  trimmed,
+	}
+}
+
+// activeHost returns the host requests are currently sent to.
+func (h *restHandler) activeHost() string {
+	h.activeLock.RLock()
+	defer h.activeLock.RUnlock()
+	if len(h.hosts) == 0 {
+		return ""
+	}
+	return h.hosts[h.active]
+}
+
+// get sends a GET request to the given endpoint and decodes the response body into resp.
+func (h *restHandler) get(ctx context.Context, endpoint string, resp interface{}) error {
+	return h.do(ctx, http.MethodGet, endpoint, nil, resp)
+}
+
+// post sends a POST request with the given raw JSON body to the given endpoint and decodes
+// the response body into resp, if resp is not nil.
+func (h *restHandler) post(ctx context.Context, endpoint string, body []byte, resp interface{}) error {
+	return h.do(ctx, http.MethodPost, endpoint, body, resp)
+}
+
+// getData sends a GET request to the given endpoint and returns the raw "data" payload.
+func (h *restHandler) getData(ctx context.Context, endpoint string) ([]byte, error) {
+	res := &dataJson{}
+	if err := h.get(ctx, endpoint, res); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// do sends the request to the active host, failing over to the remaining hosts in order
+// while the beacon nodes are unavailable. The first host to respond becomes the active one.
+func (h *restHandler) do(ctx context.Context, method, endpoint string, body []byte, resp interface{}) error {
+	if len(h.hosts) == 0 {
+		return status.Error(codes.Unavailable, "no beacon node endpoint configured")
+	}
+	h.activeLock.RLock()
+	start := h.active
+	h.activeLock.RUnlock()
+
+	var err error
+	for i := range h.hosts {
+		idx := (start + i) % len(h.hosts)
+		err = h.doWithHost(ctx, h.hosts[idx], method, endpoint, body, resp)
+		if status.Code(err) != codes.Unavailable || ctx.Err() != nil {
+			if i > 0 {
+				h.activeLock.Lock()
+				h.active = idx
+				h.activeLock.Unlock()
+				log.WithField("endpoint", h.hosts[idx]).Info("Switched to beacon node endpoint")
+			}
+			return err
+		}
+		if len(h.hosts) > 1 {
+			log.WithError(err).WithField("endpoint", h.hosts[idx]).Warn("Beacon node endpoint is unavailable")
+		}
+	}
+	return err
+}
+
+func (h *restHandler) doWithHost(ctx context.Context, host, method, endpoint string, body []byte, resp interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, host+endpoint, reqBody)
+	if err != nil {
+		return errors.Wrapf(err, "could not create request for %s", endpoint)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	httpResp, err := h.client.Do(req)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not reach beacon node: %v", err)
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	respBody, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "could not read response body for %s", endpoint)
+	}
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return decodeError(httpResp.StatusCode, respBody)
+	}
+	if resp == nil || len(respBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, resp); err != nil {
+		return errors.Wrapf(err, "could not decode response body for %s", endpoint)
+	}
+	return nil
+}
+
+// decodeError converts a standard beacon API error response into a gRPC status error, so
+// that callers can treat both beacon node backends the same way.
+func decodeError(statusCode int, body []byte) error {
+	e := &errorJson{}
+	msg := http.StatusText(statusCode)
+	if err := json.Unmarshal(body, e); err == nil && e.Message != "" {
+		msg = e.Message
+	}
+	return status.Error(grpcCode(statusCode), fmt.Sprintf("beacon node returned status %d: %s", statusCode, msg))
+}
+
+func grpcCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,167 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/apijson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// genesisJson is the "data" payload of the /eth/v1/beacon/genesis endpoint.
+type genesisJson struct {
+	GenesisTime           string `json:"genesis_time"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+	GenesisForkVersion    string `json:"genesis_fork_version"`
+}
+
+// genesis is the decoded genesis information of the beacon chain.
+type genesis struct {
+	time           uint64
+	validatorsRoot []byte
+}
+
+// getGenesis fetches the genesis information of the beacon chain. A beacon node which
+// does not know about genesis yet responds with a NotFound status error.
+func getGenesis(ctx context.Context, h *restHandler) (*genesis, error) {
+	data, err := h.getData(ctx, "/eth/v1/beacon/genesis")
+	if err != nil {
+		return nil, err
+	}
+	g := &genesisJson{}
+	if err := json.Unmarshal(data, g); err != nil {
+		return nil, errors.Wrap(err, "could not decode genesis")
+	}
+	genesisTime, err := strconv.ParseUint(g.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse genesis time")
+	}
+	root, err := hexutil.Decode(g.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode genesis validators root")
+	}
+	return &genesis{time: genesisTime, validatorsRoot: root}, nil
+}
+
+// getProto sends a GET request to the given endpoint and decodes the whole JSON response
+// body into the given Ethereum API protobuf message.
+func getProto(ctx context.Context, h *restHandler, endpoint string, m proto.Message) error {
+	var raw json.RawMessage
+	if err := h.get(ctx, endpoint, &raw); err != nil {
+		return err
+	}
+	if err := apijson.Unmarshal(raw, m); err != nil {
+		return errors.Wrapf(err, "could not decode response for %s", endpoint)
+	}
+	return nil
+}
+
+// produceBlock requests an unsigned block for the given slot from the beacon node, returning
+// its raw JSON along with the name of the fork it belongs to.
+func produceBlock(ctx context.Context, h *restHandler, in *ethpb.BlockRequest) (*produceBlockJson, error) {
+	query := url.Values{}
+	query.Set("randao_reveal", hexutil.Encode(in.RandaoReveal))
+	if len(in.Graffiti) > 0 {
+		query.Set("graffiti", hexutil.Encode(in.Graffiti))
+	}
+	resp := &produceBlockJson{}
+	if err := h.get(ctx, fmt.Sprintf("/eth/v2/validator/blocks/%d?%s", in.Slot, query.Encode()), resp); err != nil {
+		return nil, errors.Wrap(err, "could not produce block")
+	}
+	if len(resp.Data) == 0 || string(resp.Data) == "null" {
+		return nil, errors.New("beacon node returned no block")
+	}
+	return resp, nil
+}
+
+// getValidators fetches the head state validators with the given ids, which are either
+// validator indices or 0x-prefixed public keys. Unknown validators are omitted from the
+// response.
+func getValidators(ctx context.Context, h *restHandler, ids []string) ([]*ethpbv1.ValidatorContainer, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := url.Values{"id": []string{strings.Join(ids, ",")}}
+	resp := &ethpbv1.StateValidatorsResponse{}
+	if err := getProto(ctx, h, "/eth/v1/beacon/states/head/validators?"+query.Encode(), resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// getValidator fetches the validator with the given public key from the head state. A validator
+// unknown to the beacon node results in a NotFound status error.
+func getValidator(ctx context.Context, h *restHandler, pubKey []byte) (*ethpbv1.ValidatorContainer, error) {
+	resp := &ethpbv1.StateValidatorResponse{}
+	if err := getProto(ctx, h, "/eth/v1/beacon/states/head/validators/"+hexutil.Encode(pubKey), resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, status.Errorf(codes.NotFound, "could not find validator with public key %#x", pubKey)
+	}
+	return resp.Data, nil
+}
+
+// pubKeyIDs converts public keys into validator ids understood by the standard beacon API.
+func pubKeyIDs(pubKeys [][]byte) []string {
+	ids := make([]string, len(pubKeys))
+	for i, pk := range pubKeys {
+		ids[i] = hexutil.Encode(pk)
+	}
+	return ids
+}
+
+// indexIDs converts validator indices into validator ids understood by the standard beacon API.
+func indexIDs(indices []types.ValidatorIndex) []string {
+	ids := make([]string, len(indices))
+	for i, idx := range indices {
+		ids[i] = fmt.Sprintf("%d", idx)
+	}
+	return ids
+}
+
+// validatorStatus converts a standard beacon API validator status into the Prysm
+// validator status used by the validator client.
+func validatorStatus(s ethpbv1.ValidatorStatus) ethpb.ValidatorStatus {
+	switch s {
+	case ethpbv1.ValidatorStatus_PENDING_INITIALIZED:
+		return ethpb.ValidatorStatus_DEPOSITED
+	case ethpbv1.ValidatorStatus_PENDING_QUEUED, ethpbv1.ValidatorStatus_PENDING:
+		return ethpb.ValidatorStatus_PENDING
+	case ethpbv1.ValidatorStatus_ACTIVE_ONGOING, ethpbv1.ValidatorStatus_ACTIVE:
+		return ethpb.ValidatorStatus_ACTIVE
+	case ethpbv1.ValidatorStatus_ACTIVE_EXITING:
+		return ethpb.ValidatorStatus_EXITING
+	case ethpbv1.ValidatorStatus_ACTIVE_SLASHED:
+		return ethpb.ValidatorStatus_SLASHING
+	case ethpbv1.ValidatorStatus_EXITED_UNSLASHED, ethpbv1.ValidatorStatus_EXITED_SLASHED,
+		ethpbv1.ValidatorStatus_EXITED, ethpbv1.ValidatorStatus_WITHDRAWAL_POSSIBLE,
+		ethpbv1.ValidatorStatus_WITHDRAWAL_DONE, ethpbv1.ValidatorStatus_WITHDRAWAL:
+		return ethpb.ValidatorStatus_EXITED
+	default:
+		return ethpb.ValidatorStatus_UNKNOWN_STATUS
+	}
+}
+
+// validatorStatusResponse converts a standard beacon API validator into a Prysm
+// validator status response.
+func validatorStatusResponse(v *ethpbv1.ValidatorContainer) *ethpb.ValidatorStatusResponse {
+	res := &ethpb.ValidatorStatusResponse{
+		Status: validatorStatus(v.Status),
+	}
+	if v.Validator != nil {
+		res.ActivationEpoch = v.Validator.ActivationEpoch
+	}
+	return res
+}
@@ -0,0 +1,120 @@
+package beaconapi
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+var errStreamMsgUnsupported = errors.New("raw stream messages are not supported by the beacon API client")
+
+// clientStream implements grpc.ClientStream for the polling and event based streams of the
+// beacon API client. Only the typed Recv methods of the embedding streams are supported.
+type clientStream struct {
+	ctx context.Context
+}
+
+// Header is a no-op, as beacon API streams carry no header metadata.
+func (s *clientStream) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+// Trailer is a no-op, as beacon API streams carry no trailer metadata.
+func (s *clientStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+// CloseSend is a no-op, as beacon API streams are receive only.
+func (s *clientStream) CloseSend() error {
+	return nil
+}
+
+// Context returns the context of the stream.
+func (s *clientStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg is not supported.
+func (s *clientStream) SendMsg(interface{}) error {
+	return errStreamMsgUnsupported
+}
+
+// RecvMsg is not supported.
+func (s *clientStream) RecvMsg(interface{}) error {
+	return errStreamMsgUnsupported
+}
+
+// event is a single server-sent event from the /eth/v1/events endpoint.
+type event struct {
+	name string
+	data []byte
+}
+
+// eventReader reads server-sent events from the body of an /eth/v1/events response.
+type eventReader struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+}
+
+// subscribeEvents opens an event stream for the given topics.
+func (h *restHandler) subscribeEvents(ctx context.Context, topics ...string) (*eventReader, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.activeHost()+"/eth/v1/events?topics="+strings.Join(topics, ","), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	// The event stream is long lived, so it must not be subject to the request timeout.
+	client := &http.Client{Transport: h.client.Transport}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				log.WithError(err).Debug("Could not close response body")
+			}
+		}()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, decodeError(resp.StatusCode, body)
+	}
+	return &eventReader{body: resp.Body, scanner: bufio.NewScanner(resp.Body)}, nil
+}
+
+// next blocks until the next complete event is received.
+func (r *eventReader) next() (*event, error) {
+	e := &event{}
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+		switch {
+		case line == "":
+			// A blank line dispatches the event, if any data was read.
+			if e.name != "" || len(e.data) > 0 {
+				return e, nil
+			}
+		case strings.HasPrefix(line, ":"):
+			// Comment lines are used as keep-alives.
+		case strings.HasPrefix(line, "event:"):
+			e.name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			e.data = append(e.data, strings.TrimSpace(strings.TrimPrefix(line, "data:"))...)
+		}
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (r *eventReader) close() error {
+	return r.body.Close()
+}
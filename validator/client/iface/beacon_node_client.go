@@ -0,0 +1,51 @@
+package iface
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Ensure the Prysm gRPC clients satisfy the beacon node client abstractions.
+var (
+	_ = ValidatorClient(ethpb.BeaconNodeValidatorClient(nil))
+	_ = BeaconChainClient(ethpb.BeaconChainClient(nil))
+	_ = NodeClient(ethpb.NodeClient(nil))
+)
+
+// ValidatorClient defines the beacon node methods used by the validator client to fetch
+// and perform its duties. It mirrors the Prysm BeaconNodeValidatorClient gRPC client so
+// that alternative beacon API backends can be plugged in.
+type ValidatorClient interface {
+	GetDuties(ctx context.Context, in *ethpb.DutiesRequest, opts ...grpc.CallOption) (*ethpb.DutiesResponse, error)
+	DomainData(ctx context.Context, in *ethpb.DomainRequest, opts ...grpc.CallOption) (*ethpb.DomainResponse, error)
+	WaitForChainStart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error)
+	WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error)
+	ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, opts ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error)
+	ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest, opts ...grpc.CallOption) (*ethpb.ValidatorStatusResponse, error)
+	MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, opts ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error)
+	GetBlock(ctx context.Context, in *ethpb.BlockRequest, opts ...grpc.CallOption) (*ethpb.BeaconBlock, error)
+	ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, opts ...grpc.CallOption) (*ethpb.ProposeResponse, error)
+	GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, opts ...grpc.CallOption) (*ethpb.AttestationData, error)
+	ProposeAttestation(ctx context.Context, in *ethpb.Attestation, opts ...grpc.CallOption) (*ethpb.AttestResponse, error)
+	SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, opts ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error)
+	SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, opts ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error)
+	ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, opts ...grpc.CallOption) (*ethpb.ProposeExitResponse, error)
+	SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest, opts ...grpc.CallOption) (*ethpb.DoppelGangerResponse, error)
+}
+
+// BeaconChainClient defines the beacon chain methods used by the validator client.
+type BeaconChainClient interface {
+	GetChainHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error)
+	StreamBlocks(ctx context.Context, in *ethpb.StreamBlocksRequest, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error)
+	GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error)
+}
+
+// NodeClient defines the node methods used by the validator client.
+type NodeClient interface {
+	GetSyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.SyncStatus, error)
+	GetGenesis(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.Genesis, error)
+}
@@ -154,8 +154,8 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 // The exit is signed by the validator before being sent to the beacon node for broadcasting.
 func ProposeExit(
 	ctx context.Context,
-	validatorClient ethpb.BeaconNodeValidatorClient,
-	nodeClient ethpb.NodeClient,
+	validatorClient iface.ValidatorClient,
+	nodeClient iface.NodeClient,
 	signer signingFunc,
 	pubKey []byte,
 ) error {
@@ -250,7 +250,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch types.
 // Sign voluntary exit with proposer domain and private key.
 func signVoluntaryExit(
 	ctx context.Context,
-	validatorClient ethpb.BeaconNodeValidatorClient,
+	validatorClient iface.ValidatorClient,
 	signer signingFunc,
 	pubKey []byte,
 	exit *ethpb.VoluntaryExit,
@@ -28,9 +28,10 @@ import (
 )
 
 type mocks struct {
-	validatorClient *mock.MockBeaconNodeValidatorClient
-	nodeClient      *mock.MockNodeClient
-	signExitFunc    func(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
+	validatorClient       *mock.MockBeaconNodeValidatorClient
+	altairValidatorClient *mock.MockBeaconNodeValidatorAltairClient
+	nodeClient            *mock.MockNodeClient
+	signExitFunc          func(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
 }
 
 type mockSignature struct{}
@@ -62,8 +63,9 @@ func setup(t *testing.T) (*validator, *mocks, bls.SecretKey, func()) {
 	valDB := testing2.SetupDB(t, [][48]byte{pubKey})
 	ctrl := gomock.NewController(t)
 	m := &mocks{
-		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
-		nodeClient:      mock.NewMockNodeClient(ctrl),
+		validatorClient:       mock.NewMockBeaconNodeValidatorClient(ctrl),
+		altairValidatorClient: mock.NewMockBeaconNodeValidatorAltairClient(ctrl),
+		nodeClient:            mock.NewMockNodeClient(ctrl),
 		signExitFunc: func(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
 			return mockSignature{}, nil
 		},
@@ -81,6 +83,7 @@ func setup(t *testing.T) (*validator, *mocks, bls.SecretKey, func()) {
 		db:                             valDB,
 		keyManager:                     km,
 		validatorClient:                m.validatorClient,
+		altairValidatorClient:          m.altairValidatorClient,
 		graffiti:                       []byte{},
 		attLogs:                        make(map[[32]byte]*attSubmitted),
 		aggregatedSlotCommitteeIDCache: aggregatedSlotCommitteeIDCache,
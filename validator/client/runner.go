@@ -200,6 +200,10 @@ func run(ctx context.Context, v iface.Validator) {
 							v.ProposeBlock(slotCtx, slot, pubKey)
 						case iface.RoleAggregator:
 							v.SubmitAggregateAndProof(slotCtx, slot, pubKey)
+						case iface.RoleSyncCommittee:
+							v.SubmitSyncCommitteeMessage(slotCtx, slot, pubKey)
+						case iface.RoleSyncCommitteeAggregator:
+							v.SubmitSignedContributionAndProof(slotCtx, slot, pubKey)
 						case iface.RoleUnknown:
 							log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Trace("No active roles, doing nothing")
 						default:
@@ -15,12 +15,14 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	beaconapi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
@@ -69,6 +71,9 @@ type ValidatorService struct {
 	grpcHeaders           []string
 	graffiti              []byte
 	graffitiStruct        *graffiti.Graffiti
+	beaconApiEndpoints    []string
+	beaconApiTimeout      time.Duration
+	nodeClient            iface.NodeClient
 }
 
 // Config for the validator service.
@@ -91,13 +96,15 @@ type Config struct {
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiStruct             *graffiti.Graffiti
+	BeaconApiEndpoints         []string
+	BeaconApiTimeout           time.Duration
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	return &ValidatorService{
+	s := &ValidatorService{
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
@@ -118,34 +125,60 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
 		logDutyCountDown:      cfg.LogDutyCountDown,
-	}, nil
+		beaconApiEndpoints:    cfg.BeaconApiEndpoints,
+		beaconApiTimeout:      cfg.BeaconApiTimeout,
+	}
+	if len(s.beaconApiEndpoints) > 0 {
+		s.nodeClient = beaconapi.NewNodeClient(s.beaconApiEndpoints, s.beaconApiTimeout)
+	}
+	return s, nil
 }
 
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	dialOpts := ConstructDialOptions(
-		v.maxCallRecvMsgSize,
-		v.withCert,
-		v.grpcRetries,
-		v.grpcRetryDelay,
-	)
-	if dialOpts == nil {
-		return
-	}
+	var validatorClient iface.ValidatorClient
+	var altairValidatorClient validatorpb.BeaconNodeValidatorAltairClient
+	var beaconClient iface.BeaconChainClient
+	logValidatorBalances := v.logValidatorBalances
+	if len(v.beaconApiEndpoints) > 0 {
+		log.WithField("endpoints", v.beaconApiEndpoints).Info("Using the standard beacon API of the beacon node")
+		validatorClient = beaconapi.NewValidatorClient(v.beaconApiEndpoints, v.beaconApiTimeout)
+		altairValidatorClient = beaconapi.NewAltairValidatorClient(v.beaconApiEndpoints, v.beaconApiTimeout)
+		beaconClient = beaconapi.NewBeaconChainClient(v.beaconApiEndpoints, v.beaconApiTimeout)
+		if logValidatorBalances {
+			log.Warn("Validator balance logging is not supported by the standard beacon API, disabling it")
+			logValidatorBalances = false
+		}
+	} else {
+		dialOpts := ConstructDialOptions(
+			v.maxCallRecvMsgSize,
+			v.withCert,
+			v.grpcRetries,
+			v.grpcRetryDelay,
+		)
+		if dialOpts == nil {
+			return
+		}
 
-	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
+		v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
-		return
-	}
-	if v.withCert != "" {
-		log.Info("Established secure gRPC connection")
+		conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+			return
+		}
+		if v.withCert != "" {
+			log.Info("Established secure gRPC connection")
+		}
+
+		v.conn = conn
+		validatorClient = ethpb.NewBeaconNodeValidatorClient(v.conn)
+		altairValidatorClient = validatorpb.NewBeaconNodeValidatorAltairClient(v.conn)
+		beaconClient = ethpb.NewBeaconChainClient(v.conn)
+		v.nodeClient = ethpb.NewNodeClient(v.conn)
 	}
 
-	v.conn = conn
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -179,12 +212,13 @@ func (v *ValidatorService) Start() {
 
 	v.validator = &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                validatorClient,
+		altairValidatorClient:          altairValidatorClient,
+		beaconClient:                   beaconClient,
+		node:                           v.nodeClient,
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
-		logValidatorBalances:           v.logValidatorBalances,
+		logValidatorBalances:           logValidatorBalances,
 		emitAccountMetrics:             v.emitAccountMetrics,
 		startBalances:                  make(map[[48]byte]uint64),
 		prevBalance:                    make(map[[48]byte]uint64),
@@ -217,7 +251,7 @@ func (v *ValidatorService) Stop() error {
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.conn == nil && len(v.beaconApiEndpoints) == 0 {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
@@ -311,8 +345,10 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	resp, err := nc.GetSyncStatus(ctx, &emptypb.Empty{})
+	if v.nodeClient == nil {
+		return false, errors.New("no connection to beacon node")
+	}
+	resp, err := v.nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
 	if err != nil {
 		return false, err
 	}
@@ -322,8 +358,10 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	return nc.GetGenesis(ctx, &emptypb.Empty{})
+	if v.nodeClient == nil {
+		return nil, errors.New("no connection to beacon node")
+	}
+	return v.nodeClient.GetGenesis(ctx, &emptypb.Empty{})
 }
 
 // to accounts changes in the keymanager, then updates those keys'
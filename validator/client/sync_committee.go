@@ -0,0 +1,377 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// syncSelection holds the sync subcommittee indices of a validator at a slot and the
+// selection proofs signed for each of them.
+type syncSelection struct {
+	slot    types.Slot
+	indices []uint64
+	proofs  [][]byte
+}
+
+// SubmitSyncCommitteeMessage submits the sync committee message to the beacon chain.
+func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.Slot, pubKey [48]byte) {
+	ctx, span := trace.StartSpan(ctx, "validator.SubmitSyncCommitteeMessage")
+	defer span.End()
+	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
+
+	v.waitOneThirdOrValidBlock(ctx, slot)
+
+	res, err := v.altairValidatorClient.GetSyncMessageBlockRoot(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).Error("Could not request sync message block root to sign")
+		return
+	}
+
+	duty, err := v.duty(pubKey)
+	if err != nil {
+		log.WithError(err).Error("Could not fetch validator assignment")
+		return
+	}
+
+	d, err := v.domainData(ctx, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainSyncCommittee[:])
+	if err != nil {
+		log.WithError(err).Error("Could not get sync committee domain data")
+		return
+	}
+	sszRoot := types.SSZBytes(res.Root)
+	r, err := helpers.ComputeSigningRoot(&sszRoot, d.SignatureDomain)
+	if err != nil {
+		log.WithError(err).Error("Could not get sync committee message signing root")
+		return
+	}
+
+	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     r[:],
+		SignatureDomain: d.SignatureDomain,
+		SigningSlot:     slot,
+		Object:          &validatorpb.SignRequest_SyncMessageBlockRoot{SyncMessageBlockRoot: res.Root},
+	})
+	if err != nil {
+		log.WithError(err).Error("Could not sign sync committee message")
+		return
+	}
+
+	msg := &validatorpb.SyncCommitteeMessage{
+		Slot:           slot,
+		BlockRoot:      res.Root,
+		ValidatorIndex: duty.ValidatorIndex,
+		Signature:      sig.Marshal(),
+	}
+	if _, err := v.altairValidatorClient.SubmitSyncMessage(ctx, msg); err != nil {
+		log.WithError(err).Error("Could not submit sync committee message")
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"slot":           msg.Slot,
+		"blockRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(msg.BlockRoot)),
+		"validatorIndex": msg.ValidatorIndex,
+	}).Info("Submitted new sync message")
+}
+
+// SubmitSignedContributionAndProof submits the signed sync committee contribution and proof to the beacon chain.
+func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot types.Slot, pubKey [48]byte) {
+	ctx, span := trace.StartSpan(ctx, "validator.SubmitSignedContributionAndProof")
+	defer span.End()
+	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
+
+	duty, err := v.duty(pubKey)
+	if err != nil {
+		log.WithError(err).Error("Could not fetch validator assignment")
+		return
+	}
+
+	selection, err := v.syncSelectionProofs(ctx, slot, pubKey)
+	if err != nil {
+		log.WithError(err).Error("Could not get sync committee selection proofs")
+		return
+	}
+	if len(selection.indices) == 0 {
+		log.Debug("Empty subcommittee index list, do nothing")
+		return
+	}
+
+	// As specified in spec, an aggregator should wait until two thirds of the way through slot
+	// to broadcast the best contribution to the global contribution channel.
+	v.waitToSlotTwoThirds(ctx, slot)
+
+	for i, comIdx := range selection.indices {
+		isAggregator, err := altair.IsSyncCommitteeAggregator(selection.proofs[i])
+		if err != nil {
+			log.WithError(err).Error("Could not check if a validator is a sync committee aggregator")
+			return
+		}
+		if !isAggregator {
+			continue
+		}
+		subnet := comIdx / subCommitteeSize()
+		contribution, err := v.altairValidatorClient.GetSyncCommitteeContribution(ctx, &validatorpb.SyncCommitteeContributionRequest{
+			Slot:      slot,
+			PublicKey: pubKey[:],
+			SubnetId:  subnet,
+		})
+		if err != nil {
+			log.WithError(err).Error("Could not get sync committee contribution")
+			return
+		}
+		if contribution.AggregationBits.Count() == 0 {
+			log.WithFields(logrus.Fields{
+				"slot":   slot,
+				"pubkey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+				"subnet": subnet,
+			}).Warn("Sync contribution for validator has no bits set")
+			continue
+		}
+
+		contributionAndProof := &validatorpb.ContributionAndProof{
+			AggregatorIndex: duty.ValidatorIndex,
+			Contribution:    contribution,
+			SelectionProof:  selection.proofs[i],
+		}
+		sig, err := v.signContributionAndProof(ctx, pubKey, contributionAndProof)
+		if err != nil {
+			log.WithError(err).Error("Could not sign contribution and proof")
+			return
+		}
+
+		if _, err := v.altairValidatorClient.SubmitSignedContributionAndProof(ctx, &validatorpb.SignedContributionAndProof{
+			Message:   contributionAndProof,
+			Signature: sig,
+		}); err != nil {
+			log.WithError(err).Error("Could not submit signed contribution and proof")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"slot":              contributionAndProof.Contribution.Slot,
+			"blockRoot":         fmt.Sprintf("%#x", bytesutil.Trunc(contributionAndProof.Contribution.BlockRoot)),
+			"subcommitteeIndex": contributionAndProof.Contribution.SubcommitteeIndex,
+			"aggregatorIndex":   contributionAndProof.AggregatorIndex,
+			"bitsCount":         contributionAndProof.Contribution.AggregationBits.Count(),
+		}).Info("Submitted new sync contribution and proof")
+	}
+}
+
+// syncSelectionProofs returns the sync subcommittee indices of the validator at the given slot
+// along with their selection proofs. The proofs are signed once per slot and reused by both
+// the aggregator role check and the contribution submission.
+func (v *validator) syncSelectionProofs(ctx context.Context, slot types.Slot, pubKey [48]byte) (*syncSelection, error) {
+	v.syncSelectionsLock.Lock()
+	cached, ok := v.syncSelections[pubKey]
+	v.syncSelectionsLock.Unlock()
+	if ok && cached.slot == slot {
+		return cached, nil
+	}
+
+	res, err := v.altairValidatorClient.GetSyncSubcommitteeIndex(ctx, &validatorpb.SyncSubcommitteeIndexRequest{
+		PublicKey: pubKey[:],
+		Slot:      slot,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync subcommittee index")
+	}
+	proofs, err := v.selectionProofs(ctx, slot, pubKey, res.Indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get selection proofs")
+	}
+	selection := &syncSelection{
+		slot:    slot,
+		indices: res.Indices,
+		proofs:  proofs,
+	}
+
+	v.syncSelectionsLock.Lock()
+	defer v.syncSelectionsLock.Unlock()
+	if v.syncSelections == nil {
+		v.syncSelections = make(map[[48]byte]*syncSelection)
+	}
+	// Proofs of past slots are never needed again.
+	for key, s := range v.syncSelections {
+		if s.slot < slot {
+			delete(v.syncSelections, key)
+		}
+	}
+	v.syncSelections[pubKey] = selection
+	return selection, nil
+}
+
+// Signs and returns selection proofs per validator for slot and index.
+func (v *validator) selectionProofs(ctx context.Context, slot types.Slot, pubKey [48]byte, indices []uint64) ([][]byte, error) {
+	selectionProofs := make([][]byte, len(indices))
+	for i, index := range indices {
+		subnet := index / subCommitteeSize()
+		selectionProof, err := v.signSyncSelectionData(ctx, pubKey, subnet, slot)
+		if err != nil {
+			return nil, err
+		}
+		selectionProofs[i] = selectionProof
+	}
+	return selectionProofs, nil
+}
+
+// Signs input slot and subcommittee index with domain sync committee selection proof. This is used
+// to create the signature for sync committee aggregator selection.
+func (v *validator) signSyncSelectionData(ctx context.Context, pubKey [48]byte, index uint64, slot types.Slot) ([]byte, error) {
+	domain, err := v.domainData(ctx, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainSyncCommitteeSelectionProof[:])
+	if err != nil {
+		return nil, err
+	}
+	data := &statepb.SyncAggregatorSelectionData{
+		Slot:              slot,
+		SubcommitteeIndex: index,
+	}
+	root, err := helpers.ComputeSigningRoot(data, domain.SignatureDomain)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     root[:],
+		SignatureDomain: domain.SignatureDomain,
+		Object:          &validatorpb.SignRequest_SyncAggregatorSelectionData{SyncAggregatorSelectionData: data},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sig.Marshal(), nil
+}
+
+// This returns the signature of validator signing over contribution and proof object.
+func (v *validator) signContributionAndProof(ctx context.Context, pubKey [48]byte, c *validatorpb.ContributionAndProof) ([]byte, error) {
+	d, err := v.domainData(ctx, helpers.SlotToEpoch(c.Contribution.Slot), params.BeaconConfig().DomainContributionAndProof[:])
+	if err != nil {
+		return nil, err
+	}
+	root, err := helpers.ComputeSigningRoot(c, d.SignatureDomain)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     root[:],
+		SignatureDomain: d.SignatureDomain,
+		Object:          &validatorpb.SignRequest_ContributionAndProof{ContributionAndProof: c},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sig.Marshal(), nil
+}
+
+// isSyncCommitteeAggregator checks if a validator in the sync committee is an aggregator of any
+// of its subcommittees at the given slot.
+func (v *validator) isSyncCommitteeAggregator(ctx context.Context, slot types.Slot, pubKey [48]byte) (bool, error) {
+	selection, err := v.syncSelectionProofs(ctx, slot, pubKey)
+	if err != nil {
+		return false, err
+	}
+	for _, proof := range selection.proofs {
+		isAggregator, err := altair.IsSyncCommitteeAggregator(proof)
+		if err != nil {
+			return false, err
+		}
+		if isAggregator {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// updateSyncCommitteeMembership refreshes the sync committee membership of the validating keys
+// and flags the matching duties as sync committee duties. Membership is looked up for the current
+// and the next sync committee period, so keys seen for the first time (such as on startup) are
+// resolved right away and the next committee is known before the period boundary is reached.
+func (v *validator) updateSyncCommitteeMembership(ctx context.Context, slot types.Slot, duties *ethpb.DutiesResponse) error {
+	epoch := helpers.SlotToEpoch(slot)
+	if epoch < params.BeaconConfig().AltairForkEpoch {
+		return nil
+	}
+	if v.altairValidatorClient == nil {
+		return errors.New("no Altair validator client configured")
+	}
+	period := helpers.SyncCommitteePeriod(epoch)
+	nextPeriodEpoch, err := helpers.SyncCommitteePeriodStartEpoch(epoch + params.BeaconConfig().EpochsPerSyncCommitteePeriod)
+	if err != nil {
+		return err
+	}
+	nextPeriodSlot, err := helpers.StartSlot(nextPeriodEpoch)
+	if err != nil {
+		return err
+	}
+
+	members := make(map[uint64]map[[48]byte]bool, 2)
+	for p, m := range v.syncCommitteeMembers {
+		// Memberships of past periods are never needed again.
+		if p >= period {
+			members[p] = m
+		}
+	}
+	lookups := []struct {
+		period uint64
+		slot   types.Slot
+	}{
+		{period: period, slot: slot},
+		{period: period + 1, slot: nextPeriodSlot},
+	}
+	for _, l := range lookups {
+		if members[l.period] == nil {
+			members[l.period] = make(map[[48]byte]bool)
+		}
+		for _, duty := range duties.Duties {
+			if duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING {
+				continue
+			}
+			pubKey := bytesutil.ToBytes48(duty.PublicKey)
+			if _, ok := members[l.period][pubKey]; ok {
+				continue
+			}
+			res, err := v.altairValidatorClient.GetSyncSubcommitteeIndex(ctx, &validatorpb.SyncSubcommitteeIndexRequest{
+				PublicKey: duty.PublicKey,
+				Slot:      l.slot,
+			})
+			if err != nil {
+				return errors.Wrap(err, "could not get sync subcommittee index")
+			}
+			isMember := len(res.Indices) > 0
+			members[l.period][pubKey] = isMember
+			if isMember && l.period > period {
+				log.WithFields(logrus.Fields{
+					"pubKey": fmt.Sprintf("%#x", bytesutil.Trunc(duty.PublicKey)),
+					"period": l.period,
+				}).Info("Validator is in the next sync committee")
+			}
+		}
+	}
+	v.syncCommitteeMembers = members
+
+	for _, duty := range duties.Duties {
+		if members[period][bytesutil.ToBytes48(duty.PublicKey)] {
+			duty.IsSyncCommittee = true
+		}
+	}
+	return nil
+}
+
+// subCommitteeSize returns the number of validators in each sync subcommittee.
+func subCommitteeSize() uint64 {
+	return params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+}
@@ -0,0 +1,567 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestSubmitSyncCommitteeMessage_ValidatorDutiesRequestFailure(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{}}
+	defer finish()
+
+	m.altairValidatorClient.EXPECT().GetSyncMessageBlockRoot(
+		gomock.Any(), // ctx
+		&emptypb.Empty{},
+	).Return(&validatorpb.SyncMessageBlockRootResponse{
+		Root: bytesutil.PadTo([]byte{}, 32),
+	}, nil)
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.SubmitSyncCommitteeMessage(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Could not fetch validator assignment")
+}
+
+func TestSubmitSyncCommitteeMessage_BadDomainData(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	hook := logTest.NewGlobal()
+	validatorIndex := types.ValidatorIndex(7)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+
+	r := []byte{'a'}
+	m.altairValidatorClient.EXPECT().GetSyncMessageBlockRoot(
+		gomock.Any(), // ctx
+		&emptypb.Empty{},
+	).Return(&validatorpb.SyncMessageBlockRootResponse{
+		Root: bytesutil.PadTo(r, 32),
+	}, nil)
+
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("uh oh"))
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.SubmitSyncCommitteeMessage(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Could not get sync committee domain data")
+}
+
+func TestSubmitSyncCommitteeMessage_CouldNotSubmit(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	hook := logTest.NewGlobal()
+	validatorIndex := types.ValidatorIndex(7)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+
+	r := []byte{'a'}
+	m.altairValidatorClient.EXPECT().GetSyncMessageBlockRoot(
+		gomock.Any(), // ctx
+		&emptypb.Empty{},
+	).Return(&validatorpb.SyncMessageBlockRootResponse{
+		Root: bytesutil.PadTo(r, 32),
+	}, nil)
+
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{
+			SignatureDomain: make([]byte, 32),
+		}, nil)
+
+	m.altairValidatorClient.EXPECT().SubmitSyncMessage(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&validatorpb.SyncCommitteeMessage{}),
+	).Return(&emptypb.Empty{}, errors.New("uh oh") /* error */)
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.SubmitSyncCommitteeMessage(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Could not submit sync committee message")
+}
+
+func TestSubmitSyncCommitteeMessage_OK(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	hook := logTest.NewGlobal()
+	validatorIndex := types.ValidatorIndex(7)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+
+	r := []byte{'a'}
+	m.altairValidatorClient.EXPECT().GetSyncMessageBlockRoot(
+		gomock.Any(), // ctx
+		&emptypb.Empty{},
+	).Return(&validatorpb.SyncMessageBlockRootResponse{
+		Root: bytesutil.PadTo(r, 32),
+	}, nil)
+
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{
+			SignatureDomain: make([]byte, 32),
+		}, nil)
+
+	var generatedMsg *validatorpb.SyncCommitteeMessage
+	m.altairValidatorClient.EXPECT().SubmitSyncMessage(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&validatorpb.SyncCommitteeMessage{}),
+	).Do(func(_ context.Context, msg *validatorpb.SyncCommitteeMessage, _ ...interface{}) {
+		generatedMsg = msg
+	}).Return(&emptypb.Empty{}, nil /* error */)
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.SubmitSyncCommitteeMessage(context.Background(), 1, pubKey)
+	require.LogsDoNotContain(t, hook, "Could not")
+	require.Equal(t, types.Slot(1), generatedMsg.Slot)
+	require.Equal(t, validatorIndex, generatedMsg.ValidatorIndex)
+	require.DeepEqual(t, bytesutil.PadTo(r, 32), generatedMsg.BlockRoot)
+}
+
+func TestSubmitSignedContributionAndProof_ValidatorDutiesRequestFailure(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, _, validatorKey, finish := setup(t)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{}}
+	defer finish()
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.SubmitSignedContributionAndProof(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Could not fetch validator assignment")
+}
+
+func TestSubmitSignedContributionAndProof_GetSyncSubcommitteeIndexFailure(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	validatorIndex := types.ValidatorIndex(7)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+	defer finish()
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{
+			Slot:      1,
+			PublicKey: pubKey[:],
+		},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{}, errors.New("Bad index"))
+
+	validator.SubmitSignedContributionAndProof(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Could not get sync committee selection proofs")
+	require.LogsContain(t, hook, "could not get sync subcommittee index")
+}
+
+func TestSubmitSignedContributionAndProof_NothingToDo(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	validatorIndex := types.ValidatorIndex(7)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+	defer finish()
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{
+			Slot:      1,
+			PublicKey: pubKey[:],
+		},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{Indices: []uint64{}}, nil)
+
+	validator.SubmitSignedContributionAndProof(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Empty subcommittee index list, do nothing")
+}
+
+func TestSubmitSignedContributionAndProof_BadDomain(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	validatorIndex := types.ValidatorIndex(7)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+	defer finish()
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{
+			Slot:      1,
+			PublicKey: pubKey[:],
+		},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{Indices: []uint64{1}}, nil)
+
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{
+			SignatureDomain: make([]byte, 32),
+		}, errors.New("bad domain response"))
+
+	validator.SubmitSignedContributionAndProof(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "could not get selection proofs")
+	require.LogsContain(t, hook, "bad domain response")
+}
+
+func TestSubmitSignedContributionAndProof_CouldNotSubmitContribution(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	validatorIndex := types.ValidatorIndex(7)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+	defer finish()
+	// Every selection proof is an aggregator proof with a single validator per subcommittee.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SyncCommitteeSize = params.BeaconConfig().SyncCommitteeSubnetCount
+	params.OverrideBeaconConfig(cfg)
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{
+			Slot:      1,
+			PublicKey: pubKey[:],
+		},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{Indices: []uint64{1}}, nil)
+
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{
+			SignatureDomain: make([]byte, 32),
+		}, nil).Times(2)
+
+	aggBits := bitfield.NewBitvector128()
+	aggBits.SetBitAt(0, true)
+	m.altairValidatorClient.EXPECT().GetSyncCommitteeContribution(
+		gomock.Any(), // ctx
+		&validatorpb.SyncCommitteeContributionRequest{
+			Slot:      1,
+			PublicKey: pubKey[:],
+			SubnetId:  1,
+		},
+	).Return(&validatorpb.SyncCommitteeContribution{
+		BlockRoot:       make([]byte, 32),
+		Signature:       make([]byte, 96),
+		AggregationBits: aggBits,
+	}, nil)
+
+	m.altairValidatorClient.EXPECT().SubmitSignedContributionAndProof(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&validatorpb.SignedContributionAndProof{}),
+	).Return(&emptypb.Empty{}, errors.New("bad"))
+
+	validator.SubmitSignedContributionAndProof(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Could not submit signed contribution and proof")
+}
+
+func TestSubmitSignedContributionAndProof_Ok(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	validatorIndex := types.ValidatorIndex(7)
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+	defer finish()
+	// Every selection proof is an aggregator proof with a single validator per subcommittee.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SyncCommitteeSize = params.BeaconConfig().SyncCommitteeSubnetCount
+	params.OverrideBeaconConfig(cfg)
+
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{
+			Slot:      1,
+			PublicKey: pubKey[:],
+		},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{Indices: []uint64{1}}, nil)
+
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{
+			SignatureDomain: make([]byte, 32),
+		}, nil).Times(2)
+
+	aggBits := bitfield.NewBitvector128()
+	aggBits.SetBitAt(0, true)
+	m.altairValidatorClient.EXPECT().GetSyncCommitteeContribution(
+		gomock.Any(), // ctx
+		&validatorpb.SyncCommitteeContributionRequest{
+			Slot:      1,
+			PublicKey: pubKey[:],
+			SubnetId:  1,
+		},
+	).Return(&validatorpb.SyncCommitteeContribution{
+		Slot:            1,
+		BlockRoot:       make([]byte, 32),
+		Signature:       make([]byte, 96),
+		AggregationBits: aggBits,
+	}, nil)
+
+	var submitted *validatorpb.SignedContributionAndProof
+	m.altairValidatorClient.EXPECT().SubmitSignedContributionAndProof(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&validatorpb.SignedContributionAndProof{}),
+	).Do(func(_ context.Context, msg *validatorpb.SignedContributionAndProof, _ ...interface{}) {
+		submitted = msg
+	}).Return(&emptypb.Empty{}, nil)
+
+	validator.SubmitSignedContributionAndProof(context.Background(), 1, pubKey)
+	require.LogsDoNotContain(t, hook, "Could not")
+	require.LogsContain(t, hook, "Submitted new sync contribution and proof")
+	require.NotNil(t, submitted)
+	assert.Equal(t, validatorIndex, submitted.Message.AggregatorIndex)
+	assert.Equal(t, 96, len(submitted.Message.SelectionProof))
+}
+
+func TestRolesAt_SyncCommittee(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				CommitteeIndex:  1,
+				AttesterSlot:    2,
+				PublicKey:       pubKey[:],
+				IsSyncCommittee: true,
+			},
+		},
+	}
+
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{
+			Slot:      1,
+			PublicKey: pubKey[:],
+		},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{Indices: []uint64{}}, nil)
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []iface.ValidatorRole{iface.RoleSyncCommittee}, roleMap[pubKey])
+}
+
+func TestRolesAt_SyncCommitteeAggregatorReusesSelectionProofs(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	// Every selection proof is an aggregator proof with a single validator per subcommittee.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 0
+	cfg.SyncCommitteeSize = params.BeaconConfig().SyncCommitteeSubnetCount
+	params.OverrideBeaconConfig(cfg)
+
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:       pubKey[:],
+				ValidatorIndex:  7,
+				IsSyncCommittee: true,
+			},
+		},
+	}
+
+	// The subcommittee index is requested and the selection proof is signed only once for the slot.
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{
+			Slot:      1,
+			PublicKey: pubKey[:],
+		},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{Indices: []uint64{1}}, nil).Times(1)
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{
+			SignatureDomain: make([]byte, 32),
+		}, nil).Times(2)
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []iface.ValidatorRole{iface.RoleSyncCommittee, iface.RoleSyncCommitteeAggregator}, roleMap[pubKey])
+
+	aggBits := bitfield.NewBitvector128()
+	aggBits.SetBitAt(0, true)
+	m.altairValidatorClient.EXPECT().GetSyncCommitteeContribution(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(&validatorpb.SyncCommitteeContribution{
+		Slot:            1,
+		BlockRoot:       make([]byte, 32),
+		Signature:       make([]byte, 96),
+		AggregationBits: aggBits,
+	}, nil)
+	m.altairValidatorClient.EXPECT().SubmitSignedContributionAndProof(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&validatorpb.SignedContributionAndProof{}),
+	).Return(&emptypb.Empty{}, nil)
+
+	v.SubmitSignedContributionAndProof(context.Background(), 1, pubKey)
+	require.LogsDoNotContain(t, hook, "Could not")
+	require.LogsContain(t, hook, "Submitted new sync contribution and proof")
+}
+
+func TestRolesAt_SyncCommitteeAggregatorCheckFails(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				CommitteeIndex:  1,
+				AttesterSlot:    1,
+				ProposerSlots:   []types.Slot{1},
+				PublicKey:       pubKey[:],
+				IsSyncCommittee: true,
+			},
+		},
+	}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(nil, errors.New("bad"))
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []iface.ValidatorRole{iface.RoleProposer, iface.RoleAttester, iface.RoleAggregator, iface.RoleSyncCommittee}, roleMap[pubKey])
+	require.LogsContain(t, hook, "Could not check if a validator is a sync committee aggregator")
+}
+
+func TestUpdateSyncCommitteeMembership_CurrentAndNextPeriod(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	otherKey := [48]byte{'b'}
+	newDuties := func() *ethpb.DutiesResponse {
+		return &ethpb.DutiesResponse{
+			Duties: []*ethpb.DutiesResponse_Duty{
+				{PublicKey: pubKey[:], Status: ethpb.ValidatorStatus_ACTIVE},
+				{PublicKey: otherKey[:], Status: ethpb.ValidatorStatus_ACTIVE},
+				{PublicKey: []byte{'c'}, Status: ethpb.ValidatorStatus_PENDING},
+			},
+		}
+	}
+	periodSlots := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+
+	// On startup, membership is looked up for the current and the next period.
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{Slot: 1, PublicKey: pubKey[:]},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{Indices: []uint64{3}}, nil).Times(1)
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{Slot: 1, PublicKey: otherKey[:]},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{}, nil).Times(1)
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{Slot: periodSlots, PublicKey: pubKey[:]},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{}, nil).Times(1)
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{Slot: periodSlots, PublicKey: otherKey[:]},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{Indices: []uint64{5}}, nil).Times(1)
+
+	duties := newDuties()
+	require.NoError(t, v.updateSyncCommitteeMembership(context.Background(), 1, duties))
+	assert.Equal(t, true, duties.Duties[0].IsSyncCommittee)
+	assert.Equal(t, false, duties.Duties[1].IsSyncCommittee)
+	assert.Equal(t, false, duties.Duties[2].IsSyncCommittee)
+
+	// The membership is cached for the rest of the sync committee period.
+	duties = newDuties()
+	require.NoError(t, v.updateSyncCommitteeMembership(context.Background(), params.BeaconConfig().SlotsPerEpoch, duties))
+	assert.Equal(t, true, duties.Duties[0].IsSyncCommittee)
+	assert.Equal(t, false, duties.Duties[1].IsSyncCommittee)
+
+	// At the period boundary the looked ahead membership is used, only the following period is requested.
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{Slot: 2 * periodSlots, PublicKey: pubKey[:]},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{}, nil).Times(1)
+	m.altairValidatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&validatorpb.SyncSubcommitteeIndexRequest{Slot: 2 * periodSlots, PublicKey: otherKey[:]},
+	).Return(&validatorpb.SyncSubcommitteeIndexResponse{}, nil).Times(1)
+
+	duties = newDuties()
+	require.NoError(t, v.updateSyncCommitteeMembership(context.Background(), periodSlots, duties))
+	assert.Equal(t, false, duties.Duties[0].IsSyncCommittee)
+	assert.Equal(t, true, duties.Duties[1].IsSyncCommittee)
+}
@@ -16,39 +16,41 @@ var _ iface.Validator = (*FakeValidator)(nil)
 
 // FakeValidator for mocking.
 type FakeValidator struct {
-	DoneCalled                        bool
-	WaitForWalletInitializationCalled bool
-	SlasherReadyCalled                bool
-	NextSlotCalled                    bool
-	UpdateDutiesCalled                bool
-	UpdateProtectionsCalled           bool
-	RoleAtCalled                      bool
-	AttestToBlockHeadCalled           bool
-	ProposeBlockCalled                bool
-	LogValidatorGainsAndLossesCalled  bool
-	SaveProtectionsCalled             bool
-	DeleteProtectionCalled            bool
-	SlotDeadlineCalled                bool
-	HandleKeyReloadCalled             bool
-	WaitForChainStartCalled           int
-	WaitForSyncCalled                 int
-	WaitForActivationCalled           int
-	CanonicalHeadSlotCalled           int
-	ReceiveBlocksCalled               int
-	RetryTillSuccess                  int
-	ProposeBlockArg1                  uint64
-	AttestToBlockHeadArg1             uint64
-	RoleAtArg1                        uint64
-	UpdateDutiesArg1                  uint64
-	NextSlotRet                       <-chan types.Slot
-	PublicKey                         string
-	UpdateDutiesRet                   error
-	RolesAtRet                        []iface.ValidatorRole
-	Balances                          map[[48]byte]uint64
-	IndexToPubkeyMap                  map[uint64][48]byte
-	PubkeyToIndexMap                  map[[48]byte]uint64
-	PubkeysToStatusesMap              map[[48]byte]ethpb.ValidatorStatus
-	Keymanager                        keymanager.IKeymanager
+	DoneCalled                             bool
+	WaitForWalletInitializationCalled      bool
+	SlasherReadyCalled                     bool
+	NextSlotCalled                         bool
+	UpdateDutiesCalled                     bool
+	UpdateProtectionsCalled                bool
+	RoleAtCalled                           bool
+	AttestToBlockHeadCalled                bool
+	ProposeBlockCalled                     bool
+	LogValidatorGainsAndLossesCalled       bool
+	SaveProtectionsCalled                  bool
+	DeleteProtectionCalled                 bool
+	SlotDeadlineCalled                     bool
+	HandleKeyReloadCalled                  bool
+	SubmitSyncCommitteeMessageCalled       bool
+	SubmitSignedContributionAndProofCalled bool
+	WaitForChainStartCalled                int
+	WaitForSyncCalled                      int
+	WaitForActivationCalled                int
+	CanonicalHeadSlotCalled                int
+	ReceiveBlocksCalled                    int
+	RetryTillSuccess                       int
+	ProposeBlockArg1                       uint64
+	AttestToBlockHeadArg1                  uint64
+	RoleAtArg1                             uint64
+	UpdateDutiesArg1                       uint64
+	NextSlotRet                            <-chan types.Slot
+	PublicKey                              string
+	UpdateDutiesRet                        error
+	RolesAtRet                             []iface.ValidatorRole
+	Balances                               map[[48]byte]uint64
+	IndexToPubkeyMap                       map[uint64][48]byte
+	PubkeyToIndexMap                       map[[48]byte]uint64
+	PubkeysToStatusesMap                   map[[48]byte]ethpb.ValidatorStatus
+	Keymanager                             keymanager.IKeymanager
 }
 
 type ctxKey string
@@ -169,6 +171,16 @@ func (fv *FakeValidator) ProposeBlock(_ context.Context, slot types.Slot, _ [48]
 // SubmitAggregateAndProof for mocking.
 func (fv *FakeValidator) SubmitAggregateAndProof(_ context.Context, _ types.Slot, _ [48]byte) {}
 
+// SubmitSyncCommitteeMessage for mocking.
+func (fv *FakeValidator) SubmitSyncCommitteeMessage(_ context.Context, _ types.Slot, _ [48]byte) {
+	fv.SubmitSyncCommitteeMessageCalled = true
+}
+
+// SubmitSignedContributionAndProof for mocking.
+func (fv *FakeValidator) SubmitSignedContributionAndProof(_ context.Context, _ types.Slot, _ [48]byte) {
+	fv.SubmitSignedContributionAndProofCalled = true
+}
+
 // LogAttestationsSubmitted for mocking.
 func (fv *FakeValidator) LogAttestationsSubmitted() {}
 
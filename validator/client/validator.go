@@ -20,6 +20,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -74,10 +75,11 @@ type validator struct {
 	duties                             *ethpb.DutiesResponse
 	startBalances                      map[[48]byte]uint64
 	attLogs                            map[[32]byte]*attSubmitted
-	node                               ethpb.NodeClient
+	node                               iface.NodeClient
 	keyManager                         keymanager.IKeymanager
-	beaconClient                       ethpb.BeaconChainClient
-	validatorClient                    ethpb.BeaconNodeValidatorClient
+	beaconClient                       iface.BeaconChainClient
+	validatorClient                    iface.ValidatorClient
+	altairValidatorClient              validatorpb.BeaconNodeValidatorAltairClient
 	protector                          slashingiface.Protector
 	db                                 vdb.Database
 	graffiti                           []byte
@@ -85,6 +87,9 @@ type validator struct {
 	graffitiStruct                     *graffiti.Graffiti
 	graffitiOrderedIndex               uint64
 	eipImportBlacklistedPublicKeys     map[[48]byte]bool
+	syncCommitteeMembers               map[uint64]map[[48]byte]bool
+	syncSelectionsLock                 sync.Mutex
+	syncSelections                     map[[48]byte]*syncSelection
 }
 
 type validatorStatus struct {
@@ -515,6 +520,10 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 		return err
 	}
 
+	if err := v.updateSyncCommitteeMembership(ctx, slot, resp); err != nil {
+		log.WithError(err).Error("Could not update sync committee membership")
+	}
+
 	v.duties = resp
 	v.logDuties(slot, v.duties.CurrentEpochDuties)
 
@@ -625,6 +634,19 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[48]byte]
 			}
 
 		}
+		if duty.IsSyncCommittee && helpers.SlotToEpoch(slot) >= params.BeaconConfig().AltairForkEpoch {
+			roles = append(roles, iface.RoleSyncCommittee)
+
+			// A failed check only skips the sync aggregator role, the other roles of the validator are kept.
+			aggregator, err := v.isSyncCommitteeAggregator(ctx, slot, bytesutil.ToBytes48(duty.PublicKey))
+			if err != nil {
+				log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(duty.PublicKey))).
+					Error("Could not check if a validator is a sync committee aggregator")
+			}
+			if aggregator {
+				roles = append(roles, iface.RoleSyncCommitteeAggregator)
+			}
+		}
 		if len(roles) == 0 {
 			roles = append(roles, iface.RoleUnknown)
 		}
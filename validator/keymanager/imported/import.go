@@ -1,6 +1,7 @@
 package imported
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"encoding/json"
@@ -72,7 +73,35 @@ func (km *Keymanager) ImportKeypairs(ctx context.Context, privKeys, pubKeys [][]
 	if err != nil {
 		return errors.Wrap(err, "could not marshal accounts keystore into JSON")
 	}
-	return km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encodedAccounts)
+	if err := km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encodedAccounts); err != nil {
+		return err
+	}
+	km.notifyAccountsChanged()
+	return nil
+}
+
+// DecryptKeystore retrieves the private key and public key from an EIP-2335 keystore
+// using the specified password, failing rather than prompting if the password is wrong.
+func DecryptKeystore(keystore *keymanager.Keystore, password string) ([]byte, []byte, error) {
+	privKeyBytes, err := keystorev4.New().Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	privKey, err := bls.SecretKeyFromBytes(privKeyBytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not initialize private key from bytes")
+	}
+	pubKeyBytes := privKey.PublicKey().Marshal()
+	if keystore.Pubkey != "" {
+		keystorePubKey, err := hex.DecodeString(strings.TrimPrefix(keystore.Pubkey, "0x"))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not decode pubkey from keystore")
+		}
+		if !bytes.Equal(keystorePubKey, pubKeyBytes) {
+			return nil, nil, fmt.Errorf("pubkey %#x of keystore does not match its private key", keystorePubKey)
+		}
+	}
+	return privKeyBytes, pubKeyBytes, nil
 }
 
 // Retrieves the private key and public key from an EIP-2335 keystore file
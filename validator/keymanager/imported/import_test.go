@@ -152,3 +152,22 @@ func TestImportedKeymanager_ImportKeystores(t *testing.T) {
 	assert.Equal(t, numAccounts, len(store.PublicKeys))
 	assert.Equal(t, numAccounts, len(store.PrivateKeys))
 }
+
+func TestDecryptKeystore(t *testing.T) {
+	password := "secretPassw0rd$1999"
+	keystore := createRandomKeystore(t, password)
+	privKey, pubKey, err := DecryptKeystore(keystore, password)
+	require.NoError(t, err)
+	assert.Equal(t, keystore.Pubkey, fmt.Sprintf("%x", pubKey))
+	secretKey, err := bls.SecretKeyFromBytes(privKey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKey, secretKey.PublicKey().Marshal())
+
+	_, _, err = DecryptKeystore(keystore, "wrong password")
+	assert.ErrorContains(t, "could not decrypt keystore", err)
+
+	other := createRandomKeystore(t, password)
+	keystore.Pubkey = other.Pubkey
+	_, _, err = DecryptKeystore(keystore, password)
+	assert.ErrorContains(t, "does not match its private key", err)
+}
@@ -200,9 +200,23 @@ func (km *Keymanager) DeleteAccounts(ctx context.Context, publicKeys [][]byte) e
 			return errors.Wrap(err, "failed to initialize keys caches")
 		}
 	}
+	km.notifyAccountsChanged()
 	return nil
 }
 
+// Notifies subscribers of the account changes made through the keymanager itself,
+// so a running validator picks them up without waiting on the keystore file watcher.
+func (km *Keymanager) notifyAccountsChanged() {
+	if km.accountsChangedFeed == nil {
+		return
+	}
+	lock.RLock()
+	pubKeys := make([][48]byte, len(orderedPublicKeys))
+	copy(pubKeys, orderedPublicKeys)
+	lock.RUnlock()
+	km.accountsChangedFeed.Send(pubKeys)
+}
+
 // FetchValidatingPublicKeys fetches the list of active public keys from the imported account keystores.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "keymanager.FetchValidatingPublicKeys")
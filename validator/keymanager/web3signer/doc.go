@@ -0,0 +1,19 @@
+/*
+Package web3signer defines a keymanager implementation which signs through a remote
+signer speaking the Web3Signer HTTP interface. The public keys available for signing
+are listed at /api/v1/eth2/publicKeys, and objects are signed by posting typed JSON
+payloads to /api/v1/eth2/sign/{pubkey}, which carry the object to sign in the JSON
+format of the beacon API along with its signing root and the fork it is signed at.
+
+The public keys of the remote signer are polled by the validator client, so keys added
+to or removed from the remote signer are picked up without restarting it.
+
+The web3signer keymanager is configured via a keymanageropts.json file with the
+following schema:
+
+	{
+	  "base_url": "http://signer.example.com:9000", // Base URL of the remote signer.
+	  "genesis_validators_root": "0x4b36...",       // Genesis validators root of the chain.
+	}
+*/
+package web3signer
@@ -0,0 +1,387 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/apijson"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign"
+	requestTimeout = 10 * time.Second
+)
+
+var (
+	// ErrSigningDenied defines a failure from the remote signer when it refuses
+	// to sign a request, such as when signing would be slashable.
+	ErrSigningDenied = errors.New("signing request was denied by remote signer")
+	// ErrUnknownPublicKey defines a failure from the remote signer when it does
+	// not hold the key a request asks to sign with.
+	ErrUnknownPublicKey = errors.New("public key not found in remote signer")
+)
+
+// KeymanagerOpts for a web3signer keymanager.
+type KeymanagerOpts struct {
+	BaseURL               string `json:"base_url"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// SetupConfig includes configuration values for initializing a web3signer keymanager.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+}
+
+// Keymanager implementation signing through a remote signer speaking the
+// Web3Signer HTTP interface.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	baseURL               *url.URL
+	client                *http.Client
+	genesisValidatorsRoot []byte
+	orderedPubKeys        [][48]byte
+	accountsChangedFeed   *event.Feed
+}
+
+// NewKeymanager instantiates a new web3signer keymanager from configuration options.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil {
+		return nil, errors.New("keymanager options are missing")
+	}
+	baseURL, err := url.Parse(cfg.Opts.BaseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse remote signer URL")
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, fmt.Errorf("remote signer URL %q must use http or https", cfg.Opts.BaseURL)
+	}
+	gvr, err := hexutil.Decode(cfg.Opts.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode genesis validators root")
+	}
+	if len(gvr) != 32 {
+		return nil, fmt.Errorf("genesis validators root must be 32 bytes, got %d", len(gvr))
+	}
+	return &Keymanager{
+		opts:                  cfg.Opts,
+		baseURL:               baseURL,
+		client:                &http.Client{Timeout: requestTimeout},
+		genesisValidatorsRoot: gvr,
+		orderedPubKeys:        make([][48]byte, 0),
+		accountsChangedFeed:   new(event.Feed),
+	}, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of web3signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	strURL := fmt.Sprintf("%s: %s\n", au.BrightMagenta("Remote signer URL"), opts.BaseURL)
+	if _, err := b.WriteString(strURL); err != nil {
+		log.Error(err)
+		return ""
+	}
+	strRoot := fmt.Sprintf("%s: %s\n", au.BrightMagenta("Genesis validators root"), opts.GenesisValidatorsRoot)
+	if _, err := b.WriteString(strRoot); err != nil {
+		log.Error(err)
+		return ""
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the web3signer keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// ReloadPublicKeys fetches the public keys of the remote signer and notifies the
+// subscribers of account changes if they differ from the last fetched ones. The
+// validator client polls it regularly, so keys added to or removed from the
+// remote signer are picked up at runtime.
+func (km *Keymanager) ReloadPublicKeys(ctx context.Context) ([][48]byte, error) {
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reload public keys")
+	}
+
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) == -1 })
+	if len(km.orderedPubKeys) != len(pubKeys) {
+		log.Info(keymanager.KeysReloaded)
+		km.accountsChangedFeed.Send(pubKeys)
+	} else {
+		for i := range km.orderedPubKeys {
+			if !bytes.Equal(km.orderedPubKeys[i][:], pubKeys[i][:]) {
+				log.Info(keymanager.KeysReloaded)
+				km.accountsChangedFeed.Send(pubKeys)
+				break
+			}
+		}
+	}
+
+	km.orderedPubKeys = pubKeys
+	return km.orderedPubKeys, nil
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys held by the remote signer.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	body, err := km.do(ctx, http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list public keys from remote signer")
+	}
+	var encoded []string
+	if err := json.Unmarshal(body, &encoded); err != nil {
+		return nil, errors.Wrap(err, "could not decode public keys of remote signer")
+	}
+	pubKeys := make([][48]byte, len(encoded))
+	for i, enc := range encoded {
+		pubKey, err := hexutil.Decode(enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", enc)
+		}
+		if len(pubKey) != 48 {
+			return nil, fmt.Errorf("public key %s is not 48 bytes long", enc)
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	return pubKeys, nil
+}
+
+// Sign signs a message for a validator key via an HTTP request to the remote signer.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	payload, err := km.signPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode sign request")
+	}
+	body, err := km.do(ctx, http.MethodPost, path.Join(signPath, hexutil.Encode(req.PublicKey)), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	return decodeSignature(body)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime, such as when keys are
+// added to or removed from the remote signer.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+type forkInfo struct {
+	Fork                  fork   `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+type fork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// signPayload maps a sign request to the typed JSON payload of the remote signer,
+// which carries the object to sign next to its signing root and fork.
+func (km *Keymanager) signPayload(req *validatorpb.SignRequest) (map[string]interface{}, error) {
+	var signType, field string
+	var object interface{}
+	var err error
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		signType, field = "BLOCK", "block"
+		object, err = encodeObject(o.Block)
+	case *validatorpb.SignRequest_BlockV2:
+		signType, field = "BLOCK_V2", "beacon_block"
+		var block json.RawMessage
+		block, err = encodeObject(o.BlockV2)
+		object = map[string]interface{}{"version": "ALTAIR", "block": block}
+	case *validatorpb.SignRequest_AttestationData:
+		signType, field = "ATTESTATION", "attestation"
+		object, err = encodeObject(o.AttestationData)
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		signType, field = "AGGREGATE_AND_PROOF", "aggregate_and_proof"
+		object, err = encodeObject(o.AggregateAttestationAndProof)
+	case *validatorpb.SignRequest_Exit:
+		signType, field = "VOLUNTARY_EXIT", "voluntary_exit"
+		object, err = encodeObject(o.Exit)
+	case *validatorpb.SignRequest_Slot:
+		signType, field = "AGGREGATION_SLOT", "aggregation_slot"
+		object = map[string]string{"slot": fmt.Sprintf("%d", o.Slot)}
+	case *validatorpb.SignRequest_Epoch:
+		signType, field = "RANDAO_REVEAL", "randao_reveal"
+		object = map[string]string{"epoch": fmt.Sprintf("%d", o.Epoch)}
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		signType, field = "SYNC_COMMITTEE_SELECTION_PROOF", "sync_aggregator_selection_data"
+		object, err = encodeObject(o.SyncAggregatorSelectionData)
+	case *validatorpb.SignRequest_ContributionAndProof:
+		signType, field = "SYNC_COMMITTEE_CONTRIBUTION_AND_PROOF", "contribution_and_proof"
+		object, err = encodeObject(o.ContributionAndProof)
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		signType, field = "SYNC_COMMITTEE_MESSAGE", "sync_committee_message"
+		object = map[string]string{
+			"beacon_block_root": hexutil.Encode(o.SyncMessageBlockRoot),
+			"slot":              fmt.Sprintf("%d", req.SigningSlot),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported sign request object %T", req.Object)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not encode %s sign request", signType)
+	}
+	info, err := km.forkInfo(req.SignatureDomain)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"type":        signType,
+		"signingRoot": hexutil.Encode(req.SigningRoot),
+		"fork_info":   info,
+		field:         object,
+	}, nil
+}
+
+// forkInfo determines the fork a request is signed at from its signature domain, as the
+// domain commits to the fork version and the genesis validators root of the chain.
+func (km *Keymanager) forkInfo(signatureDomain []byte) (*forkInfo, error) {
+	if len(signatureDomain) != 32 {
+		return nil, fmt.Errorf("signature domain must be 32 bytes, got %d", len(signatureDomain))
+	}
+	cfg := params.BeaconConfig()
+	forks := []fork{
+		{
+			PreviousVersion: hexutil.Encode(cfg.GenesisForkVersion),
+			CurrentVersion:  hexutil.Encode(cfg.GenesisForkVersion),
+			Epoch:           fmt.Sprintf("%d", cfg.GenesisEpoch),
+		},
+		{
+			PreviousVersion: hexutil.Encode(cfg.GenesisForkVersion),
+			CurrentVersion:  hexutil.Encode(cfg.AltairForkVersion),
+			Epoch:           fmt.Sprintf("%d", cfg.AltairForkEpoch),
+		},
+	}
+	domainType := bytesutil.ToBytes4(signatureDomain[:4])
+	for _, f := range forks {
+		version, err := hexutil.Decode(f.CurrentVersion)
+		if err != nil {
+			return nil, err
+		}
+		d, err := helpers.ComputeDomain(domainType, version, km.genesisValidatorsRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute signature domain")
+		}
+		if bytes.Equal(d, signatureDomain) {
+			return &forkInfo{Fork: f, GenesisValidatorsRoot: hexutil.Encode(km.genesisValidatorsRoot)}, nil
+		}
+	}
+	return nil, fmt.Errorf("signature domain %#x does not match any known fork of the chain", signatureDomain)
+}
+
+func (km *Keymanager) do(ctx context.Context, method, endpoint string, body []byte) ([]byte, error) {
+	u := *km.baseURL
+	u.Path = path.Join(u.Path, endpoint)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := km.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send request to remote signer")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read response of remote signer")
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return respBody, nil
+	case http.StatusPreconditionFailed:
+		return nil, ErrSigningDenied
+	case http.StatusNotFound:
+		if method == http.MethodPost {
+			return nil, ErrUnknownPublicKey
+		}
+	}
+	return nil, fmt.Errorf("remote signer responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+}
+
+// encodeObject encodes an object in the JSON format of the beacon API, as a raw
+// message so it is embedded as is in the sign request.
+func encodeObject(m proto.Message) (json.RawMessage, error) {
+	return apijson.Marshal(m)
+}
+
+// decodeSignature decodes a signature response of the remote signer, which is either
+// a JSON object or the bare hex encoded signature.
+func decodeSignature(body []byte) (bls.Signature, error) {
+	encoded := strings.TrimSpace(string(body))
+	if strings.HasPrefix(encoded, "{") {
+		resp := &struct {
+			Signature string `json:"signature"`
+		}{}
+		if err := json.Unmarshal(body, resp); err != nil {
+			return nil, errors.Wrap(err, "could not decode signature response")
+		}
+		encoded = resp.Signature
+	}
+	sig, err := hexutil.Decode(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature")
+	}
+	return bls.SignatureFromBytes(sig)
+}
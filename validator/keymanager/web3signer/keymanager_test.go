@@ -0,0 +1,393 @@
+package web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	statepb "github.com/prysmaticlabs/prysm/proto/prysm/v2/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var testGenesisValidatorsRoot = bytesutil.PadTo([]byte("genesis validators root"), 32)
+
+// testSigner is a remote signer speaking the Web3Signer HTTP interface.
+type testSigner struct {
+	sync.Mutex
+	t           *testing.T
+	keys        map[string]bls.SecretKey
+	plainText   bool
+	status      int
+	lastRequest map[string]json.RawMessage
+}
+
+func newTestSigner(t *testing.T, numKeys int) *testSigner {
+	s := &testSigner{t: t, keys: make(map[string]bls.SecretKey), status: http.StatusOK}
+	for i := 0; i < numKeys; i++ {
+		s.addKey()
+	}
+	return s
+}
+
+func (s *testSigner) addKey() bls.SecretKey {
+	key, err := bls.RandKey()
+	require.NoError(s.t, err)
+	s.Lock()
+	defer s.Unlock()
+	s.keys[hexutil.Encode(key.PublicKey().Marshal())] = key
+	return key
+}
+
+func (s *testSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	if r.Method == http.MethodGet && r.URL.Path == publicKeysPath {
+		pubKeys := make([]string, 0, len(s.keys))
+		for pubKey := range s.keys {
+			pubKeys = append(pubKeys, pubKey)
+		}
+		require.NoError(s.t, json.NewEncoder(w).Encode(pubKeys))
+		return
+	}
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.URL.Path, signPath+"/") {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if s.status != http.StatusOK {
+		w.WriteHeader(s.status)
+		return
+	}
+	key, ok := s.keys[strings.TrimPrefix(r.URL.Path, signPath+"/")]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	require.NoError(s.t, err)
+	s.lastRequest = make(map[string]json.RawMessage)
+	require.NoError(s.t, json.Unmarshal(body, &s.lastRequest))
+	var signingRoot string
+	require.NoError(s.t, json.Unmarshal(s.lastRequest["signingRoot"], &signingRoot))
+	root, err := hexutil.Decode(signingRoot)
+	require.NoError(s.t, err)
+	sig := hexutil.Encode(key.Sign(root).Marshal())
+	if s.plainText {
+		_, err = fmt.Fprint(w, sig)
+	} else {
+		err = json.NewEncoder(w).Encode(map[string]string{"signature": sig})
+	}
+	require.NoError(s.t, err)
+}
+
+func setupKeymanager(t *testing.T, signer *testSigner) *Keymanager {
+	srv := httptest.NewServer(signer)
+	t.Cleanup(srv.Close)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		Opts: &KeymanagerOpts{
+			BaseURL:               srv.URL,
+			GenesisValidatorsRoot: hexutil.Encode(testGenesisValidatorsRoot),
+		},
+	})
+	require.NoError(t, err)
+	return km
+}
+
+func TestNewKeymanager_InvalidOpts(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewKeymanager(ctx, &SetupConfig{})
+	assert.ErrorContains(t, "options are missing", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{
+		BaseURL:               "signer:9000",
+		GenesisValidatorsRoot: hexutil.Encode(testGenesisValidatorsRoot),
+	}})
+	assert.ErrorContains(t, "must use http or https", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{
+		BaseURL:               "http://signer:9000",
+		GenesisValidatorsRoot: "0x1234",
+	}})
+	assert.ErrorContains(t, "must be 32 bytes", err)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	signer := newTestSigner(t, 3)
+	km := setupKeymanager(t, signer)
+
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, len(keys))
+	for _, key := range keys {
+		_, ok := signer.keys[hexutil.Encode(key[:])]
+		assert.Equal(t, true, ok)
+	}
+}
+
+func TestKeymanager_ReloadPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	signer := newTestSigner(t, 2)
+	km := setupKeymanager(t, signer)
+	accountsChanged := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(accountsChanged)
+	defer sub.Unsubscribe()
+
+	keys, err := km.ReloadPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, keys, <-accountsChanged)
+
+	// Unchanged keys are not sent to subscribers.
+	_, err = km.ReloadPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(accountsChanged))
+
+	added := signer.addKey()
+	keys, err = km.ReloadPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(keys))
+	reloaded := <-accountsChanged
+	assert.DeepEqual(t, keys, reloaded)
+	found := false
+	for _, key := range reloaded {
+		found = found || key == bytesutil.ToBytes48(added.PublicKey().Marshal())
+	}
+	assert.Equal(t, true, found)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctx := context.Background()
+	signer := newTestSigner(t, 1)
+	km := setupKeymanager(t, signer)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	pubKey := keys[0]
+
+	domain, err := helpers.ComputeDomain(
+		params.BeaconConfig().DomainBeaconAttester, params.BeaconConfig().GenesisForkVersion, testGenesisValidatorsRoot,
+	)
+	require.NoError(t, err)
+	signingRoot := bytesutil.PadTo([]byte("signing root"), 32)
+
+	tests := []struct {
+		name     string
+		req      *validatorpb.SignRequest
+		wantType string
+		field    string
+		want     string
+	}{
+		{
+			name:     "block",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Block{Block: testutil.NewBeaconBlock().Block}},
+			wantType: "BLOCK",
+			field:    "block",
+		},
+		{
+			name:     "block v2",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_BlockV2{BlockV2: testutil.HydrateBeaconBlockAltair(&validatorpb.BeaconBlockAltair{})}},
+			wantType: "BLOCK_V2",
+			field:    "beacon_block",
+		},
+		{
+			name:     "attestation",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AttestationData{AttestationData: testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 3})}},
+			wantType: "ATTESTATION",
+			field:    "attestation",
+		},
+		{
+			name: "aggregate and proof",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: &ethpb.AggregateAttestationAndProof{
+				Aggregate:      testutil.HydrateAttestation(&ethpb.Attestation{}),
+				SelectionProof: make([]byte, 96),
+			}}},
+			wantType: "AGGREGATE_AND_PROOF",
+			field:    "aggregate_and_proof",
+		},
+		{
+			name:     "voluntary exit",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 5}}},
+			wantType: "VOLUNTARY_EXIT",
+			field:    "voluntary_exit",
+			want:     `{"epoch":"2","validator_index":"5"}`,
+		},
+		{
+			name:     "aggregation slot",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{Slot: 7}},
+			wantType: "AGGREGATION_SLOT",
+			field:    "aggregation_slot",
+			want:     `{"slot":"7"}`,
+		},
+		{
+			name:     "randao reveal",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 4}},
+			wantType: "RANDAO_REVEAL",
+			field:    "randao_reveal",
+			want:     `{"epoch":"4"}`,
+		},
+		{
+			name: "sync committee selection proof",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_SyncAggregatorSelectionData{
+				SyncAggregatorSelectionData: &statepb.SyncAggregatorSelectionData{Slot: 9, SubcommitteeIndex: 1},
+			}},
+			wantType: "SYNC_COMMITTEE_SELECTION_PROOF",
+			field:    "sync_aggregator_selection_data",
+			want:     `{"slot":"9","subcommittee_index":"1"}`,
+		},
+		{
+			name: "sync committee contribution and proof",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_ContributionAndProof{ContributionAndProof: &validatorpb.ContributionAndProof{
+				Contribution: &validatorpb.SyncCommitteeContribution{
+					BlockRoot:       make([]byte, 32),
+					AggregationBits: make([]byte, 16),
+					Signature:       make([]byte, 96),
+				},
+				SelectionProof: make([]byte, 96),
+			}}},
+			wantType: "SYNC_COMMITTEE_CONTRIBUTION_AND_PROOF",
+			field:    "contribution_and_proof",
+		},
+		{
+			name:     "sync committee message",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_SyncMessageBlockRoot{SyncMessageBlockRoot: bytesutil.PadTo([]byte{1}, 32)}},
+			wantType: "SYNC_COMMITTEE_MESSAGE",
+			field:    "sync_committee_message",
+			want: fmt.Sprintf(
+				`{"beacon_block_root":"%s","slot":"11"}`, hexutil.Encode(bytesutil.PadTo([]byte{1}, 32)),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.PublicKey = pubKey[:]
+			tt.req.SigningRoot = signingRoot
+			tt.req.SignatureDomain = domain
+			tt.req.SigningSlot = 11
+			sig, err := km.Sign(ctx, tt.req)
+			require.NoError(t, err)
+			pub, err := bls.PublicKeyFromBytes(pubKey[:])
+			require.NoError(t, err)
+			assert.Equal(t, true, sig.Verify(pub, signingRoot))
+
+			req := signer.lastRequest
+			assert.Equal(t, fmt.Sprintf("%q", tt.wantType), string(req["type"]))
+			assert.Equal(t, fmt.Sprintf("%q", hexutil.Encode(signingRoot)), string(req["signingRoot"]))
+			require.NotNil(t, req[tt.field])
+			if tt.want != "" {
+				assert.Equal(t, tt.want, string(req[tt.field]))
+			}
+			info := &forkInfo{}
+			require.NoError(t, json.Unmarshal(req["fork_info"], info))
+			assert.Equal(t, hexutil.Encode(params.BeaconConfig().GenesisForkVersion), info.Fork.CurrentVersion)
+			assert.Equal(t, hexutil.Encode(testGenesisValidatorsRoot), info.GenesisValidatorsRoot)
+		})
+	}
+}
+
+func TestKeymanager_Sign_BlockV2Version(t *testing.T) {
+	ctx := context.Background()
+	signer := newTestSigner(t, 1)
+	km := setupKeymanager(t, signer)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	domain, err := helpers.ComputeDomain(
+		params.BeaconConfig().DomainBeaconProposer, params.BeaconConfig().AltairForkVersion, testGenesisValidatorsRoot,
+	)
+	require.NoError(t, err)
+
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:       keys[0][:],
+		SigningRoot:     make([]byte, 32),
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_BlockV2{BlockV2: testutil.HydrateBeaconBlockAltair(&validatorpb.BeaconBlockAltair{})},
+	})
+	require.NoError(t, err)
+	block := make(map[string]json.RawMessage)
+	require.NoError(t, json.Unmarshal(signer.lastRequest["beacon_block"], &block))
+	assert.Equal(t, `"ALTAIR"`, string(block["version"]))
+	require.NotNil(t, block["block"])
+
+	// Objects signed at the altair fork carry the altair fork version.
+	info := &forkInfo{}
+	require.NoError(t, json.Unmarshal(signer.lastRequest["fork_info"], info))
+	assert.Equal(t, hexutil.Encode(params.BeaconConfig().GenesisForkVersion), info.Fork.PreviousVersion)
+	assert.Equal(t, hexutil.Encode(params.BeaconConfig().AltairForkVersion), info.Fork.CurrentVersion)
+	assert.Equal(t, fmt.Sprintf("%d", params.BeaconConfig().AltairForkEpoch), info.Fork.Epoch)
+}
+
+func TestKeymanager_Sign_PlainTextResponse(t *testing.T) {
+	ctx := context.Background()
+	signer := newTestSigner(t, 1)
+	signer.plainText = true
+	km := setupKeymanager(t, signer)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainRandao, nil, testGenesisValidatorsRoot)
+	require.NoError(t, err)
+
+	sig, err := km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:       keys[0][:],
+		SigningRoot:     make([]byte, 32),
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	require.NoError(t, err)
+	pub, err := bls.PublicKeyFromBytes(keys[0][:])
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(pub, make([]byte, 32)))
+}
+
+func TestKeymanager_Sign_Errors(t *testing.T) {
+	ctx := context.Background()
+	signer := newTestSigner(t, 1)
+	km := setupKeymanager(t, signer)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainRandao, nil, testGenesisValidatorsRoot)
+	require.NoError(t, err)
+	req := &validatorpb.SignRequest{
+		PublicKey:       keys[0][:],
+		SigningRoot:     make([]byte, 32),
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Epoch{Epoch: 1},
+	}
+
+	unknownKey, err := bls.RandKey()
+	require.NoError(t, err)
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:       unknownKey.PublicKey().Marshal(),
+		SigningRoot:     req.SigningRoot,
+		SignatureDomain: req.SignatureDomain,
+		Object:          req.Object,
+	})
+	assert.ErrorContains(t, ErrUnknownPublicKey.Error(), err)
+
+	// Domains of another chain do not match any fork.
+	otherDomain, err := helpers.ComputeDomain(params.BeaconConfig().DomainRandao, nil, make([]byte, 32))
+	require.NoError(t, err)
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:       req.PublicKey,
+		SigningRoot:     req.SigningRoot,
+		SignatureDomain: otherDomain,
+		Object:          req.Object,
+	})
+	assert.ErrorContains(t, "does not match any known fork", err)
+
+	signer.status = http.StatusPreconditionFailed
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+
+	signer.status = http.StatusInternalServerError
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, "status 500", err)
+}
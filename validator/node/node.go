@@ -17,6 +17,7 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
@@ -397,6 +398,14 @@ func (c *ValidatorClient) registerValidatorService(
 		protector = sp
 	}
 
+	var beaconApiEndpoints []string
+	fallbacks := c.cliCtx.StringSlice(flags.FallbackBeaconRESTApiProviderFlag.Name)
+	if primary := c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name); primary != "" {
+		beaconApiEndpoints = append([]string{primary}, fallbacks...)
+	} else if len(fallbacks) > 0 {
+		return fmt.Errorf("--%s requires --%s to be set", flags.FallbackBeaconRESTApiProviderFlag.Name, flags.BeaconRESTApiProviderFlag.Name)
+	}
+
 	gStruct := &g.Graffiti{}
 	var err error
 	if c.cliCtx.IsSet(flags.GraffitiFileFlag.Name) {
@@ -425,6 +434,8 @@ func (c *ValidatorClient) registerValidatorService(
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		BeaconApiEndpoints:         beaconApiEndpoints,
+		BeaconApiTimeout:           c.cliCtx.Duration(flags.BeaconRESTApiTimeoutFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -554,9 +565,30 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		Mux:           mux,
 	}
 
+	// The standard keymanager API follows the naming of the Ethereum APIs,
+	// which use the original field names of the protos.
+	v1Mux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
+			Marshaler: &gwruntime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					UseProtoNames:   true,
+					EmitUnpopulated: true,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		}),
+	)
+	v1PbHandler := gateway.PbMux{
+		Registrations: []gateway.PbHandlerRegistration{ethpbv1.RegisterKeyManagementHandler},
+		Patterns:      []string{"/eth/v1/"},
+		Mux:           v1Mux,
+	}
+
 	gw := gateway.New(
 		cliCtx.Context,
-		[]gateway.PbMux{pbHandler},
+		[]gateway.PbMux{pbHandler, v1PbHandler},
 		muxHandler,
 		rpcAddr,
 		gatewayAddress,
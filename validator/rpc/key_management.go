@@ -0,0 +1,235 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	slashing "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Statuses of keystores in the standard keymanager API.
+const (
+	keystoreImported  = "imported"
+	keystoreDuplicate = "duplicate"
+	keystoreDeleted   = "deleted"
+	keystoreNotActive = "not_active"
+	keystoreNotFound  = "not_found"
+	keystoreError     = "error"
+)
+
+// keyManagementServer implements the standard keymanager API against the wallet of the
+// validator RPC server. It is a type of its own as the Prysm wallet API already defines
+// methods of the same names.
+type keyManagementServer struct {
+	s *Server
+}
+
+// ListKeystores lists the validating public keys of the wallet. The derivation paths of derived
+// keys are left out, as the wallet does not record the account index each key was derived at.
+func (k *keyManagementServer) ListKeystores(ctx context.Context, _ *empty.Empty) (*ethpbv1.ListKeystoresResponse, error) {
+	if err := k.checkLocalWallet(); err != nil {
+		return nil, err
+	}
+	keys, err := k.s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	data := make([]*ethpbv1.ListKeystoresResponse_Keystore, len(keys))
+	for i := 0; i < len(keys); i++ {
+		data[i] = &ethpbv1.ListKeystoresResponse_Keystore{
+			ValidatingPubkey: fmt.Sprintf("%#x", keys[i]),
+		}
+	}
+	return &ethpbv1.ListKeystoresResponse{Data: data}, nil
+}
+
+// ImportKeystores imports EIP-2335 keystores into an imported wallet, along with the slashing
+// protection history of their keys. The history is imported first, so no key is imported if
+// the history is invalid.
+func (k *keyManagementServer) ImportKeystores(
+	ctx context.Context, req *ethpbv1.ImportKeystoresRequest,
+) (*ethpbv1.ImportKeystoresResponse, error) {
+	if err := k.checkLocalWallet(); err != nil {
+		return nil, err
+	}
+	if k.s.wallet.KeymanagerKind() != keymanager.Imported {
+		return nil, status.Error(codes.FailedPrecondition, "Only imported wallets can import keystores")
+	}
+	km, ok := k.s.keymanager.(*imported.Keymanager)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Could not assert keymanager interface to concrete type")
+	}
+	if len(req.Keystores) != len(req.Passwords) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Number of keystores %d does not match the number of passwords %d",
+			len(req.Keystores),
+			len(req.Passwords),
+		)
+	}
+	if req.SlashingProtection != "" {
+		if k.s.valDB == nil {
+			return nil, status.Error(codes.FailedPrecondition, "No validator database found")
+		}
+		buf := bytes.NewBufferString(req.SlashingProtection)
+		if err := slashing.ImportStandardProtectionJSON(ctx, k.s.valDB, buf); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not import slashing protection history: %v", err)
+		}
+	}
+	existingKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	seen := make(map[[48]byte]bool, len(existingKeys))
+	for _, key := range existingKeys {
+		seen[key] = true
+	}
+	statuses := make([]*ethpbv1.KeystoreStatus, len(req.Keystores))
+	privKeys := make([][]byte, 0, len(req.Keystores))
+	pubKeys := make([][]byte, 0, len(req.Keystores))
+	for i, encoded := range req.Keystores {
+		keystore := &keymanager.Keystore{}
+		if err := json.Unmarshal([]byte(encoded), keystore); err != nil {
+			statuses[i] = &ethpbv1.KeystoreStatus{Status: keystoreError, Message: fmt.Sprintf("Invalid keystore: %v", err)}
+			continue
+		}
+		privKey, pubKey, err := imported.DecryptKeystore(keystore, req.Passwords[i])
+		if err != nil {
+			statuses[i] = &ethpbv1.KeystoreStatus{Status: keystoreError, Message: err.Error()}
+			continue
+		}
+		if seen[bytesutil.ToBytes48(pubKey)] {
+			statuses[i] = &ethpbv1.KeystoreStatus{Status: keystoreDuplicate}
+			continue
+		}
+		seen[bytesutil.ToBytes48(pubKey)] = true
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, pubKey)
+		statuses[i] = &ethpbv1.KeystoreStatus{Status: keystoreImported}
+	}
+	if len(pubKeys) > 0 {
+		if err := km.ImportKeypairs(ctx, privKeys, pubKeys); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not import keystores: %v", err)
+		}
+		log.WithField("numKeys", len(pubKeys)).Info("Imported keystores through the keymanager API")
+	}
+	return &ethpbv1.ImportKeystoresResponse{Data: statuses}, nil
+}
+
+// DeleteKeystores deletes keys from an imported or derived wallet and returns the slashing
+// protection history of the deleted keys, as well as that of requested keys which are no
+// longer in the wallet but still have a history in the validator database.
+func (k *keyManagementServer) DeleteKeystores(
+	ctx context.Context, req *ethpbv1.DeleteKeystoresRequest,
+) (*ethpbv1.DeleteKeystoresResponse, error) {
+	if err := k.checkLocalWallet(); err != nil {
+		return nil, err
+	}
+	if k.s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No validator database found")
+	}
+	existingKeys, err := k.s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	active := make(map[[48]byte]bool, len(existingKeys))
+	for _, key := range existingKeys {
+		active[key] = true
+	}
+	withHistory, err := k.pubKeysWithHistory(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch slashing protection public keys: %v", err)
+	}
+
+	statuses := make([]*ethpbv1.KeystoreStatus, len(req.Pubkeys))
+	toDelete := make([][]byte, 0, len(req.Pubkeys))
+	toExport := make([][48]byte, 0, len(req.Pubkeys))
+	for i, encoded := range req.Pubkeys {
+		pubKey, err := slashing.PubKeyFromHex(encoded)
+		if err != nil {
+			statuses[i] = &ethpbv1.KeystoreStatus{Status: keystoreError, Message: err.Error()}
+			continue
+		}
+		if _, err := bls.PublicKeyFromBytes(pubKey[:]); err != nil {
+			statuses[i] = &ethpbv1.KeystoreStatus{Status: keystoreError, Message: fmt.Sprintf("Not a valid BLS public key: %v", err)}
+			continue
+		}
+		switch {
+		case active[pubKey]:
+			// Duplicate entries of the request are only deleted once.
+			active[pubKey] = false
+			toDelete = append(toDelete, pubKey[:])
+			toExport = append(toExport, pubKey)
+			statuses[i] = &ethpbv1.KeystoreStatus{Status: keystoreDeleted}
+		case withHistory[pubKey]:
+			toExport = append(toExport, pubKey)
+			statuses[i] = &ethpbv1.KeystoreStatus{Status: keystoreNotActive}
+		default:
+			statuses[i] = &ethpbv1.KeystoreStatus{Status: keystoreNotFound}
+		}
+	}
+	if len(toDelete) > 0 {
+		if err := accounts.DeleteAccount(ctx, &accounts.Config{
+			Wallet:           k.s.wallet,
+			Keymanager:       k.s.keymanager,
+			DeletePublicKeys: toDelete,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not delete public keys: %v", err)
+		}
+	}
+
+	eipJSON, err := slashing.ExportStandardProtectionJSONForPubKeys(ctx, k.s.valDB, toExport)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not export slashing protection history: %v", err)
+	}
+	encoded, err := json.Marshal(eipJSON)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not JSON marshal slashing protection history: %v", err)
+	}
+	return &ethpbv1.DeleteKeystoresResponse{
+		Data:               statuses,
+		SlashingProtection: string(encoded),
+	}, nil
+}
+
+// Only local wallets, whose keys live in the validator client, can be managed through the API.
+func (k *keyManagementServer) checkLocalWallet() error {
+	if k.s.wallet == nil || k.s.keymanager == nil {
+		return status.Error(codes.FailedPrecondition, "No wallet found")
+	}
+	if k.s.wallet.KeymanagerKind() != keymanager.Imported && k.s.wallet.KeymanagerKind() != keymanager.Derived {
+		return status.Error(codes.FailedPrecondition, "Only imported or derived wallets can manage keystores")
+	}
+	return nil
+}
+
+// Public keys with a proposal or attestation history in the validator database.
+func (k *keyManagementServer) pubKeysWithHistory(ctx context.Context) (map[[48]byte]bool, error) {
+	proposed, err := k.s.valDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attested, err := k.s.valDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[[48]byte]bool, len(proposed)+len(attested))
+	for _, key := range proposed {
+		keys[key] = true
+	}
+	for _, key := range attested {
+		keys[key] = true
+	}
+	return keys, nil
+}
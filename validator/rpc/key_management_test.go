@@ -0,0 +1,248 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	mocks "github.com/prysmaticlabs/prysm/validator/testing"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestKeyManagement_NoWallet(t *testing.T) {
+	k := &keyManagementServer{s: &Server{}}
+	ctx := context.Background()
+	_, err := k.ListKeystores(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "No wallet found", err)
+	_, err = k.ImportKeystores(ctx, &ethpbv1.ImportKeystoresRequest{})
+	assert.ErrorContains(t, "No wallet found", err)
+	_, err = k.DeleteKeystores(ctx, &ethpbv1.DeleteKeystoresRequest{})
+	assert.ErrorContains(t, "No wallet found", err)
+}
+
+func TestKeyManagement_ListKeystores(t *testing.T) {
+	imported.ResetCaches()
+	s, pubKeys := createImportedWalletWithAccounts(t, 3)
+	k := &keyManagementServer{s: s}
+
+	resp, err := k.ListKeystores(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, len(pubKeys), len(resp.Data))
+	listed := make(map[string]bool)
+	for _, item := range resp.Data {
+		listed[item.ValidatingPubkey] = true
+		assert.Equal(t, "", item.DerivationPath)
+	}
+	for _, pubKey := range pubKeys {
+		assert.Equal(t, true, listed[fmt.Sprintf("%#x", pubKey)])
+	}
+}
+
+func TestKeyManagement_ListKeystores_DerivedWallet(t *testing.T) {
+	imported.ResetCaches()
+	ctx := context.Background()
+	defaultWalletPath = setupWalletDir(t)
+	w, err := accounts.CreateWalletWithKeymanager(ctx, &accounts.CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      defaultWalletPath,
+			KeymanagerKind: keymanager.Derived,
+			WalletPassword: strongPass,
+		},
+		SkipMnemonicConfirm: true,
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	dr, ok := km.(*derived.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, dr.RecoverAccountsFromMnemonic(ctx, mocks.TestMnemonic, "", 3))
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.NoError(t, dr.DeleteAccounts(ctx, [][]byte{keys[0][:]}))
+	k := &keyManagementServer{s: &Server{keymanager: km, walletInitialized: true, wallet: w}}
+
+	// The remaining keys no longer sit at their derivation index, no path is reported for them.
+	resp, err := k.ListKeystores(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	for i, item := range resp.Data {
+		assert.Equal(t, fmt.Sprintf("%#x", keys[i+1]), item.ValidatingPubkey)
+		assert.Equal(t, "", item.DerivationPath)
+	}
+}
+
+func TestKeyManagement_ImportKeystores(t *testing.T) {
+	imported.ResetCaches()
+	ctx := context.Background()
+	s, existing := createImportedWalletWithAccounts(t, 1)
+	s.valDB = dbtest.SetupDB(t, nil)
+	k := &keyManagementServer{s: s}
+
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(privKey.PublicKey().Marshal())
+	otherKey, err := bls.RandKey()
+	require.NoError(t, err)
+
+	// The keys of the wallet are reloaded without restarting the validator.
+	accountsChanged := make(chan [][48]byte, 1)
+	sub := s.keymanager.SubscribeAccountChanges(accountsChanged)
+	defer sub.Unsubscribe()
+
+	history, err := mocks.MockSlashingProtectionJSON(
+		[][48]byte{pubKey},
+		nil,
+		[]kv.ProposalHistoryForPubkey{{Proposals: []kv.Proposal{{Slot: 5, SigningRoot: make([]byte, 32)}}}},
+	)
+	require.NoError(t, err)
+	encodedHistory, err := json.Marshal(history)
+	require.NoError(t, err)
+
+	resp, err := k.ImportKeystores(ctx, &ethpbv1.ImportKeystoresRequest{
+		Keystores: []string{
+			createTestKeystore(t, privKey, strongPass),
+			createTestKeystore(t, otherKey, strongPass),
+			createTestKeystore(t, privKey, strongPass),
+			"{",
+		},
+		Passwords:          []string{strongPass, "wrong password", strongPass, strongPass},
+		SlashingProtection: string(encodedHistory),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(resp.Data))
+	assert.Equal(t, keystoreImported, resp.Data[0].Status)
+	assert.Equal(t, keystoreError, resp.Data[1].Status)
+	assert.Equal(t, keystoreDuplicate, resp.Data[2].Status)
+	assert.Equal(t, keystoreError, resp.Data[3].Status)
+
+	reloaded := <-accountsChanged
+	assert.Equal(t, len(existing)+1, len(reloaded))
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(existing)+1, len(keys))
+
+	proposed, err := s.valDB.ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pubKey}, proposed)
+
+	// Importing an existing key again reports it as a duplicate.
+	resp, err = k.ImportKeystores(ctx, &ethpbv1.ImportKeystoresRequest{
+		Keystores: []string{createTestKeystore(t, privKey, strongPass)},
+		Passwords: []string{strongPass},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, keystoreDuplicate, resp.Data[0].Status)
+}
+
+func TestKeyManagement_ImportKeystores_InvalidSlashingProtection(t *testing.T) {
+	imported.ResetCaches()
+	ctx := context.Background()
+	s, existing := createImportedWalletWithAccounts(t, 1)
+	s.valDB = dbtest.SetupDB(t, nil)
+	k := &keyManagementServer{s: s}
+
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	_, err = k.ImportKeystores(ctx, &ethpbv1.ImportKeystoresRequest{
+		Keystores:          []string{createTestKeystore(t, privKey, strongPass)},
+		Passwords:          []string{strongPass},
+		SlashingProtection: "{",
+	})
+	assert.ErrorContains(t, "Could not import slashing protection history", err)
+
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(existing), len(keys))
+}
+
+func TestKeyManagement_ImportKeystores_MismatchedPasswords(t *testing.T) {
+	imported.ResetCaches()
+	s, _ := createImportedWalletWithAccounts(t, 1)
+	k := &keyManagementServer{s: s}
+	_, err := k.ImportKeystores(context.Background(), &ethpbv1.ImportKeystoresRequest{
+		Keystores: []string{"{}"},
+	})
+	assert.ErrorContains(t, "does not match the number of passwords", err)
+}
+
+func TestKeyManagement_DeleteKeystores(t *testing.T) {
+	imported.ResetCaches()
+	ctx := context.Background()
+	s, pubKeys := createImportedWalletWithAccounts(t, 2)
+	k := &keyManagementServer{s: s}
+
+	deleted := bytesutil.ToBytes48(pubKeys[0])
+	inactiveKey, err := bls.RandKey()
+	require.NoError(t, err)
+	inactive := bytesutil.ToBytes48(inactiveKey.PublicKey().Marshal())
+	unknownKey, err := bls.RandKey()
+	require.NoError(t, err)
+
+	s.valDB = dbtest.SetupDB(t, [][48]byte{deleted, inactive})
+	require.NoError(t, s.valDB.SaveProposalHistoryForSlot(ctx, deleted, 1, make([]byte, 32)))
+	require.NoError(t, s.valDB.SaveProposalHistoryForSlot(ctx, inactive, 2, make([]byte, 32)))
+	require.NoError(t, s.valDB.SaveGenesisValidatorsRoot(ctx, make([]byte, 32)))
+
+	accountsChanged := make(chan [][48]byte, 1)
+	sub := s.keymanager.SubscribeAccountChanges(accountsChanged)
+	defer sub.Unsubscribe()
+
+	resp, err := k.DeleteKeystores(ctx, &ethpbv1.DeleteKeystoresRequest{
+		Pubkeys: []string{
+			fmt.Sprintf("%#x", deleted),
+			fmt.Sprintf("%#x", inactive),
+			fmt.Sprintf("%#x", unknownKey.PublicKey().Marshal()),
+			"0x1234",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(resp.Data))
+	assert.Equal(t, keystoreDeleted, resp.Data[0].Status)
+	assert.Equal(t, keystoreNotActive, resp.Data[1].Status)
+	assert.Equal(t, keystoreNotFound, resp.Data[2].Status)
+	assert.Equal(t, keystoreError, resp.Data[3].Status)
+
+	reloaded := <-accountsChanged
+	assert.DeepEqual(t, [][48]byte{bytesutil.ToBytes48(pubKeys[1])}, reloaded)
+
+	history := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(resp.SlashingProtection), history))
+	require.Equal(t, 2, len(history.Data))
+	exported := map[string]bool{history.Data[0].Pubkey: true, history.Data[1].Pubkey: true}
+	assert.Equal(t, true, exported[fmt.Sprintf("%#x", deleted)])
+	assert.Equal(t, true, exported[fmt.Sprintf("%#x", inactive)])
+}
+
+func createTestKeystore(t *testing.T, privKey bls.SecretKey, password string) string {
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(privKey.Marshal(), password)
+	require.NoError(t, err)
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	encoded, err := json.Marshal(&keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Pubkey:  fmt.Sprintf("%x", privKey.PublicKey().Marshal()),
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	})
+	require.NoError(t, err)
+	return string(encoded)
+}
@@ -11,6 +11,7 @@ import (
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -188,6 +189,7 @@ func (s *Server) Start() {
 	pb.RegisterBeaconServer(s.grpcServer, s)
 	pb.RegisterAccountsServer(s.grpcServer, s)
 	pb.RegisterSlashingProtectionServer(s.grpcServer, s)
+	ethpbv1.RegisterKeyManagementServer(s.grpcServer, &keyManagementServer{s: s})
 
 	go func() {
 		if s.listener != nil {
@@ -51,7 +51,7 @@ func (s *Server) CreateWallet(ctx context.Context, req *pb.CreateWalletRequest)
 		switch s.wallet.KeymanagerKind() {
 		case keymanager.Derived:
 			keymanagerKind = pb.KeymanagerKind_DERIVED
-		case keymanager.Remote:
+		case keymanager.Remote, keymanager.Web3Signer:
 			keymanagerKind = pb.KeymanagerKind_REMOTE
 		}
 		return &pb.CreateWalletResponse{
@@ -124,7 +124,7 @@ func (s *Server) WalletConfig(ctx context.Context, _ *empty.Empty) (*pb.WalletRe
 		keymanagerKind = pb.KeymanagerKind_DERIVED
 	case keymanager.Imported:
 		keymanagerKind = pb.KeymanagerKind_IMPORTED
-	case keymanager.Remote:
+	case keymanager.Remote, keymanager.Web3Signer:
 		keymanagerKind = pb.KeymanagerKind_REMOTE
 	}
 	return &pb.WalletResponse{
@@ -17,6 +17,26 @@ import (
 // ExportStandardProtectionJSON extracts all slashing protection data from a validator database
 // and packages it into an EIP-3076 compliant, standard
 func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database) (*format.EIPSlashingProtectionFormat, error) {
+	return exportStandardProtectionJSON(ctx, validatorDB, nil)
+}
+
+// ExportStandardProtectionJSONForPubKeys extracts the slashing protection data of the given
+// public keys only, such as keys being removed from the validator client.
+func ExportStandardProtectionJSONForPubKeys(
+	ctx context.Context, validatorDB db.Database, pubKeys [][48]byte,
+) (*format.EIPSlashingProtectionFormat, error) {
+	wanted := make(map[[48]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		wanted[pubKey] = true
+	}
+	return exportStandardProtectionJSON(ctx, validatorDB, wanted)
+}
+
+// Exports the slashing protection data of the public keys in the filter, or of
+// every public key in the database if the filter is nil.
+func exportStandardProtectionJSON(
+	ctx context.Context, validatorDB db.Database, filter map[[48]byte]bool,
+) (*format.EIPSlashingProtectionFormat, error) {
 	interchangeJSON := &format.EIPSlashingProtectionFormat{}
 	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
 	if err != nil {
@@ -38,6 +58,10 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 	if err != nil {
 		return nil, err
 	}
+	if filter != nil {
+		proposedPublicKeys = filterPubKeys(proposedPublicKeys, filter)
+		attestedPublicKeys = filterPubKeys(attestedPublicKeys, filter)
+	}
 	dataByPubKey := make(map[[48]byte]*format.ProtectionData)
 
 	// Extract the signed proposals by public key.
@@ -108,6 +132,16 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 	return interchangeJSON, nil
 }
 
+func filterPubKeys(pubKeys [][48]byte, filter map[[48]byte]bool) [][48]byte {
+	filtered := make([][48]byte, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if filter[pubKey] {
+			filtered = append(filtered, pubKey)
+		}
+	}
+	return filtered
+}
+
 func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [48]byte) ([]*format.SignedAttestation, error) {
 	// If a key does not have an attestation history in our database, we return nil.
 	// This way, a user will be able to export their slashing protection history
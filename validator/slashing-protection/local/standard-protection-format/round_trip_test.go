@@ -128,6 +128,44 @@ func TestImportExport_RoundTrip_SkippedAttestationEpochs(t *testing.T) {
 	require.DeepEqual(t, wanted.Data, eipStandard.Data)
 }
 
+func TestImportExport_RoundTrip_ForPubKeys(t *testing.T) {
+	ctx := context.Background()
+	numValidators := 5
+	publicKeys, err := slashtest.CreateRandomPubKeys(numValidators)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	attestingHistory, proposalHistory := slashtest.MockAttestingAndProposalHistories(publicKeys)
+	wanted, err := slashtest.MockSlashingProtectionJSON(publicKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	blob, err := json.Marshal(wanted)
+	require.NoError(t, err)
+	require.NoError(t, protectionFormat.ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	// Only the history of the requested keys is exported, keys without a history are left out.
+	unknownKeys, err := slashtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	requested := [][48]byte{publicKeys[1], publicKeys[3], unknownKeys[0]}
+	eipStandard, err := protectionFormat.ExportStandardProtectionJSONForPubKeys(ctx, validatorDB, requested)
+	require.NoError(t, err)
+	require.Equal(t, wanted.Metadata, eipStandard.Metadata)
+	require.Equal(t, 2, len(eipStandard.Data))
+
+	wantedByPubKey := make(map[string]*format.ProtectionData)
+	for _, item := range wanted.Data {
+		wantedByPubKey[item.Pubkey] = item
+	}
+	exported := make(map[string]bool)
+	for _, item := range eipStandard.Data {
+		exported[item.Pubkey] = true
+		want := wantedByPubKey[item.Pubkey]
+		require.Equal(t, len(want.SignedAttestations), len(item.SignedAttestations))
+		require.DeepEqual(t, want.SignedBlocks, item.SignedBlocks)
+	}
+	require.Equal(t, true, exported[fmt.Sprintf("%#x", publicKeys[1])])
+	require.Equal(t, true, exported[fmt.Sprintf("%#x", publicKeys[3])])
+}
+
 func TestImportInterchangeData_OK(t *testing.T) {
 	ctx := context.Background()
 	numValidators := 10